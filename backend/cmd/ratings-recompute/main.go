@@ -0,0 +1,78 @@
+// Command ratings-recompute replays every completed match through the rating engine and replaces the
+// stored driver ratings. Flags override the configured parameters so they can be retuned against history.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/f1-rivals-cup/backend/internal/config"
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+)
+
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("Failed to load config", "error", err)
+		os.Exit(1)
+	}
+
+	initial := flag.Float64("initial", cfg.RatingInitial, "rating given to a driver's first rated match")
+	kFactor := flag.Float64("k", cfg.RatingKFactor, "most a driver can gain or lose in one match")
+	dnfMode := flag.String("dnf", cfg.RatingDNFMode, "how DNFs are compared: last or exclude")
+	dryRun := flag.Bool("dry-run", false, "print the resulting leaderboard without saving it")
+	top := flag.Int("top", 20, "number of drivers printed in dry-run mode")
+	flag.Parse()
+
+	if *dnfMode != string(model.RatingDNFLast) && *dnfMode != string(model.RatingDNFExclude) {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	db, err := database.New(cfg.DatabaseURL)
+	if err != nil {
+		slog.Error("Failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	ratingService := service.NewRatingService(repository.NewRatingRepository(db), service.RatingParams{
+		Initial: *initial,
+		KFactor: *kFactor,
+		DNFMode: model.RatingDNFMode(*dnfMode),
+	})
+
+	ctx := context.Background()
+
+	if *dryRun {
+		engine, matchIDs, err := ratingService.Replay(ctx)
+		if err != nil {
+			slog.Error("Failed to replay ratings", "error", err)
+			os.Exit(1)
+		}
+
+		ratings := engine.Ratings()
+		fmt.Printf("%d matches, %d drivers\n", len(matchIDs), len(ratings))
+		for i, r := range ratings {
+			if i >= *top {
+				break
+			}
+			fmt.Printf("%3d  %s  %8.2f  peak %8.2f  %d matches\n", i+1, r.UserID, r.Rating, r.PeakRating, r.MatchesRated)
+		}
+		return
+	}
+
+	result, err := ratingService.Recompute(ctx)
+	if err != nil {
+		slog.Error("Failed to recompute ratings", "error", err)
+		os.Exit(1)
+	}
+
+	slog.Info("Ratings recomputed", "matches", result.Matches, "drivers", result.Drivers)
+}
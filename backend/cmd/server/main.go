@@ -6,8 +6,10 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
+	_ "time/tzdata" // League timezones must resolve on hosts without a zoneinfo database
 
 	"github.com/f1-rivals-cup/backend/internal/auth"
 	"github.com/f1-rivals-cup/backend/internal/config"
@@ -15,9 +17,11 @@ import (
 	"github.com/f1-rivals-cup/backend/internal/discord"
 	"github.com/f1-rivals-cup/backend/internal/handler"
 	custommiddleware "github.com/f1-rivals-cup/backend/internal/middleware"
+	"github.com/f1-rivals-cup/backend/internal/model"
 	"github.com/f1-rivals-cup/backend/internal/repository"
 	"github.com/f1-rivals-cup/backend/internal/scheduler"
 	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/f1-rivals-cup/backend/internal/telemetry"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
@@ -66,6 +70,23 @@ func main() {
 	productRepo := repository.NewProductRepository(db)
 	subscriptionRepo := repository.NewSubscriptionRepository(db)
 	couponRepo := repository.NewCouponRepository(db)
+	pointsSystemRepo := repository.NewPointsSystemRepository(db)
+	standingsRulesRepo := repository.NewStandingsRulesRepository(db)
+	penaltyRepo := repository.NewPenaltyRepository(db)
+	incidentRepo := repository.NewIncidentRepository(db)
+	incidentActivityRepo := repository.NewIncidentActivityRepository(db)
+	licenceRepo := repository.NewLicenceRepository(db)
+	qualifyingRepo := repository.NewQualifyingRepository(db)
+	substitutionRepo := repository.NewSubstitutionRepository(db)
+	sessionRepo := repository.NewSessionRepository(db)
+	telemetryRepo := repository.NewTelemetryRepository(db)
+	careerRepo := repository.NewCareerRepository(db)
+	ratingRepo := repository.NewRatingRepository(db)
+	trackRepo := repository.NewTrackRepository(db)
+	matchStatusEventRepo := repository.NewMatchStatusEventRepository(db)
+	matchRescheduleRepo := repository.NewMatchRescheduleRepository(db)
+	calendarFeedRepo := repository.NewCalendarFeedRepository(db)
+	attendanceRepo := repository.NewAttendanceRepository(db)
 
 	// Initialize OAuth repository
 	oauthRepo := repository.NewOAuthAccountRepository(db)
@@ -82,6 +103,17 @@ func main() {
 
 	// Initialize services
 	aiService := service.NewAIService(cfg.GeminiAPIKey)
+	resultService := service.NewResultService(matchResultRepo, sessionRepo, pointsSystemRepo, penaltyRepo, leagueRepo)
+	standingsService := service.NewStandingsService(matchResultRepo, standingsRulesRepo, qualifyingRepo)
+	licenceService := service.NewLicenceService(licenceRepo, matchRepo)
+	ratingService := service.NewRatingService(ratingRepo, service.RatingParams{
+		Initial: cfg.RatingInitial,
+		KFactor: cfg.RatingKFactor,
+		DNFMode: model.RatingDNFMode(cfg.RatingDNFMode),
+	})
+	lifecycleService := service.NewMatchLifecycleService(matchRepo, sessionRepo, matchStatusEventRepo, cfg.MatchDefaultDuration)
+	attendanceService := service.NewAttendanceService(attendanceRepo, matchRepo, substitutionRepo)
+	telemetryService := service.NewTelemetryService(matchRepo, participantRepo, telemetryRepo, telemetry.NewHub(), cfg.TelemetryUDPAddr != "")
 
 	// Initialize repositories for team change
 	teamChangeRepo := repository.NewTeamChangeRepository(db)
@@ -91,10 +123,21 @@ func main() {
 	healthHandler := handler.NewHealthHandler()
 	authHandler := handler.NewAuthHandlerWithBlacklist(userRepo, refreshTokenRepo, jwtService, tokenBlacklist, oauthRepo, discordService, oauthState)
 	adminHandler := handler.NewAdminHandler(userRepo, permissionHistoryRepo)
-	leagueHandler := handler.NewLeagueHandler(leagueRepo)
-	participantHandler := handler.NewParticipantHandler(participantRepo, leagueRepo, accountRepo)
-	matchHandler := handler.NewMatchHandler(matchRepo, leagueRepo)
-	matchResultHandler := handler.NewMatchResultHandler(matchResultRepo, matchRepo, leagueRepo, participantRepo)
+	leagueHandler := handler.NewLeagueHandler(leagueRepo, resultService)
+	participantHandler := handler.NewParticipantHandler(participantRepo, leagueRepo, accountRepo, licenceService)
+	matchHandler := handler.NewMatchHandler(matchRepo, leagueRepo, sessionRepo, trackRepo, matchStatusEventRepo, matchRescheduleRepo, lifecycleService)
+	calendarFeedHandler := handler.NewCalendarFeedHandler(calendarFeedRepo, leagueRepo, matchRepo, participantRepo, cfg.MatchDefaultDuration)
+	sessionHandler := handler.NewSessionHandler(sessionRepo, matchRepo, participantRepo, resultService, licenceService, lifecycleService)
+	matchResultHandler := handler.NewMatchResultHandler(matchResultRepo, matchRepo, sessionRepo, leagueRepo, participantRepo, resultService, standingsService, licenceService, lifecycleService, sessionHandler)
+	qualifyingHandler := handler.NewQualifyingHandler(qualifyingRepo, matchRepo, participantRepo, licenceService)
+	telemetryHandler := handler.NewTelemetryHandler(telemetryService, telemetryRepo, matchRepo, sessionRepo, participantRepo, sessionHandler)
+	pointsSystemHandler := handler.NewPointsSystemHandler(leagueRepo, resultService)
+	standingsRulesHandler := handler.NewStandingsRulesHandler(standingsRulesRepo, leagueRepo, standingsService)
+	penaltyHandler := handler.NewPenaltyHandler(penaltyRepo, matchRepo, sessionRepo, resultService)
+	substitutionHandler := handler.NewSubstitutionHandler(substitutionRepo, matchRepo, participantRepo)
+	attendanceHandler := handler.NewAttendanceHandler(attendanceRepo, leagueRepo, matchRepo, participantRepo, attendanceService)
+	licenceHandler := handler.NewLicenceHandler(licenceRepo, leagueRepo, matchRepo, participantRepo, licenceService)
+	incidentHandler := handler.NewIncidentHandler(incidentRepo, incidentActivityRepo, participantRepo, matchRepo, sessionRepo, resultService, licenceService)
 	teamHandler := handler.NewTeamHandler(teamRepo, leagueRepo, accountRepo)
 	newsHandler := handler.NewNewsHandler(newsRepo, leagueRepo, aiService)
 	commentHandler := handler.NewCommentHandler(commentRepo)
@@ -103,6 +146,9 @@ func main() {
 	productHandler := handler.NewProductHandler(productRepo, subscriptionRepo)
 	subscriptionHandler := handler.NewSubscriptionHandler(subscriptionRepo, productRepo, accountRepo, participantRepo, couponRepo)
 	couponHandler := handler.NewCouponHandler(couponRepo, productRepo)
+	careerHandler := handler.NewCareerHandler(userRepo, careerRepo)
+	ratingHandler := handler.NewRatingHandler(ratingRepo, userRepo, ratingService)
+	trackHandler := handler.NewTrackHandler(trackRepo)
 
 	// Initialize Echo
 	e := echo.New()
@@ -173,23 +219,83 @@ func main() {
 	adminGroup.GET("/leagues/:id", leagueHandler.Get)
 	adminGroup.PUT("/leagues/:id", leagueHandler.Update)
 	adminGroup.DELETE("/leagues/:id", leagueHandler.Delete)
+	adminGroup.PUT("/leagues/:id/points-system", pointsSystemHandler.Update)
+	adminGroup.PUT("/leagues/:id/standings-rules", standingsRulesHandler.Update)
+	adminGroup.POST("/ratings/recompute", ratingHandler.Recompute)
 
 	// Admin participant routes
 	adminGroup.GET("/leagues/:id/participants", participantHandler.ListByLeague)
 	adminGroup.PUT("/participants/:id/status", participantHandler.UpdateStatus)
 	adminGroup.PUT("/participants/:id/team", participantHandler.UpdateTeam)
 
+	// Admin track catalogue routes
+	adminGroup.POST("/tracks", trackHandler.Create)
+	adminGroup.PUT("/tracks/:id", trackHandler.Update)
+	adminGroup.DELETE("/tracks/:id", trackHandler.Delete)
+
 	// Admin match routes
 	adminGroup.POST("/leagues/:id/matches", matchHandler.Create)
 	adminGroup.PUT("/matches/:id", matchHandler.Update)
 	adminGroup.DELETE("/matches/:id", matchHandler.Delete)
+	adminGroup.GET("/matches/:id/status-events", matchHandler.ListStatusEvents)
+	adminGroup.POST("/matches/:id/postpone", matchHandler.Postpone)
+	adminGroup.POST("/matches/:id/reschedule", matchHandler.Reschedule)
+	adminGroup.GET("/matches/:id/availability", attendanceHandler.Availability)
+	adminGroup.PUT("/leagues/:id/attendance-settings", attendanceHandler.UpdateSettings)
+	adminGroup.PUT("/leagues/:id/reserve-priority", attendanceHandler.UpdateReservePriority)
+	adminGroup.GET("/leagues/:id/attendance-strikes", attendanceHandler.ListStrikes)
+	adminGroup.POST("/leagues/:id/calendar/preview", matchHandler.PreviewCalendar)
+	adminGroup.POST("/leagues/:id/calendar", matchHandler.GenerateCalendar)
+	adminGroup.POST("/leagues/:id/calendar/rounds", matchHandler.InsertRound)
+
+	// Admin race weekend session routes
+	adminGroup.POST("/matches/:id/sessions", sessionHandler.Create)
+	adminGroup.PUT("/sessions/:id", sessionHandler.Update)
+	adminGroup.DELETE("/sessions/:id", sessionHandler.Delete)
+	adminGroup.PUT("/sessions/:id/results", sessionHandler.UpdateResults)
+	adminGroup.DELETE("/sessions/:id/results", sessionHandler.DeleteResults)
+	adminGroup.PUT("/sessions/:id/results/finalise", sessionHandler.FinaliseResults)
+	adminGroup.PUT("/matches/:id/results/finalise", sessionHandler.FinaliseMatchResults)
+
+	// Admin telemetry routes
+	adminGroup.GET("/telemetry/status", telemetryHandler.Status)
+	adminGroup.POST("/matches/:id/telemetry/attach", telemetryHandler.Attach)
+	adminGroup.DELETE("/telemetry/attach", telemetryHandler.Detach)
+	adminGroup.GET("/matches/:id/telemetry/classification", telemetryHandler.ListClassification)
+	adminGroup.POST("/matches/:id/telemetry/classification/confirm", telemetryHandler.ConfirmClassification)
+	adminGroup.PUT("/telemetry-classifications/:id", telemetryHandler.AssignDriver)
 
 	// Admin match result routes
 	adminGroup.PUT("/matches/:id/results", matchResultHandler.BulkUpdate)
+	adminGroup.POST("/matches/:id/results/import/preview", matchResultHandler.PreviewImport)
+	adminGroup.POST("/matches/:id/results/import", matchResultHandler.Import)
 	adminGroup.PUT("/matches/:id/results/sprint", matchResultHandler.UpdateSprintResults)
 	adminGroup.PUT("/matches/:id/results/race", matchResultHandler.UpdateRaceResults)
+	adminGroup.PUT("/matches/:id/results/qualifying", qualifyingHandler.UpdateQualifying)
+	adminGroup.PUT("/matches/:id/results/sprint-shootout", qualifyingHandler.UpdateSprintShootout)
 	adminGroup.DELETE("/matches/:id/results", matchResultHandler.Delete)
 
+	// Admin penalty routes
+	adminGroup.POST("/matches/:id/penalties", penaltyHandler.Create)
+	adminGroup.DELETE("/penalties/:id", penaltyHandler.Revoke)
+
+	// Admin reserve substitution routes
+	adminGroup.POST("/matches/:id/substitutions", substitutionHandler.Create)
+	adminGroup.DELETE("/substitutions/:id", substitutionHandler.Delete)
+
+	// Admin incident report routes (stewards)
+	adminGroup.GET("/leagues/:id/incident-reports", incidentHandler.ListByLeague, custommiddleware.RequirePermission(auth.PermIncidentReview))
+	adminGroup.GET("/incident-reports/:id/activity", incidentHandler.ListActivity, custommiddleware.RequirePermission(auth.PermIncidentReview))
+	adminGroup.PUT("/incident-reports/:id/review", incidentHandler.StartReview, custommiddleware.RequirePermission(auth.PermIncidentReview))
+	adminGroup.POST("/incident-reports/:id/notes", incidentHandler.AddNote, custommiddleware.RequirePermission(auth.PermIncidentReview))
+	adminGroup.PUT("/incident-reports/:id/decision", incidentHandler.Decide, custommiddleware.RequirePermission(auth.PermIncidentReview))
+	adminGroup.PUT("/incident-reports/:id/publish", incidentHandler.Publish, custommiddleware.RequirePermission(auth.PermIncidentReview))
+
+	// Admin super licence routes
+	adminGroup.PUT("/leagues/:id/licence-settings", licenceHandler.UpdateSettings)
+	adminGroup.GET("/leagues/:id/licence-history", licenceHandler.History, custommiddleware.RequirePermission(auth.PermIncidentReview))
+	adminGroup.POST("/leagues/:id/licence-points", licenceHandler.Award, custommiddleware.RequirePermission(auth.PermIncidentReview))
+
 	// Admin team routes
 	adminGroup.POST("/leagues/:id/teams", teamHandler.Create)
 	adminGroup.PUT("/teams/:id", teamHandler.Update)
@@ -224,9 +330,20 @@ func main() {
 	// Public league routes
 	leagueGroup := v1.Group("/leagues")
 	leagueGroup.GET("", leagueHandler.List)
+	leagueGroup.GET("/settings-schema", leagueHandler.GetSettingsSchema)
 	leagueGroup.GET("/:id", leagueHandler.Get)
 	leagueGroup.GET("/:id/matches", matchHandler.List)
+	leagueGroup.GET("/:id/calendar.ics", calendarFeedHandler.LeagueFeed)
+	leagueGroup.GET("/:id/attendance-settings", attendanceHandler.GetSettings)
 	leagueGroup.GET("/:id/standings", matchResultHandler.Standings)
+	leagueGroup.GET("/:id/standings/progression", matchResultHandler.Progression)
+	leagueGroup.GET("/:id/standings/outlook", matchResultHandler.Outlook)
+	leagueGroup.GET("/:id/standings/export", matchResultHandler.ExportStandings)
+	leagueGroup.GET("/:id/head-to-head", matchResultHandler.HeadToHead)
+	leagueGroup.GET("/:id/points-system", pointsSystemHandler.Get)
+	leagueGroup.GET("/:id/standings-rules", standingsRulesHandler.Get)
+	leagueGroup.GET("/:id/incident-decisions", incidentHandler.ListDecisions)
+	leagueGroup.GET("/:id/licence-settings", licenceHandler.GetSettings)
 	leagueGroup.GET("/:id/teams", teamHandler.List)
 	leagueGroup.GET("/:id/participants", participantHandler.ListApprovedByLeague)
 	leagueGroup.GET("/:id/news", newsHandler.List)
@@ -258,7 +375,29 @@ func main() {
 	// Public match routes
 	matchGroup := v1.Group("/matches")
 	matchGroup.GET("/:id", matchHandler.Get)
+	matchGroup.GET("/:id/reschedules", matchHandler.ListReschedules)
 	matchGroup.GET("/:id/results", matchResultHandler.List)
+	matchGroup.GET("/:id/results/export", matchResultHandler.Export)
+	matchGroup.GET("/:id/qualifying", qualifyingHandler.ListQualifying)
+	matchGroup.GET("/:id/sprint-shootout", qualifyingHandler.ListSprintShootout)
+	matchGroup.GET("/:id/sessions", sessionHandler.List)
+	matchGroup.GET("/:id/sessions/:sessionId/results", sessionHandler.ListResults)
+	matchGroup.GET("/:id/penalties", penaltyHandler.List)
+	matchGroup.GET("/:id/substitutions", substitutionHandler.List)
+	matchGroup.GET("/:id/live-timing", telemetryHandler.LiveTiming)
+	matchGroup.GET("/:id/live-timing/stream", telemetryHandler.LiveTimingStream)
+
+	// Match attendance routes (protected)
+	protectedMatchGroup := v1.Group("/matches")
+	protectedMatchGroup.Use(authMiddleware)
+	protectedMatchGroup.PUT("/:id/rsvp", attendanceHandler.RSVP)
+	protectedMatchGroup.GET("/:id/availability", attendanceHandler.TeamAvailability)
+
+	// Reserve seat offer routes (protected)
+	reserveOfferGroup := v1.Group("/reserve-offers")
+	reserveOfferGroup.Use(authMiddleware)
+	reserveOfferGroup.POST("/:id/accept", attendanceHandler.AcceptOffer)
+	reserveOfferGroup.POST("/:id/decline", attendanceHandler.DeclineOffer)
 
 	// League participation routes (protected)
 	leagueGroup.Use(optionalAuthMiddleware)
@@ -277,6 +416,29 @@ func main() {
 	protectedLeagueGroup.PUT("/:id/team-change-requests/:requestId", teamChangeHandler.ReviewRequest)
 	protectedLeagueGroup.DELETE("/:id/team-change-requests/:requestId", teamChangeHandler.CancelRequest)
 
+	// Incident report routes (participants)
+	protectedLeagueGroup.POST("/:id/incident-reports", incidentHandler.Create)
+	protectedLeagueGroup.GET("/:id/my-incident-reports", incidentHandler.ListMine)
+	protectedLeagueGroup.DELETE("/:id/incident-reports/:reportId", incidentHandler.Withdraw)
+
+	// Public driver profile routes
+	userGroup := v1.Group("/users")
+	userGroup.GET("/:id/career", careerHandler.Get)
+	userGroup.GET("/:id/ratings", ratingHandler.History)
+
+	// Public calendar feed routes, personal feeds are authorised by the token in the URL
+	v1.GET("/calendar/:token", calendarFeedHandler.PersonalFeed)
+
+	// Public rating routes
+	ratingGroup := v1.Group("/ratings")
+	ratingGroup.GET("", ratingHandler.Leaderboard)
+
+	// Public track catalogue routes
+	trackGroup := v1.Group("/tracks")
+	trackGroup.GET("", trackHandler.List)
+	trackGroup.GET("/:id", trackHandler.Get)
+	trackGroup.GET("/:id/stats", trackHandler.Stats)
+
 	// Public product routes
 	productGroup := v1.Group("/products")
 	productGroup.GET("", productHandler.List)
@@ -310,6 +472,9 @@ func main() {
 	meGroup := v1.Group("/me")
 	meGroup.Use(authMiddleware)
 	meGroup.GET("/participations", participantHandler.ListMyParticipations)
+	meGroup.GET("/calendar", calendarFeedHandler.GetMyFeed)
+	meGroup.POST("/calendar/rotate", calendarFeedHandler.RotateMyFeed)
+	meGroup.GET("/reserve-offers", attendanceHandler.ListMyOffers)
 	meGroup.GET("/products", productHandler.ListMy, custommiddleware.RequirePermission(auth.PermStoreCreate))
 	meGroup.GET("/subscriptions", subscriptionHandler.ListMy)
 	meGroup.GET("/orders", subscriptionHandler.ListMyOrders)
@@ -318,16 +483,22 @@ func main() {
 
 	// Initialize and start schedulers
 	ctx, cancel := context.WithCancel(context.Background())
-	matchScheduler := scheduler.New(matchRepo, 10*time.Minute)
+	matchScheduler := scheduler.New(lifecycleService, 10*time.Minute)
 	go matchScheduler.Start(ctx)
 	subScheduler := scheduler.NewSubscriptionScheduler(subscriptionRepo, 5*time.Minute)
 	go subScheduler.Start(ctx)
+	resultsScheduler := scheduler.NewResultsScheduler(sessionRepo, service.DefaultAppealWindowHours, 5*time.Minute)
+	go resultsScheduler.Start(ctx)
+	ratingScheduler := scheduler.NewRatingScheduler(ratingService, 15*time.Minute)
+	go ratingScheduler.Start(ctx)
+	attendanceScheduler := scheduler.NewAttendanceScheduler(attendanceService, 5*time.Minute)
+	go attendanceScheduler.Start(ctx)
 
 	// Discord Bot (only start if configured)
 	var discordBot *discord.Bot
 	if cfg.DiscordBotToken != "" {
 		var err error
-		discordBot, err = discord.NewBot(cfg.DiscordBotToken, cfg.DiscordGuildID, leagueRepo, matchRepo, matchResultRepo)
+		discordBot, err = discord.NewBot(cfg.DiscordBotToken, cfg.DiscordGuildID, leagueRepo, matchRepo, sessionRepo, participantRepo, trackRepo, standingsService)
 		if err != nil {
 			slog.Error("Failed to create Discord bot", "error", err)
 		} else {
@@ -335,6 +506,11 @@ func main() {
 		}
 	}
 
+	// Telemetry listener (only start if configured)
+	if cfg.TelemetryUDPAddr != "" {
+		go startTelemetryListener(ctx, cfg, telemetryService)
+	}
+
 	// Start server with graceful shutdown
 	go func() {
 		slog.Info("Starting server", "port", cfg.ServerPort)
@@ -355,6 +531,9 @@ func main() {
 	cancel()
 	matchScheduler.Stop()
 	subScheduler.Stop()
+	resultsScheduler.Stop()
+	ratingScheduler.Stop()
+	attendanceScheduler.Stop()
 
 	// Stop Discord bot
 	if discordBot != nil {
@@ -378,3 +557,34 @@ func main() {
 
 	slog.Info("Server gracefully stopped")
 }
+
+// startTelemetryListener receives game telemetry until ctx is cancelled,
+// recording every packet to a capture file when a capture directory is configured
+func startTelemetryListener(ctx context.Context, cfg *config.Config, telemetryService *service.TelemetryService) {
+	var recorder *telemetry.Recorder
+	if cfg.TelemetryCaptureDir != "" {
+		path := filepath.Join(cfg.TelemetryCaptureDir, "capture-"+time.Now().Format("20060102-150405")+".bin")
+		f, err := os.Create(path)
+		if err != nil {
+			slog.Error("Failed to create telemetry capture file", "error", err, "path", path)
+		} else {
+			defer f.Close()
+			recorder = telemetry.NewRecorder(f)
+			slog.Info("Recording telemetry capture", "path", path)
+		}
+	}
+
+	err := telemetry.Listen(ctx, cfg.TelemetryUDPAddr, func(packet []byte) {
+		if recorder != nil {
+			if err := recorder.Write(time.Now(), packet); err != nil {
+				slog.Error("Failed to record telemetry packet", "error", err)
+			}
+		}
+		if err := telemetryService.HandlePacket(ctx, packet); err != nil {
+			slog.Error("Failed to handle telemetry packet", "error", err)
+		}
+	})
+	if err != nil {
+		slog.Error("Telemetry listener failed", "error", err)
+	}
+}
@@ -0,0 +1,52 @@
+// Command telemetry-replay sends the packets of a recorded telemetry capture to a UDP listener,
+// so the live timing feed can be exercised without running the game.
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/f1-rivals-cup/backend/internal/telemetry"
+)
+
+func main() {
+	file := flag.String("file", "", "telemetry capture file to replay")
+	addr := flag.String("addr", "127.0.0.1:20777", "UDP address of the telemetry listener")
+	realtime := flag.Bool("realtime", true, "keep the recorded gaps between packets")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	conn, err := net.Dial("udp", *addr)
+	if err != nil {
+		slog.Error("Failed to connect to telemetry listener", "error", err, "addr", *addr)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	sent := 0
+	err = telemetry.ReplayFile(ctx, *file, *realtime, func(packet []byte) error {
+		if _, err := conn.Write(packet); err != nil {
+			return err
+		}
+		sent++
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		slog.Error("Failed to replay telemetry capture", "error", err, "file", *file)
+		os.Exit(1)
+	}
+
+	slog.Info("Telemetry replay finished", "packets", sent)
+}
@@ -0,0 +1,3 @@
+ALTER TABLE match_results DROP COLUMN IF EXISTS sprint_points_manual;
+ALTER TABLE match_results DROP COLUMN IF EXISTS points_manual;
+DROP TABLE IF EXISTS points_systems;
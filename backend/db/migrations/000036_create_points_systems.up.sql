@@ -0,0 +1,24 @@
+-- 리그별 포인트 시스템 (레이스/스프린트 포인트 테이블, 패스티스트 랩 보너스)
+CREATE TABLE IF NOT EXISTS points_systems (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    league_id UUID NOT NULL UNIQUE REFERENCES leagues(id) ON DELETE CASCADE,
+    race_points DECIMAL(5,1)[] NOT NULL DEFAULT '{25,18,15,12,10,8,6,4,2,1}',
+    sprint_points DECIMAL(5,1)[] NOT NULL DEFAULT '{8,7,6,5,4,3,2,1}',
+    fastest_lap_points DECIMAL(5,1) NOT NULL DEFAULT 1,
+    fastest_lap_max_position INT DEFAULT 10,
+    dnf_no_points BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN points_systems.race_points IS 'Points awarded per race position (index 0 = P1)';
+COMMENT ON COLUMN points_systems.sprint_points IS 'Points awarded per sprint position (index 0 = P1)';
+COMMENT ON COLUMN points_systems.fastest_lap_max_position IS 'Fastest lap bonus only awarded within top N (NULL = no restriction)';
+COMMENT ON COLUMN points_systems.dnf_no_points IS 'DNF drivers score no points even if classified';
+
+-- 수동 입력 포인트 플래그 (자동 계산 대상에서 제외)
+ALTER TABLE match_results ADD COLUMN points_manual BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE match_results ADD COLUMN sprint_points_manual BOOLEAN NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN match_results.points_manual IS 'Race points were entered manually and are not recalculated';
+COMMENT ON COLUMN match_results.sprint_points_manual IS 'Sprint points were entered manually and are not recalculated';
@@ -0,0 +1,16 @@
+-- 리그별 챔피언십 순위 규칙 (베스트 N 라운드, 최하위 라운드 제외)
+CREATE TABLE IF NOT EXISTS standings_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    league_id UUID NOT NULL UNIQUE REFERENCES leagues(id) ON DELETE CASCADE,
+    counted_rounds INT,
+    drop_worst_rounds INT NOT NULL DEFAULT 0,
+    apply_to_teams BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_standings_rules_counted_rounds CHECK (counted_rounds IS NULL OR counted_rounds > 0),
+    CONSTRAINT chk_standings_rules_drop_worst CHECK (drop_worst_rounds >= 0)
+);
+
+COMMENT ON COLUMN standings_rules.counted_rounds IS 'Only the best N round scores count (NULL = all rounds)';
+COMMENT ON COLUMN standings_rules.drop_worst_rounds IS 'Number of worst round scores dropped from the total';
+COMMENT ON COLUMN standings_rules.apply_to_teams IS 'Whether the same rules apply to the team championship';
@@ -0,0 +1,8 @@
+ALTER TABLE match_results DROP COLUMN IF EXISTS sprint_disqualified;
+ALTER TABLE match_results DROP COLUMN IF EXISTS disqualified;
+ALTER TABLE match_results DROP COLUMN IF EXISTS original_sprint_position;
+ALTER TABLE match_results DROP COLUMN IF EXISTS original_position;
+ALTER TABLE match_results DROP COLUMN IF EXISTS sprint_time_ms;
+ALTER TABLE match_results DROP COLUMN IF EXISTS race_time_ms;
+
+DROP TABLE IF EXISTS penalties;
@@ -0,0 +1,34 @@
+-- 스튜어드 페널티 (시간 페널티, 순위 강등, 실격)
+CREATE TABLE IF NOT EXISTS penalties (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    session VARCHAR(10) NOT NULL CHECK (session IN ('race', 'sprint')),
+    type VARCHAR(20) NOT NULL CHECK (type IN ('time', 'position_drop', 'disqualification')),
+    value INT NOT NULL DEFAULT 0 CHECK (value >= 0),
+    reason TEXT NOT NULL,
+    issued_by UUID NOT NULL REFERENCES users(id),
+    revoked_by UUID REFERENCES users(id),
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_penalties_match_id ON penalties(match_id);
+CREATE INDEX idx_penalties_participant_id ON penalties(participant_id);
+
+COMMENT ON COLUMN penalties.value IS 'Seconds for time penalties, places for position drops, unused for disqualifications';
+
+-- 페널티 적용 전 원본 순위와 기록 시간
+ALTER TABLE match_results ADD COLUMN race_time_ms BIGINT;
+ALTER TABLE match_results ADD COLUMN sprint_time_ms BIGINT;
+ALTER TABLE match_results ADD COLUMN original_position INT;
+ALTER TABLE match_results ADD COLUMN original_sprint_position INT;
+ALTER TABLE match_results ADD COLUMN disqualified BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE match_results ADD COLUMN sprint_disqualified BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE match_results SET original_position = position, original_sprint_position = sprint_position;
+
+COMMENT ON COLUMN match_results.race_time_ms IS 'Total race time in milliseconds, required for time penalties';
+COMMENT ON COLUMN match_results.original_position IS 'Race position as entered, before penalties were applied';
+COMMENT ON COLUMN match_results.original_sprint_position IS 'Sprint position as entered, before penalties were applied';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS incident_activity_log;
+DROP TABLE IF EXISTS incident_reports;
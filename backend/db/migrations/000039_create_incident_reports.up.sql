@@ -0,0 +1,46 @@
+-- Incident reports filed by participants for steward review
+CREATE TABLE IF NOT EXISTS incident_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    session VARCHAR(10) NOT NULL CHECK (session IN ('race', 'sprint')),
+    reporter_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    accused_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    lap INT CHECK (lap > 0),
+    description TEXT NOT NULL,
+    evidence_links TEXT[] NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'submitted',
+    decision VARCHAR(30),
+    decision_reason TEXT,
+    penalty_id UUID REFERENCES penalties(id) ON DELETE SET NULL,
+    reviewed_by UUID REFERENCES users(id),
+    decided_at TIMESTAMP WITH TIME ZONE,
+    published_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_incident_reports_different_parties CHECK (reporter_id <> accused_id)
+);
+
+CREATE INDEX idx_incident_reports_match_id ON incident_reports(match_id);
+CREATE INDEX idx_incident_reports_reporter_id ON incident_reports(reporter_id);
+CREATE INDEX idx_incident_reports_accused_id ON incident_reports(accused_id);
+CREATE INDEX idx_incident_reports_status ON incident_reports(status);
+
+ALTER TABLE incident_reports ADD CONSTRAINT chk_incident_reports_status
+    CHECK (status IN ('submitted', 'under_review', 'decided', 'published', 'withdrawn'));
+ALTER TABLE incident_reports ADD CONSTRAINT chk_incident_reports_decision
+    CHECK (decision IS NULL OR decision IN ('no_further_action', 'warning', 'penalty'));
+
+-- Incident activity log table for audit purposes
+CREATE TABLE IF NOT EXISTS incident_activity_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_id UUID NOT NULL REFERENCES users(id),
+    report_id UUID NOT NULL REFERENCES incident_reports(id) ON DELETE CASCADE,
+    action_type VARCHAR(20) NOT NULL,
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_ial_report ON incident_activity_log(report_id, created_at DESC);
+
+ALTER TABLE incident_activity_log ADD CONSTRAINT chk_ial_action_type
+    CHECK (action_type IN ('CREATE', 'REVIEW', 'NOTE', 'DECIDE', 'PUBLISH', 'WITHDRAW'));
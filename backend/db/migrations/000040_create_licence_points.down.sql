@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS licence_points;
+DROP TABLE IF EXISTS race_bans;
+DROP TABLE IF EXISTS licence_settings;
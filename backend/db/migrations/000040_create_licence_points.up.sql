@@ -0,0 +1,43 @@
+-- 리그별 슈퍼라이선스 설정 (출전 정지 기준 점수, 벌점 만료 조건)
+CREATE TABLE IF NOT EXISTS licence_settings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    league_id UUID NOT NULL UNIQUE REFERENCES leagues(id) ON DELETE CASCADE,
+    ban_threshold INT NOT NULL DEFAULT 12 CHECK (ban_threshold > 0),
+    expiry_rounds INT CHECK (expiry_rounds > 0),
+    expiry_days INT CHECK (expiry_days > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN licence_settings.expiry_rounds IS 'Points expire once this many rounds have been completed after the awarding round (NULL = never)';
+COMMENT ON COLUMN licence_settings.expiry_days IS 'Points expire this many days after being awarded (NULL = never)';
+
+-- 출전 정지 기록
+CREATE TABLE IF NOT EXISTS race_bans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    points_at_ban INT NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (participant_id, match_id)
+);
+
+CREATE INDEX idx_race_bans_match_id ON race_bans(match_id);
+
+-- 라이선스 벌점
+CREATE TABLE IF NOT EXISTS licence_points (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    points INT NOT NULL CHECK (points > 0),
+    reason TEXT NOT NULL,
+    incident_report_id UUID REFERENCES incident_reports(id) ON DELETE SET NULL,
+    issued_by UUID NOT NULL REFERENCES users(id),
+    ban_id UUID REFERENCES race_bans(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_licence_points_participant_id ON licence_points(participant_id, created_at DESC);
+
+COMMENT ON COLUMN licence_points.ban_id IS 'Ban these points triggered; consumed points no longer count towards the threshold';
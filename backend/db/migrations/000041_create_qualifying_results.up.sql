@@ -0,0 +1,23 @@
+-- 예선 / 스프린트 슛아웃 결과와 출발 그리드
+CREATE TABLE IF NOT EXISTS qualifying_results (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    session VARCHAR(20) NOT NULL CHECK (session IN ('qualifying', 'sprint_shootout')),
+    team_name VARCHAR(100),
+    position INT NOT NULL CHECK (position > 0),
+    best_lap_ms BIGINT CHECK (best_lap_ms > 0),
+    gap_ms BIGINT,
+    grid_penalty INT NOT NULL DEFAULT 0 CHECK (grid_penalty >= 0),
+    pit_lane_start BOOLEAN NOT NULL DEFAULT false,
+    grid_position INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (match_id, participant_id, session)
+);
+
+CREATE INDEX idx_qualifying_results_match_id ON qualifying_results(match_id, session);
+
+COMMENT ON COLUMN qualifying_results.gap_ms IS 'Gap to the fastest qualifier, derived from best lap times';
+COMMENT ON COLUMN qualifying_results.grid_penalty IS 'Grid places dropped from the qualifying position';
+COMMENT ON COLUMN qualifying_results.grid_position IS 'Starting slot for the race (qualifying) or sprint (sprint_shootout)';
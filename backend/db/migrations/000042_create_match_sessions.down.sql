@@ -0,0 +1,66 @@
+ALTER TABLE matches ADD COLUMN has_sprint BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE matches ADD COLUMN sprint_date DATE;
+ALTER TABLE matches ADD COLUMN sprint_time TIME;
+ALTER TABLE matches ADD COLUMN sprint_status VARCHAR(20) DEFAULT 'upcoming';
+
+UPDATE matches m
+SET has_sprint = true, sprint_date = s.session_date, sprint_time = s.session_time, sprint_status = s.status
+FROM match_sessions s
+WHERE s.match_id = m.id AND s.type = 'sprint';
+
+CREATE TABLE IF NOT EXISTS match_results (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    team_name VARCHAR(100),
+    position INT,
+    points DECIMAL(5,1) NOT NULL DEFAULT 0,
+    points_manual BOOLEAN NOT NULL DEFAULT false,
+    fastest_lap BOOLEAN NOT NULL DEFAULT false,
+    dnf BOOLEAN NOT NULL DEFAULT false,
+    dnf_reason VARCHAR(100),
+    sprint_position INT,
+    sprint_points DECIMAL(5,1) NOT NULL DEFAULT 0,
+    sprint_points_manual BOOLEAN NOT NULL DEFAULT false,
+    race_time_ms BIGINT,
+    sprint_time_ms BIGINT,
+    original_position INT,
+    original_sprint_position INT,
+    disqualified BOOLEAN NOT NULL DEFAULT false,
+    sprint_disqualified BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE(match_id, participant_id)
+);
+
+CREATE INDEX idx_match_results_match_id ON match_results(match_id);
+CREATE INDEX idx_match_results_participant_id ON match_results(participant_id);
+CREATE INDEX idx_match_results_position ON match_results(position);
+CREATE INDEX idx_match_results_team_name ON match_results(team_name);
+
+-- Only the race and sprint sessions fit the old layout; other session results are discarded
+INSERT INTO match_results (match_id, participant_id, team_name, position, points, points_manual, fastest_lap,
+                           dnf, dnf_reason, race_time_ms, original_position, disqualified)
+SELECT s.match_id, r.participant_id, r.team_name, r.position, r.points, r.points_manual, r.fastest_lap,
+       r.dnf, r.dnf_reason, r.time_ms, r.original_position, r.disqualified
+FROM session_results r
+JOIN match_sessions s ON r.session_id = s.id
+WHERE s.type = 'race';
+
+INSERT INTO match_results (match_id, participant_id, team_name, sprint_position, sprint_points, sprint_points_manual,
+                           sprint_time_ms, original_sprint_position, sprint_disqualified)
+SELECT s.match_id, r.participant_id, r.team_name, r.position, r.points, r.points_manual,
+       r.time_ms, r.original_position, r.disqualified
+FROM session_results r
+JOIN match_sessions s ON r.session_id = s.id
+WHERE s.type = 'sprint'
+ON CONFLICT (match_id, participant_id) DO UPDATE SET
+    sprint_position = EXCLUDED.sprint_position,
+    sprint_points = EXCLUDED.sprint_points,
+    sprint_points_manual = EXCLUDED.sprint_points_manual,
+    sprint_time_ms = EXCLUDED.sprint_time_ms,
+    original_sprint_position = EXCLUDED.original_sprint_position,
+    sprint_disqualified = EXCLUDED.sprint_disqualified;
+
+DROP TABLE IF EXISTS session_results;
+DROP TABLE IF EXISTS match_sessions;
@@ -0,0 +1,84 @@
+-- 레이스 위크엔드 세션 (연습, 예선, 스프린트, 본선, 피처 레이스, 리버스 그리드, 내구 스틴트)
+CREATE TABLE IF NOT EXISTS match_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN (
+        'practice', 'qualifying', 'sprint_shootout', 'sprint', 'race',
+        'feature_race', 'reverse_grid_race', 'endurance_stint'
+    )),
+    name VARCHAR(100),
+    session_order INT NOT NULL,
+    session_date DATE,
+    session_time TIME,
+    status VARCHAR(20) NOT NULL DEFAULT 'upcoming' CHECK (status IN ('upcoming', 'in_progress', 'completed', 'cancelled')),
+    reverse_grid_size INT CHECK (reverse_grid_size > 0),
+    duration_minutes INT CHECK (duration_minutes > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (match_id, session_order)
+);
+
+CREATE INDEX idx_match_sessions_match_id ON match_sessions(match_id);
+CREATE INDEX idx_match_sessions_status ON match_sessions(status, session_date);
+
+COMMENT ON COLUMN match_sessions.reverse_grid_size IS 'Number of top finishers reversed from the previous session (reverse_grid_race only)';
+COMMENT ON COLUMN match_sessions.duration_minutes IS 'Scheduled length of a timed session such as an endurance stint';
+
+-- 세션별 결과
+CREATE TABLE IF NOT EXISTS session_results (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    session_id UUID NOT NULL REFERENCES match_sessions(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    team_name VARCHAR(100),
+    position INT,
+    points DECIMAL(5,1) NOT NULL DEFAULT 0,
+    points_manual BOOLEAN NOT NULL DEFAULT false,
+    fastest_lap BOOLEAN NOT NULL DEFAULT false,
+    dnf BOOLEAN NOT NULL DEFAULT false,
+    dnf_reason VARCHAR(100),
+    time_ms BIGINT,
+    laps INT,
+    original_position INT,
+    disqualified BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (session_id, participant_id)
+);
+
+CREATE INDEX idx_session_results_participant_id ON session_results(participant_id);
+CREATE INDEX idx_session_results_team_name ON session_results(team_name);
+
+-- 기존 본선 / 스프린트 일정을 세션으로 이전
+INSERT INTO match_sessions (match_id, type, session_order, session_date, session_time, status)
+SELECT id, 'sprint', 1, COALESCE(sprint_date, match_date), sprint_time, COALESCE(sprint_status, 'upcoming')
+FROM matches
+WHERE has_sprint;
+
+INSERT INTO match_sessions (match_id, type, session_order, session_date, session_time, status)
+SELECT id, 'race', 2, match_date, match_time, status
+FROM matches;
+
+-- 기존 결과를 세션 결과로 이전
+INSERT INTO session_results (session_id, participant_id, team_name, position, points, points_manual, fastest_lap,
+                             dnf, dnf_reason, time_ms, original_position, disqualified, created_at, updated_at)
+SELECT s.id, mr.participant_id, mr.team_name, mr.position, mr.points, mr.points_manual, mr.fastest_lap,
+       mr.dnf, mr.dnf_reason, mr.race_time_ms, mr.original_position, mr.disqualified, mr.created_at, mr.updated_at
+FROM match_results mr
+JOIN match_sessions s ON s.match_id = mr.match_id AND s.type = 'race';
+
+INSERT INTO session_results (session_id, participant_id, team_name, position, points, points_manual,
+                             time_ms, original_position, disqualified, created_at, updated_at)
+SELECT s.id, mr.participant_id, mr.team_name, mr.sprint_position, mr.sprint_points, mr.sprint_points_manual,
+       mr.sprint_time_ms, mr.original_sprint_position, mr.sprint_disqualified, mr.created_at, mr.updated_at
+FROM match_results mr
+JOIN match_sessions s ON s.match_id = mr.match_id AND s.type = 'sprint'
+WHERE mr.sprint_position IS NOT NULL OR mr.sprint_points <> 0 OR mr.sprint_disqualified;
+
+DROP TABLE match_results;
+
+ALTER TABLE matches DROP COLUMN has_sprint;
+ALTER TABLE matches DROP COLUMN sprint_date;
+ALTER TABLE matches DROP COLUMN sprint_time;
+ALTER TABLE matches DROP COLUMN sprint_status;
+
+COMMENT ON COLUMN matches.status IS 'Race weekend status; each session tracks its own status in match_sessions';
@@ -0,0 +1,24 @@
+-- 게임 텔레메트리 최종 순위 (관리자 확정 전 임시 결과)
+CREATE TABLE IF NOT EXISTS telemetry_classifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    session_type VARCHAR(20) NOT NULL,
+    car_index INT NOT NULL,
+    participant_id UUID REFERENCES league_participants(id) ON DELETE SET NULL,
+    driver_name VARCHAR(48) NOT NULL DEFAULT '',
+    race_number INT NOT NULL DEFAULT 0,
+    position INT NOT NULL,
+    grid_position INT NOT NULL DEFAULT 0,
+    laps INT NOT NULL DEFAULT 0,
+    best_lap_ms BIGINT,
+    total_time_ms BIGINT,
+    penalties_sec INT NOT NULL DEFAULT 0,
+    pit_stops INT NOT NULL DEFAULT 0,
+    result_status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (match_id, session_type, car_index)
+);
+
+CREATE INDEX idx_telemetry_classifications_match_id ON telemetry_classifications(match_id);
+
+COMMENT ON COLUMN telemetry_classifications.total_time_ms IS 'Race time including time penalties';
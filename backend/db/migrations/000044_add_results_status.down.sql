@@ -0,0 +1,7 @@
+ALTER TABLE standings_rules DROP CONSTRAINT IF EXISTS chk_standings_rules_appeal_window;
+ALTER TABLE standings_rules DROP COLUMN IF EXISTS appeal_window_hours;
+
+DROP INDEX IF EXISTS idx_match_sessions_results_status;
+ALTER TABLE match_sessions DROP COLUMN IF EXISTS results_official_at;
+ALTER TABLE match_sessions DROP COLUMN IF EXISTS results_submitted_at;
+ALTER TABLE match_sessions DROP COLUMN IF EXISTS results_status;
@@ -0,0 +1,21 @@
+-- 세션 결과 잠정 / 공식 상태 (이의 제기 기간 종료 또는 관리자 확정 시 공식)
+ALTER TABLE match_sessions ADD COLUMN results_status VARCHAR(20) CHECK (results_status IN ('provisional', 'official'));
+ALTER TABLE match_sessions ADD COLUMN results_submitted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE match_sessions ADD COLUMN results_official_at TIMESTAMP WITH TIME ZONE;
+
+-- 기존 결과는 공식 결과로 간주
+UPDATE match_sessions s
+SET results_status = 'official',
+    results_submitted_at = s.updated_at,
+    results_official_at = s.updated_at
+WHERE EXISTS (SELECT 1 FROM session_results sr WHERE sr.session_id = s.id);
+
+CREATE INDEX idx_match_sessions_results_status ON match_sessions(results_status, results_submitted_at);
+
+COMMENT ON COLUMN match_sessions.results_status IS 'provisional until finalised by an admin or the appeal window closes (NULL = no results)';
+
+-- 리그별 이의 제기 기간
+ALTER TABLE standings_rules ADD COLUMN appeal_window_hours INT NOT NULL DEFAULT 24;
+ALTER TABLE standings_rules ADD CONSTRAINT chk_standings_rules_appeal_window CHECK (appeal_window_hours >= 0);
+
+COMMENT ON COLUMN standings_rules.appeal_window_hours IS 'Hours after submission before provisional results become official automatically';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS rated_matches;
+DROP TABLE IF EXISTS driver_rating_history;
+DROP TABLE IF EXISTS driver_ratings;
@@ -0,0 +1,34 @@
+-- 드라이버 스킬 레이팅 (리그 통합, 완료된 경기를 시간순으로 반영)
+CREATE TABLE IF NOT EXISTS driver_ratings (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    rating DOUBLE PRECISION NOT NULL,
+    peak_rating DOUBLE PRECISION NOT NULL,
+    matches_rated INT NOT NULL DEFAULT 0,
+    last_match_id UUID REFERENCES matches(id) ON DELETE SET NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_driver_ratings_rating ON driver_ratings(rating DESC);
+
+-- 경기별 레이팅 변동 이력
+CREATE TABLE IF NOT EXISTS driver_rating_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    rating_before DOUBLE PRECISION NOT NULL,
+    rating_after DOUBLE PRECISION NOT NULL,
+    position INT NOT NULL,
+    opponents INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, match_id)
+);
+
+CREATE INDEX idx_driver_rating_history_match_id ON driver_rating_history(match_id);
+
+-- 레이팅에 반영된 경기 (결과가 없는 경기도 포함)
+CREATE TABLE IF NOT EXISTS rated_matches (
+    match_id UUID PRIMARY KEY REFERENCES matches(id) ON DELETE CASCADE,
+    processed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN driver_rating_history.position IS 'Effective finishing position used for the pairwise comparison; DNFs share the place behind the last finisher';
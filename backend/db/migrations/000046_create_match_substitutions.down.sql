@@ -0,0 +1,4 @@
+ALTER TABLE standings_rules DROP COLUMN IF EXISTS reserve_round_cap;
+ALTER TABLE standings_rules DROP COLUMN IF EXISTS reserve_points_mode;
+
+DROP TABLE IF EXISTS match_substitutions;
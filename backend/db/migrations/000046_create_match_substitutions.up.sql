@@ -0,0 +1,22 @@
+-- 경기별 리저브 대체 출전 기록 (리저브 결과는 대체한 팀에 귀속)
+CREATE TABLE IF NOT EXISTS match_substitutions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    reserve_participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    replaced_participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    team_name VARCHAR(100) NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_match_substitutions_reserve UNIQUE (match_id, reserve_participant_id),
+    CONSTRAINT uq_match_substitutions_replaced UNIQUE (match_id, replaced_participant_id),
+    CONSTRAINT chk_match_substitutions_distinct CHECK (reserve_participant_id <> replaced_participant_id)
+);
+
+CREATE INDEX idx_match_substitutions_reserve ON match_substitutions(reserve_participant_id);
+
+-- 리저브 드라이버 포인트의 드라이버 챔피언십 반영 방식
+ALTER TABLE standings_rules ADD COLUMN reserve_points_mode VARCHAR(20) NOT NULL DEFAULT 'count'
+    CHECK (reserve_points_mode IN ('count', 'exclude', 'capped'));
+ALTER TABLE standings_rules ADD COLUMN reserve_round_cap INT CHECK (reserve_round_cap >= 0);
+
+COMMENT ON COLUMN standings_rules.reserve_round_cap IS 'With capped mode, how many substitute rounds count towards a reserve''s driver championship points';
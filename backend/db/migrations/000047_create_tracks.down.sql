@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_matches_track_id;
+ALTER TABLE matches DROP COLUMN IF EXISTS track_id;
+
+DROP TABLE IF EXISTS tracks;
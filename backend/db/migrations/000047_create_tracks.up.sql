@@ -0,0 +1,59 @@
+-- 서킷 카탈로그 (경기 트랙 자유 입력 대체)
+CREATE TABLE IF NOT EXISTS tracks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slug VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    country VARCHAR(100) NOT NULL,
+    country_code CHAR(2) NOT NULL,
+    layout VARCHAR(50) NOT NULL DEFAULT 'Grand Prix',
+    full_race_laps INT NOT NULL CHECK (full_race_laps > 0),
+    length_km DECIMAL(5,3) NOT NULL CHECK (length_km > 0),
+    default_race_laps INT NOT NULL CHECK (default_race_laps > 0),
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN tracks.aliases IS 'Lower-case alternative names (city, country, Korean names) used to match free-text track names';
+
+INSERT INTO tracks (slug, name, country, country_code, full_race_laps, length_km, default_race_laps, aliases) VALUES
+    ('bahrain', 'Bahrain International Circuit', 'Bahrain', 'BH', 57, 5.412, 29, ARRAY['bahrain', 'sakhir', '바레인', '사키르']),
+    ('jeddah', 'Jeddah Corniche Circuit', 'Saudi Arabia', 'SA', 50, 6.174, 25, ARRAY['jeddah', 'saudi arabia', '사우디', '사우디아라비아', '제다']),
+    ('albert-park', 'Albert Park Circuit', 'Australia', 'AU', 58, 5.278, 29, ARRAY['albert park', 'melbourne', 'australia', '호주', '멜버른']),
+    ('suzuka', 'Suzuka International Racing Course', 'Japan', 'JP', 53, 5.807, 27, ARRAY['suzuka', 'japan', '일본', '스즈카']),
+    ('shanghai', 'Shanghai International Circuit', 'China', 'CN', 56, 5.451, 28, ARRAY['shanghai', 'china', '중국', '상하이']),
+    ('miami', 'Miami International Autodrome', 'United States', 'US', 57, 5.412, 29, ARRAY['miami', '마이애미']),
+    ('imola', 'Autodromo Enzo e Dino Ferrari', 'Italy', 'IT', 63, 4.909, 32, ARRAY['imola', 'emilia romagna', 'emilia-romagna', '이몰라', '에밀리아로마냐']),
+    ('monaco', 'Circuit de Monaco', 'Monaco', 'MC', 78, 3.337, 39, ARRAY['monaco', 'monte carlo', '모나코', '몬테카를로']),
+    ('montreal', 'Circuit Gilles Villeneuve', 'Canada', 'CA', 70, 4.361, 35, ARRAY['montreal', 'canada', 'gilles villeneuve', '캐나다', '몬트리올']),
+    ('barcelona', 'Circuit de Barcelona-Catalunya', 'Spain', 'ES', 66, 4.657, 33, ARRAY['barcelona', 'catalunya', 'spain', '스페인', '바르셀로나']),
+    ('red-bull-ring', 'Red Bull Ring', 'Austria', 'AT', 71, 4.318, 36, ARRAY['red bull ring', 'spielberg', 'austria', '오스트리아', '레드불링']),
+    ('silverstone', 'Silverstone Circuit', 'United Kingdom', 'GB', 52, 5.891, 26, ARRAY['silverstone', 'great britain', 'britain', 'uk', '영국', '실버스톤']),
+    ('hungaroring', 'Hungaroring', 'Hungary', 'HU', 70, 4.381, 35, ARRAY['hungaroring', 'hungary', 'budapest', '헝가리', '헝가로링']),
+    ('spa', 'Circuit de Spa-Francorchamps', 'Belgium', 'BE', 44, 7.004, 22, ARRAY['spa', 'spa-francorchamps', 'belgium', '벨기에', '스파']),
+    ('zandvoort', 'Circuit Zandvoort', 'Netherlands', 'NL', 72, 4.259, 36, ARRAY['zandvoort', 'netherlands', 'dutch', '네덜란드', '잔드보르트']),
+    ('monza', 'Autodromo Nazionale Monza', 'Italy', 'IT', 53, 5.793, 27, ARRAY['monza', 'italy', '이탈리아', '몬자']),
+    ('baku', 'Baku City Circuit', 'Azerbaijan', 'AZ', 51, 6.003, 26, ARRAY['baku', 'azerbaijan', '아제르바이잔', '바쿠']),
+    ('marina-bay', 'Marina Bay Street Circuit', 'Singapore', 'SG', 62, 4.940, 31, ARRAY['marina bay', 'singapore', '싱가포르', '마리나베이']),
+    ('cota', 'Circuit of the Americas', 'United States', 'US', 56, 5.513, 28, ARRAY['cota', 'austin', 'united states', 'usa', '미국', '오스틴']),
+    ('mexico-city', 'Autódromo Hermanos Rodríguez', 'Mexico', 'MX', 71, 4.304, 36, ARRAY['mexico', 'mexico city', 'hermanos rodriguez', '멕시코']),
+    ('interlagos', 'Autódromo José Carlos Pace', 'Brazil', 'BR', 71, 4.309, 36, ARRAY['interlagos', 'brazil', 'sao paulo', 'são paulo', '브라질', '인터라고스']),
+    ('las-vegas', 'Las Vegas Strip Circuit', 'United States', 'US', 50, 6.201, 25, ARRAY['las vegas', 'vegas', '라스베이거스', '라스베가스']),
+    ('lusail', 'Lusail International Circuit', 'Qatar', 'QA', 57, 5.419, 29, ARRAY['lusail', 'qatar', '카타르', '루사일']),
+    ('yas-marina', 'Yas Marina Circuit', 'United Arab Emirates', 'AE', 58, 5.281, 29, ARRAY['yas marina', 'abu dhabi', '아부다비', '야스마리나']),
+    ('paul-ricard', 'Circuit Paul Ricard', 'France', 'FR', 53, 5.842, 27, ARRAY['paul ricard', 'france', 'le castellet', '프랑스', '폴리카르']),
+    ('portimao', 'Algarve International Circuit', 'Portugal', 'PT', 66, 4.653, 33, ARRAY['portimao', 'portimão', 'algarve', 'portugal', '포르투갈', '포르티망'])
+ON CONFLICT (slug) DO NOTHING;
+
+-- 경기 트랙 참조
+ALTER TABLE matches ADD COLUMN track_id UUID REFERENCES tracks(id) ON DELETE RESTRICT;
+CREATE INDEX idx_matches_track_id ON matches(track_id);
+
+-- 기존 트랙 이름을 카탈로그에 연결하고 표기를 통일 ("Monza GP", "이탈리아 그랑프리" 등 접미사 무시)
+UPDATE matches m
+SET track_id = t.id, track = t.name
+FROM tracks t
+WHERE m.track_id IS NULL
+  AND (LOWER(TRIM(m.track)) IN (LOWER(t.name), t.slug)
+       OR LOWER(TRIM(m.track)) = ANY(t.aliases)
+       OR REGEXP_REPLACE(LOWER(TRIM(m.track)), '\s*(grand prix|gp|그랑프리|서킷)$', '') = ANY(t.aliases));
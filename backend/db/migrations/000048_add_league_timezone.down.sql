@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_match_sessions_status;
+CREATE INDEX idx_match_sessions_status ON match_sessions(status, session_date);
+
+ALTER TABLE match_sessions DROP COLUMN IF EXISTS starts_at;
+ALTER TABLE matches DROP COLUMN IF EXISTS starts_at;
+ALTER TABLE leagues DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,23 @@
+-- 리그별 시간대 (IANA 이름, 예: Asia/Seoul, Europe/London)
+ALTER TABLE leagues ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Seoul';
+
+-- 경기 및 세션 시작 시각 (리그 시간대 기준 날짜/시간에서 계산된 실제 시각)
+ALTER TABLE matches ADD COLUMN starts_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE match_sessions ADD COLUMN starts_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE matches m
+SET starts_at = (m.match_date + COALESCE(m.match_time, TIME '00:00')) AT TIME ZONE l.timezone
+FROM leagues l
+WHERE l.id = m.league_id;
+
+UPDATE match_sessions s
+SET starts_at = (s.session_date + COALESCE(s.session_time, TIME '00:00')) AT TIME ZONE l.timezone
+FROM matches m
+JOIN leagues l ON l.id = m.league_id
+WHERE m.id = s.match_id AND s.session_date IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_match_sessions_status;
+CREATE INDEX idx_match_sessions_status ON match_sessions(status, starts_at);
+
+COMMENT ON COLUMN matches.starts_at IS 'Race start instant, derived from match_date and match_time in the league timezone';
+COMMENT ON COLUMN match_sessions.starts_at IS 'Session start instant, derived from session_date and session_time in the league timezone';
@@ -0,0 +1,17 @@
+-- 경기 및 세션 상태 변경 이력 (자동 전환과 관리자 변경 감사 로그)
+CREATE TABLE IF NOT EXISTS match_status_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    session_id UUID REFERENCES match_sessions(id) ON DELETE CASCADE,
+    subject VARCHAR(20) NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('schedule', 'results', 'duration', 'sessions', 'admin')),
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_match_status_events_match ON match_status_events(match_id, created_at DESC);
+
+COMMENT ON COLUMN match_status_events.subject IS '''match'' for the match itself, otherwise the type of the session that changed';
+COMMENT ON COLUMN match_status_events.actor_id IS 'User whose action caused the change, NULL for scheduler transitions';
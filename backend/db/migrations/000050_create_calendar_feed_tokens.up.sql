@@ -0,0 +1,8 @@
+-- 개인 캘린더(.ics) 구독 토큰
+CREATE TABLE IF NOT EXISTS calendar_feed_tokens (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN calendar_feed_tokens.token IS 'Secret embedded in the personal feed URL; rotating it revokes old subscriptions';
@@ -0,0 +1,6 @@
+ALTER TABLE matches DROP COLUMN IF EXISTS rsvp_closed_at;
+ALTER TABLE league_participants DROP COLUMN IF EXISTS reserve_priority;
+DROP TABLE IF EXISTS attendance_strikes;
+DROP TABLE IF EXISTS reserve_offers;
+DROP TABLE IF EXISTS match_rsvps;
+DROP TABLE IF EXISTS attendance_settings;
@@ -0,0 +1,64 @@
+-- 리그별 출석 확인 설정 (응답 마감, 리저브 제안 유효 시간, 미응답 경고 수)
+CREATE TABLE IF NOT EXISTS attendance_settings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    league_id UUID NOT NULL UNIQUE REFERENCES leagues(id) ON DELETE CASCADE,
+    rsvp_deadline_hours INT NOT NULL DEFAULT 24 CHECK (rsvp_deadline_hours >= 0),
+    offer_timeout_minutes INT NOT NULL DEFAULT 120 CHECK (offer_timeout_minutes > 0),
+    missed_rsvp_strikes INT NOT NULL DEFAULT 1 CHECK (missed_rsvp_strikes >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN attendance_settings.rsvp_deadline_hours IS 'RSVPs close this many hours before the race starts';
+COMMENT ON COLUMN attendance_settings.missed_rsvp_strikes IS 'Strikes given to a driver who has not answered by the deadline (0 = none)';
+
+-- 경기별 출석 응답
+CREATE TABLE IF NOT EXISTS match_rsvps (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('attending', 'not_attending', 'unsure')),
+    note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (match_id, participant_id)
+);
+
+-- 빈 자리에 대한 리저브 출전 제안
+CREATE TABLE IF NOT EXISTS reserve_offers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    reserve_participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    replaced_participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    team_name VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'accepted', 'declined', 'expired', 'withdrawn')),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX uq_reserve_offers_pending_seat ON reserve_offers(match_id, replaced_participant_id) WHERE status = 'pending';
+CREATE INDEX idx_reserve_offers_reserve ON reserve_offers(reserve_participant_id, status);
+CREATE INDEX idx_reserve_offers_pending_expiry ON reserve_offers(expires_at) WHERE status = 'pending';
+
+-- 출석 미응답 경고
+CREATE TABLE IF NOT EXISTS attendance_strikes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    participant_id UUID NOT NULL REFERENCES league_participants(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    strikes INT NOT NULL CHECK (strikes > 0),
+    reason VARCHAR(20) NOT NULL DEFAULT 'missed_rsvp',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (participant_id, match_id)
+);
+
+-- 리저브 호출 우선순위 (낮을수록 먼저, NULL은 등록 순)
+ALTER TABLE league_participants ADD COLUMN reserve_priority INT;
+
+-- 출석 응답이 마감 처리된 시각
+ALTER TABLE matches ADD COLUMN rsvp_closed_at TIMESTAMP WITH TIME ZONE;
+
+-- 기존 경기 중 기본 마감(24시간 전)이 이미 지난 경기는 경고 없이 마감 처리
+UPDATE matches SET rsvp_closed_at = NOW()
+WHERE status <> 'upcoming' OR starts_at IS NULL OR starts_at <= NOW() + INTERVAL '24 hours';
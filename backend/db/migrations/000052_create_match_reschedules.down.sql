@@ -0,0 +1,3 @@
+UPDATE matches SET status = 'upcoming' WHERE status = 'postponed';
+ALTER TABLE matches DROP COLUMN IF EXISTS schedule_revision;
+DROP TABLE IF EXISTS match_reschedules;
@@ -0,0 +1,24 @@
+-- 경기 연기 및 일정 변경 이력
+CREATE TABLE IF NOT EXISTS match_reschedules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('postponed', 'rescheduled', 'shifted')),
+    from_date DATE NOT NULL,
+    from_time TIME,
+    to_date DATE,
+    to_time TIME,
+    reason TEXT,
+    cause_match_id UUID REFERENCES matches(id) ON DELETE SET NULL,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_match_reschedules_match ON match_reschedules(match_id, created_at DESC);
+
+COMMENT ON COLUMN match_reschedules.to_date IS 'New date; for postponements the proposed date, NULL while undecided';
+COMMENT ON COLUMN match_reschedules.cause_match_id IS 'For shifted rounds, the rescheduled match that pushed this one back';
+
+-- 일정 변경 횟수 (캘린더 SEQUENCE)
+ALTER TABLE matches ADD COLUMN schedule_revision INT NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN matches.status IS 'Race weekend status: upcoming, in_progress, completed, cancelled, postponed';
@@ -0,0 +1,2 @@
+ALTER TABLE leagues DROP COLUMN IF EXISTS settings;
+ALTER TABLE leagues RENAME COLUMN settings_note TO settings;
@@ -0,0 +1,6 @@
+-- 리그 설정 문서 (버전이 있는 타입 설정, 기존 자유 텍스트는 settings_note로 보존)
+ALTER TABLE leagues RENAME COLUMN settings TO settings_note;
+ALTER TABLE leagues ADD COLUMN settings JSONB;
+
+COMMENT ON COLUMN leagues.settings IS 'Versioned league settings document; NULL means the defaults apply';
+COMMENT ON COLUMN leagues.settings_note IS 'Free-text settings notes shown to drivers';
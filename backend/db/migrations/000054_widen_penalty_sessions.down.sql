@@ -0,0 +1,11 @@
+-- 본선 / 스프린트 외 세션의 신고와 페널티는 되돌릴 수 없으므로 삭제
+DELETE FROM incident_reports WHERE session NOT IN ('race', 'sprint');
+DELETE FROM penalties WHERE session NOT IN ('race', 'sprint');
+
+ALTER TABLE incident_reports DROP CONSTRAINT IF EXISTS incident_reports_session_check;
+ALTER TABLE incident_reports ALTER COLUMN session TYPE VARCHAR(10);
+ALTER TABLE incident_reports ADD CONSTRAINT incident_reports_session_check CHECK (session IN ('race', 'sprint'));
+
+ALTER TABLE penalties DROP CONSTRAINT IF EXISTS penalties_session_check;
+ALTER TABLE penalties ALTER COLUMN session TYPE VARCHAR(10);
+ALTER TABLE penalties ADD CONSTRAINT penalties_session_check CHECK (session IN ('race', 'sprint'));
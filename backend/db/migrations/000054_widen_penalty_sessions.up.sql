@@ -0,0 +1,14 @@
+-- 포인트가 걸린 모든 세션 (스프린트, 본선, 피처 레이스, 리버스 그리드, 내구 스틴트)에 페널티와 사고 신고 허용
+ALTER TABLE penalties ALTER COLUMN session TYPE VARCHAR(20);
+ALTER TABLE penalties DROP CONSTRAINT IF EXISTS penalties_session_check;
+ALTER TABLE penalties ADD CONSTRAINT penalties_session_check CHECK (session IN (
+    'sprint', 'race', 'feature_race', 'reverse_grid_race', 'endurance_stint'
+));
+
+ALTER TABLE incident_reports ALTER COLUMN session TYPE VARCHAR(20);
+ALTER TABLE incident_reports DROP CONSTRAINT IF EXISTS incident_reports_session_check;
+ALTER TABLE incident_reports ADD CONSTRAINT incident_reports_session_check CHECK (session IN (
+    'sprint', 'race', 'feature_race', 'reverse_grid_race', 'endurance_stint'
+));
+
+COMMENT ON COLUMN penalties.session IS 'Session type the penalty applies to; the first session of that type in the match';
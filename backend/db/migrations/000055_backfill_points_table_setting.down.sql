@@ -0,0 +1,3 @@
+UPDATE leagues
+SET settings = settings - 'points_table'
+WHERE settings ->> 'points_table' = 'custom';
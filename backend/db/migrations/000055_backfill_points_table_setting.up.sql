@@ -0,0 +1,4 @@
+-- 포인트 시스템을 저장해 둔 리그는 계속 자체 포인트 테이블을 사용하도록 설정 문서에 custom으로 기록
+UPDATE leagues
+SET settings = jsonb_set(COALESCE(settings, '{"version": 1}'::jsonb), '{points_table}', '"custom"')
+WHERE id IN (SELECT league_id FROM points_systems);
@@ -64,6 +64,11 @@ const (
 	PermStoreManage Permission = "store.manage" // Manage all products
 )
 
+// Steward permissions
+const (
+	PermIncidentReview Permission = "incident.review" // Review incident reports and issue decisions
+)
+
 // Wildcard permission for ADMIN
 const PermWildcard Permission = "*"
 
@@ -108,6 +113,8 @@ func AllPermissions() []Permission {
 		PermStoreEdit,
 		PermStoreDelete,
 		PermStoreManage,
+		// Steward
+		PermIncidentReview,
 	}
 }
 
@@ -147,6 +154,8 @@ func GetPermissionInfo() []PermissionInfo {
 		{PermStoreEdit, "상품 수정", "상점 상품 수정", "store"},
 		{PermStoreDelete, "상품 삭제", "상점 상품 삭제", "store"},
 		{PermStoreManage, "상점 관리", "상점 전체 관리", "store"},
+		// Steward
+		{PermIncidentReview, "사고 심의", "사고 신고 검토 및 판정", "steward"},
 	}
 }
 
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -38,6 +39,18 @@ type Config struct {
 	// Discord Bot
 	DiscordBotToken string
 	DiscordGuildID  string
+
+	// Telemetry
+	TelemetryUDPAddr    string // Empty disables the UDP listener
+	TelemetryCaptureDir string // Empty disables packet capture
+
+	// Driver ratings
+	RatingInitial float64
+	RatingKFactor float64
+	RatingDNFMode string // "last" or "exclude"
+
+	// Match lifecycle
+	MatchDefaultDuration time.Duration // How long a session without its own duration runs before it completes automatically
 }
 
 // Load reads configuration from environment variables
@@ -76,6 +89,18 @@ func Load() (*Config, error) {
 		// Discord Bot
 		DiscordBotToken: getEnv("DISCORD_BOT_TOKEN", ""),
 		DiscordGuildID:  getEnv("DISCORD_GUILD_ID", ""),
+
+		// Telemetry
+		TelemetryUDPAddr:    getEnv("TELEMETRY_UDP_ADDR", ""),
+		TelemetryCaptureDir: getEnv("TELEMETRY_CAPTURE_DIR", ""),
+
+		// Driver ratings
+		RatingInitial: parseFloat(getEnv("RATING_INITIAL", "1500"), 1500),
+		RatingKFactor: parseFloat(getEnv("RATING_K_FACTOR", "32"), 32),
+		RatingDNFMode: getEnv("RATING_DNF_MODE", "last"),
+
+		// Match lifecycle
+		MatchDefaultDuration: parseDuration(getEnv("MATCH_DEFAULT_DURATION", "2h")),
 	}
 
 	return cfg, nil
@@ -98,6 +123,15 @@ func parseDuration(s string) time.Duration {
 	return d
 }
 
+// parseFloat parses a float string, returns the default on error
+func parseFloat(s string, defaultValue float64) float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return defaultValue
+	}
+	return f
+}
+
 // IsDevelopment returns true if running in development mode
 func (c *Config) IsDevelopment() bool {
 	return c.ServerEnv == "development"
@@ -113,5 +147,8 @@ func (c *Config) Validate() error {
 	if c.IsProduction() && (c.JWTSecret == "" || c.JWTSecret == "dev-secret-key") {
 		return errors.New("JWT_SECRET 환경변수가 프로덕션에서 필수입니다")
 	}
+	if c.RatingDNFMode != "last" && c.RatingDNFMode != "exclude" {
+		return errors.New("RATING_DNF_MODE는 last 또는 exclude여야 합니다")
+	}
 	return nil
 }
@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/f1-rivals-cup/backend/internal/model"
 	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/google/uuid"
 )
 
 func handleLeagueAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate, leagueRepo *repository.LeagueRepository, focused *discordgo.ApplicationCommandInteractionDataOption) {
@@ -88,3 +90,77 @@ func handleMatchAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreat
 		},
 	})
 }
+
+// handleCompetitorAutocomplete suggests drivers, or teams when the type option is set to teams,
+// from the league already chosen in the same command.
+func handleCompetitorAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate, participantRepo *repository.ParticipantRepository, focused *discordgo.ApplicationCommandInteractionDataOption) {
+	ctx := context.Background()
+	input := strings.ToLower(focused.StringValue())
+	opts := optionMap(i)
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0)
+	leagueID, err := uuid.Parse(opts["league"])
+	if err == nil {
+		participants, err := participantRepo.ListByLeague(ctx, leagueID, string(model.ParticipantStatusApproved))
+		if err == nil {
+			seen := make(map[string]bool)
+			for _, p := range participants {
+				var label, value string
+				if opts["type"] == "teams" {
+					if p.TeamName == nil {
+						continue
+					}
+					label, value = *p.TeamName, *p.TeamName
+				} else {
+					if p.UserNickname == nil {
+						continue
+					}
+					label, value = *p.UserNickname, p.ID.String()
+				}
+				if seen[value] || (input != "" && !strings.Contains(strings.ToLower(label), input)) {
+					continue
+				}
+				seen[value] = true
+				choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+					Name:  truncate(label, 100),
+					Value: value,
+				})
+				if len(choices) >= 25 {
+					break
+				}
+			}
+		}
+	}
+
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: choices,
+		},
+	})
+}
+
+func handleTrackAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate, trackRepo *repository.TrackRepository, focused *discordgo.ApplicationCommandInteractionDataOption) {
+	ctx := context.Background()
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0)
+	tracks, err := trackRepo.List(ctx, focused.StringValue())
+	if err == nil {
+		for _, t := range tracks {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  truncate(fmt.Sprintf("%s %s (%s)", t.Flag, t.Name, t.Country), 100),
+				Value: t.ID.String(),
+			})
+			if len(choices) >= 25 {
+				break
+			}
+		}
+	}
+
+	_ = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: choices,
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
 )
 
 // Bot manages the Discord bot session lifecycle.
@@ -20,13 +21,13 @@ type Bot struct {
 }
 
 // NewBot creates a new Discord bot. Call Start to connect.
-func NewBot(token, guildID string, leagueRepo *repository.LeagueRepository, matchRepo *repository.MatchRepository, matchResultRepo *repository.MatchResultRepository) (*Bot, error) {
+func NewBot(token, guildID string, leagueRepo *repository.LeagueRepository, matchRepo *repository.MatchRepository, sessionRepo *repository.SessionRepository, participantRepo *repository.ParticipantRepository, trackRepo *repository.TrackRepository, standingsService *service.StandingsService) (*Bot, error) {
 	session, err := discordgo.New("Bot " + token)
 	if err != nil {
 		return nil, err
 	}
 
-	handler := NewCommandHandler(leagueRepo, matchRepo, matchResultRepo)
+	handler := NewCommandHandler(leagueRepo, matchRepo, sessionRepo, participantRepo, trackRepo, standingsService)
 
 	bot := &Bot{
 		session: session,
@@ -29,6 +29,42 @@ var commands = []*discordgo.ApplicationCommand{
 			},
 		},
 	},
+	{
+		Name:        "head-to-head",
+		Description: "드라이버 또는 팀 맞대결 비교",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "league",
+				Description:  "리그 선택",
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "a",
+				Description:  "첫 번째 드라이버 또는 팀",
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "b",
+				Description:  "두 번째 드라이버 또는 팀",
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "type",
+				Description: "비교 종류 (기본값: 드라이버)",
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "드라이버", Value: "drivers"},
+					{Name: "팀", Value: "teams"},
+				},
+			},
+		},
+	},
 	{
 		Name:        "schedule",
 		Description: "레이스 일정 조회",
@@ -72,4 +108,23 @@ var commands = []*discordgo.ApplicationCommand{
 			},
 		},
 	},
+	{
+		Name:        "track",
+		Description: "서킷 정보 및 기록 조회",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "track",
+				Description:  "서킷 선택",
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "league",
+				Description:  "특정 리그의 기록만 조회",
+				Autocomplete: true,
+			},
+		},
+	},
 }
@@ -3,9 +3,12 @@ package discord
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
 )
 
 const (
@@ -92,6 +95,86 @@ func buildTeamStandingsEmbed(league *model.League, standings []model.TeamStandin
 	}
 }
 
+func buildHeadToHeadEmbed(league *model.League, nameA, nameB string, h2h *service.HeadToHead) *discordgo.MessageEmbed {
+	title := fmt.Sprintf("⚔️ %s vs %s", nameA, nameB)
+	if len(h2h.Rounds) == 0 {
+		return &discordgo.MessageEmbed{
+			Title:       title,
+			Description: "비교할 경기 기록이 없습니다.",
+			Color:       colorF1Red,
+		}
+	}
+
+	// Show the most recent rounds that fit in the embed
+	rounds := h2h.Rounds
+	if len(rounds) > 15 {
+		rounds = rounds[len(rounds)-15:]
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```\n")
+	sb.WriteString(fmt.Sprintf(" %-4s| %-9s| %-9s| %s\n", "Rnd", "Quali", "Race", "Pts +/-"))
+	for _, r := range rounds {
+		race := "-"
+		for _, session := range r.Sessions {
+			if session.Session != model.SessionTypeSprint {
+				race = fmt.Sprintf("%s-%s", h2hFinishLabel(session.A), h2hFinishLabel(session.B))
+				break
+			}
+		}
+		quali := fmt.Sprintf("%s-%s", h2hPositionLabel(r.QualifyingA), h2hPositionLabel(r.QualifyingB))
+		sb.WriteString(fmt.Sprintf(" R%-3d| %-9s| %-9s| %+.0f\n", r.Round, quali, race, r.PointsDelta))
+	}
+	sb.WriteString("```")
+
+	countLabel := func(c model.HeadToHeadCount) string {
+		label := fmt.Sprintf("%d : %d", c.A, c.B)
+		if c.SharedDNFs > 0 {
+			label += fmt.Sprintf(" (동반 리타이어 %d)", c.SharedDNFs)
+		}
+		return label
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{Name: "레이스", Value: countLabel(h2h.Race), Inline: true},
+		{Name: "예선", Value: countLabel(h2h.Qualifying), Inline: true},
+	}
+	if h2h.Sprint.A+h2h.Sprint.B+h2h.Sprint.SharedDNFs > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "스프린트", Value: countLabel(h2h.Sprint), Inline: true})
+	}
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:  "포인트",
+		Value: fmt.Sprintf("%.0f : %.0f", h2h.PointsA, h2h.PointsB),
+	})
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: sb.String(),
+		Color:       colorF1Red,
+		Fields:      fields,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("%s · 시즌 %d", league.Name, league.Season),
+		},
+	}
+}
+
+func h2hFinishLabel(f *model.HeadToHeadFinish) string {
+	switch {
+	case f == nil:
+		return "-"
+	case f.DNF:
+		return "DNF"
+	}
+	return h2hPositionLabel(f.Position)
+}
+
+func h2hPositionLabel(position *int) string {
+	if position == nil {
+		return "-"
+	}
+	return fmt.Sprintf("P%d", *position)
+}
+
 func buildScheduleEmbed(league *model.League, matches []*model.Match) *discordgo.MessageEmbed {
 	if len(matches) == 0 {
 		return &discordgo.MessageEmbed{
@@ -104,11 +187,17 @@ func buildScheduleEmbed(league *model.League, matches []*model.Match) *discordgo
 	fields := make([]*discordgo.MessageEmbedField, 0, len(matches))
 	for _, m := range matches {
 		name := fmt.Sprintf("Round %d - %s", m.Round, m.Track)
+		if m.TrackFlag != "" {
+			name = fmt.Sprintf("Round %d - %s %s", m.Round, m.TrackFlag, m.Track)
+		}
 
 		timeStr := m.MatchDate
 		if m.MatchTime != nil {
 			timeStr += " " + *m.MatchTime
 		}
+		if m.StartsAt != nil {
+			timeStr = scheduleTime(*m.StartsAt)
+		}
 
 		status := statusLabel(m.Status)
 		value := fmt.Sprintf("%s | %s", timeStr, status)
@@ -121,6 +210,9 @@ func buildScheduleEmbed(league *model.League, matches []*model.Match) *discordgo
 			if m.SprintTime != nil {
 				sprintTime += " " + *m.SprintTime
 			}
+			if m.SprintStartsAt != nil {
+				sprintTime = scheduleTime(*m.SprintStartsAt)
+			}
 			sprintStatus := statusLabel(m.SprintStatus)
 			value += fmt.Sprintf("\n🏃 Sprint: %s | %s", sprintTime, sprintStatus)
 		}
@@ -136,12 +228,12 @@ func buildScheduleEmbed(league *model.League, matches []*model.Match) *discordgo
 		Color:  colorSchedule,
 		Fields: fields,
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("시즌 %d | 총 %d 라운드", league.Season, len(matches)),
+			Text: fmt.Sprintf("시즌 %d | 총 %d 라운드 | 리그 시간대 %s", league.Season, len(matches), league.Timezone),
 		},
 	}
 }
 
-func buildResultsEmbed(match *model.Match, league *model.League, results []*model.MatchResult) *discordgo.MessageEmbed {
+func buildResultsEmbed(match *model.Match, league *model.League, sessions []*model.MatchSession, results []*model.SessionResult) *discordgo.MessageEmbed {
 	title := fmt.Sprintf("🏁 Round %d - %s", match.Round, match.Track)
 	if league != nil {
 		title += fmt.Sprintf(" (%s)", league.Name)
@@ -155,41 +247,73 @@ func buildResultsEmbed(match *model.Match, league *model.League, results []*mode
 		}
 	}
 
-	var sb strings.Builder
-	sb.WriteString("```\n")
-	sb.WriteString(fmt.Sprintf(" %-3s | %-16s | %-12s | %5s | %s\n", "Pos", "Driver", "Team", "Pts", "FL"))
-	sb.WriteString(fmt.Sprintf("%-4s|%-18s|%-14s|%6s|%3s\n", "----", "------------------", "--------------", "------", "---"))
-
+	bySession := make(map[uuid.UUID][]*model.SessionResult)
 	for _, r := range results {
-		pos := "-"
-		if r.Position != nil {
-			pos = fmt.Sprintf("%d", *r.Position)
-		}
-		if r.DNF {
-			pos = "DNF"
-		}
+		bySession[r.SessionID] = append(bySession[r.SessionID], r)
+	}
 
-		driver := "-"
-		if r.ParticipantName != nil {
-			driver = truncate(*r.ParticipantName, 16)
+	var sb strings.Builder
+	var substitutes []string
+	seenSubstitutes := make(map[uuid.UUID]bool)
+	for _, session := range sessions {
+		sessionResults := bySession[session.ID]
+		if len(sessionResults) == 0 {
+			continue
 		}
 
-		team := "-"
-		if r.TeamName != nil {
-			team = truncate(*r.TeamName, 12)
+		sb.WriteString(fmt.Sprintf("**%s** %s", sessionLabel(session), statusLabel(session.Status)))
+		if session.ResultsStatus != nil && *session.ResultsStatus == model.ResultsStatusProvisional {
+			sb.WriteString(" ⚠️ 잠정 결과")
 		}
+		sb.WriteString("\n")
+		sb.WriteString("```\n")
+		sb.WriteString(fmt.Sprintf(" %-3s | %-16s | %-12s | %5s | %s\n", "Pos", "Driver", "Team", "Pts", "FL"))
+		sb.WriteString(fmt.Sprintf("%-4s|%-18s|%-14s|%6s|%3s\n", "----", "------------------", "--------------", "------", "---"))
+
+		for _, r := range sessionResults {
+			pos := "-"
+			if r.Position != nil {
+				pos = fmt.Sprintf("%d", *r.Position)
+			}
+			if r.DNF {
+				pos = "DNF"
+			}
+			if r.Disqualified {
+				pos = "DSQ"
+			}
 
-		fl := " "
-		if r.FastestLap {
-			fl = "⚡"
-		}
+			driver := "-"
+			if r.ParticipantName != nil {
+				driver = truncate(*r.ParticipantName, 16)
+			}
+			if r.SubstituteFor != nil {
+				// Reserves are starred and listed below the table with the driver they replaced
+				if !seenSubstitutes[r.ParticipantID] {
+					seenSubstitutes[r.ParticipantID] = true
+					substitutes = append(substitutes, fmt.Sprintf("%s → %s", driver, *r.SubstituteFor))
+				}
+				driver = truncate(driver, 15) + "*"
+			}
 
-		totalPts := r.Points + r.SprintPoints
-		sb.WriteString(fmt.Sprintf(" %-3s | %-16s | %-12s | %5.0f | %s\n",
-			pos, driver, team, totalPts, fl,
-		))
+			team := "-"
+			if r.TeamName != nil {
+				team = truncate(*r.TeamName, 12)
+			}
+
+			fl := " "
+			if r.FastestLap {
+				fl = "⚡"
+			}
+
+			sb.WriteString(fmt.Sprintf(" %-3s | %-16s | %-12s | %5.0f | %s\n",
+				pos, driver, team, r.Points, fl,
+			))
+		}
+		sb.WriteString("```\n")
+	}
+	if len(substitutes) > 0 {
+		sb.WriteString("\\* 대체 출전: " + strings.Join(substitutes, ", ") + "\n")
 	}
-	sb.WriteString("```")
 
 	return &discordgo.MessageEmbed{
 		Title:       title,
@@ -253,6 +377,9 @@ func buildLeagueInfoEmbed(league *model.League) *discordgo.MessageEmbed {
 			Name: "경기 시간", Value: *league.MatchTime, Inline: true,
 		})
 	}
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name: "시간대", Value: league.Timezone, Inline: true,
+	})
 	if league.ContactInfo != nil {
 		fields = append(fields, &discordgo.MessageEmbedField{
 			Name: "연락처", Value: *league.ContactInfo,
@@ -272,6 +399,69 @@ func buildLeagueInfoEmbed(league *model.League) *discordgo.MessageEmbed {
 	}
 }
 
+func buildTrackEmbed(track *model.Track, league *model.League, racesHeld int, winners []model.TrackWinner, records []model.TrackLapRecord) *discordgo.MessageEmbed {
+	fields := []*discordgo.MessageEmbedField{
+		{Name: "국가", Value: fmt.Sprintf("%s %s", track.Flag, track.Country), Inline: true},
+		{Name: "레이아웃", Value: track.Layout, Inline: true},
+		{Name: "길이", Value: fmt.Sprintf("%.3f km", track.LengthKm), Inline: true},
+		{Name: "랩 수", Value: fmt.Sprintf("%d랩 (정규 %d랩)", track.DefaultRaceLaps, track.FullRaceLaps), Inline: true},
+		{Name: "개최 횟수", Value: fmt.Sprintf("%d회", racesHeld), Inline: true},
+	}
+
+	if len(winners) > 0 {
+		var sb strings.Builder
+		for idx, w := range winners {
+			if idx >= 5 {
+				break
+			}
+			team := ""
+			if w.TeamName != nil {
+				team = fmt.Sprintf(" (%s)", *w.TeamName)
+			}
+			sb.WriteString(fmt.Sprintf("`%s` %s 시즌 %d R%d — **%s**%s\n", w.MatchDate, w.LeagueName, w.Season, w.Round, w.DriverName, team))
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name: "🏆 최근 우승자", Value: truncate(sb.String(), 1024),
+		})
+	}
+
+	if len(records) > 0 {
+		var sb strings.Builder
+		for idx, rec := range records {
+			if idx >= 5 {
+				break
+			}
+			sb.WriteString(fmt.Sprintf("`%s` **%s** — %s 시즌 %d R%d\n", formatLapTime(rec.LapTimeMs), rec.DriverName, rec.LeagueName, rec.Season, rec.Round))
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name: "⏱️ 랩 레코드", Value: truncate(sb.String(), 1024),
+		})
+	}
+
+	desc := ""
+	if league != nil {
+		desc = fmt.Sprintf("%s (시즌 %d) 기록", league.Name, league.Season)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("%s %s", track.Flag, track.Name),
+		Description: desc,
+		Color:       colorInfo,
+		Fields:      fields,
+	}
+}
+
+// formatLapTime renders milliseconds as "m:ss.sss"
+func formatLapTime(ms int64) string {
+	return fmt.Sprintf("%d:%02d.%03d", ms/60000, ms/1000%60, ms%1000)
+}
+
+// scheduleTime renders a start time in the league's timezone, followed by a Discord timestamp
+// that each viewer sees in their own local time
+func scheduleTime(t time.Time) string {
+	return fmt.Sprintf("%s (<t:%d:f>)", t.Format("2006-01-02 15:04 MST"), t.Unix())
+}
+
 func buildErrorEmbed(message string) *discordgo.MessageEmbed {
 	return &discordgo.MessageEmbed{
 		Title:       "❌ 오류",
@@ -290,11 +480,39 @@ func statusLabel(status model.MatchStatus) string {
 		return "[COMPLETED]"
 	case model.MatchStatusCancelled:
 		return "[CANCELLED]"
+	case model.MatchStatusPostponed:
+		return "[POSTPONED]"
 	default:
 		return string(status)
 	}
 }
 
+func sessionLabel(session *model.MatchSession) string {
+	if session.Name != nil && *session.Name != "" {
+		return *session.Name
+	}
+	switch session.Type {
+	case model.SessionTypePractice:
+		return "Practice"
+	case model.SessionTypeQualifying:
+		return "Qualifying"
+	case model.SessionTypeSprintShootout:
+		return "Sprint Shootout"
+	case model.SessionTypeSprint:
+		return "Sprint"
+	case model.SessionTypeRace:
+		return "Race"
+	case model.SessionTypeFeatureRace:
+		return "Feature Race"
+	case model.SessionTypeReverseGridRace:
+		return "Reverse Grid Race"
+	case model.SessionTypeEnduranceStint:
+		return "Endurance Stint"
+	default:
+		return string(session.Type)
+	}
+}
+
 func statusLabelKorean(status model.LeagueStatus) string {
 	switch status {
 	case model.LeagueStatusDraft:
@@ -6,7 +6,9 @@ import (
 	"log/slog"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/f1-rivals-cup/backend/internal/model"
 	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
 	"github.com/google/uuid"
 )
 
@@ -14,15 +16,21 @@ import (
 type CommandHandler struct {
 	leagueRepo      *repository.LeagueRepository
 	matchRepo       *repository.MatchRepository
-	matchResultRepo *repository.MatchResultRepository
+	sessionRepo     *repository.SessionRepository
+	participantRepo *repository.ParticipantRepository
+	trackRepo       *repository.TrackRepository
+	standings       *service.StandingsService
 }
 
 // NewCommandHandler creates a new CommandHandler.
-func NewCommandHandler(leagueRepo *repository.LeagueRepository, matchRepo *repository.MatchRepository, matchResultRepo *repository.MatchResultRepository) *CommandHandler {
+func NewCommandHandler(leagueRepo *repository.LeagueRepository, matchRepo *repository.MatchRepository, sessionRepo *repository.SessionRepository, participantRepo *repository.ParticipantRepository, trackRepo *repository.TrackRepository, standings *service.StandingsService) *CommandHandler {
 	return &CommandHandler{
 		leagueRepo:      leagueRepo,
 		matchRepo:       matchRepo,
-		matchResultRepo: matchResultRepo,
+		sessionRepo:     sessionRepo,
+		participantRepo: participantRepo,
+		trackRepo:       trackRepo,
+		standings:       standings,
 	}
 }
 
@@ -49,6 +57,8 @@ func (h *CommandHandler) handleCommand(s *discordgo.Session, i *discordgo.Intera
 		h.handleStandings(s, i)
 	case "team-standings":
 		h.handleTeamStandings(s, i)
+	case "head-to-head":
+		h.handleHeadToHead(s, i)
 	case "schedule":
 		h.handleSchedule(s, i)
 	case "results":
@@ -57,6 +67,8 @@ func (h *CommandHandler) handleCommand(s *discordgo.Session, i *discordgo.Intera
 		h.handleLeagues(s, i)
 	case "league-info":
 		h.handleLeagueInfo(s, i)
+	case "track":
+		h.handleTrack(s, i)
 	}
 }
 
@@ -71,6 +83,10 @@ func (h *CommandHandler) handleAutocomplete(s *discordgo.Session, i *discordgo.I
 			handleLeagueAutocomplete(s, i, h.leagueRepo, opt)
 		case "match":
 			handleMatchAutocomplete(s, i, h.leagueRepo, h.matchRepo, opt)
+		case "a", "b":
+			handleCompetitorAutocomplete(s, i, h.participantRepo, opt)
+		case "track":
+			handleTrackAutocomplete(s, i, h.trackRepo, opt)
 		}
 		return
 	}
@@ -90,13 +106,13 @@ func (h *CommandHandler) handleStandings(s *discordgo.Session, i *discordgo.Inte
 		return
 	}
 
-	standings, err := h.matchResultRepo.GetLeagueStandings(ctx, leagueID)
+	standings, err := h.standings.Compute(ctx, leagueID, false)
 	if err != nil {
 		respondError(s, i, "순위 데이터를 불러올 수 없습니다.")
 		return
 	}
 
-	respondEmbed(s, i, buildStandingsEmbed(league, standings))
+	respondEmbed(s, i, buildStandingsEmbed(league, standings.Drivers))
 }
 
 func (h *CommandHandler) handleTeamStandings(s *discordgo.Session, i *discordgo.InteractionCreate) {
@@ -113,13 +129,58 @@ func (h *CommandHandler) handleTeamStandings(s *discordgo.Session, i *discordgo.
 		return
 	}
 
-	standings, err := h.matchResultRepo.GetTeamStandings(ctx, leagueID)
+	standings, err := h.standings.Compute(ctx, leagueID, false)
 	if err != nil {
 		respondError(s, i, "팀 순위 데이터를 불러올 수 없습니다.")
 		return
 	}
 
-	respondEmbed(s, i, buildTeamStandingsEmbed(league, standings))
+	respondEmbed(s, i, buildTeamStandingsEmbed(league, standings.Teams))
+}
+
+func (h *CommandHandler) handleHeadToHead(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	leagueID, err := parseLeagueOption(i)
+	if err != nil {
+		respondError(s, i, "잘못된 리그 ID입니다.")
+		return
+	}
+
+	league, err := h.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		respondError(s, i, "리그를 찾을 수 없습니다.")
+		return
+	}
+
+	opts := optionMap(i)
+	teams := opts["type"] == "teams"
+
+	participants, err := h.participantRepo.ListByLeague(ctx, leagueID, string(model.ParticipantStatusApproved))
+	if err != nil {
+		respondError(s, i, "참가자 데이터를 불러올 수 없습니다.")
+		return
+	}
+
+	subjectA, entryA, okA := service.ResolveHeadToHead(participants, opts["a"], teams)
+	subjectB, entryB, okB := service.ResolveHeadToHead(participants, opts["b"], teams)
+	if !okA || !okB {
+		respondError(s, i, "비교할 드라이버 또는 팀을 찾을 수 없습니다.")
+		return
+	}
+
+	matches, err := h.matchRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		respondError(s, i, "일정 데이터를 불러올 수 없습니다.")
+		return
+	}
+
+	h2h, err := h.standings.HeadToHead(ctx, leagueID, subjectA, subjectB, matches)
+	if err != nil {
+		respondError(s, i, "맞대결 데이터를 불러올 수 없습니다.")
+		return
+	}
+
+	respondEmbed(s, i, buildHeadToHeadEmbed(league, entryA.Name, entryB.Name, h2h))
 }
 
 func (h *CommandHandler) handleSchedule(s *discordgo.Session, i *discordgo.InteractionCreate) {
@@ -165,7 +226,13 @@ func (h *CommandHandler) handleResults(s *discordgo.Session, i *discordgo.Intera
 		return
 	}
 
-	results, err := h.matchResultRepo.ListByMatch(ctx, matchID)
+	sessions, err := h.sessionRepo.ListByMatch(ctx, matchID)
+	if err != nil {
+		respondError(s, i, "세션 데이터를 불러올 수 없습니다.")
+		return
+	}
+
+	results, err := h.sessionRepo.ListResultsByMatch(ctx, matchID)
 	if err != nil {
 		respondError(s, i, "결과 데이터를 불러올 수 없습니다.")
 		return
@@ -173,7 +240,7 @@ func (h *CommandHandler) handleResults(s *discordgo.Session, i *discordgo.Intera
 
 	league, _ := h.leagueRepo.GetByID(ctx, match.LeagueID)
 
-	respondEmbed(s, i, buildResultsEmbed(match, league, results))
+	respondEmbed(s, i, buildResultsEmbed(match, league, sessions, results))
 }
 
 func (h *CommandHandler) handleLeagues(s *discordgo.Session, i *discordgo.InteractionCreate) {
@@ -204,6 +271,62 @@ func (h *CommandHandler) handleLeagueInfo(s *discordgo.Session, i *discordgo.Int
 	respondEmbed(s, i, buildLeagueInfoEmbed(league))
 }
 
+func (h *CommandHandler) handleTrack(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	opts := optionMap(i)
+
+	trackID, err := uuid.Parse(opts["track"])
+	if err != nil {
+		respondError(s, i, "잘못된 서킷 ID입니다.")
+		return
+	}
+
+	track, err := h.trackRepo.GetByID(ctx, trackID)
+	if err != nil {
+		respondError(s, i, "서킷을 찾을 수 없습니다.")
+		return
+	}
+
+	var league *model.League
+	if v := opts["league"]; v != "" {
+		leagueID, err := uuid.Parse(v)
+		if err != nil {
+			respondError(s, i, "잘못된 리그 ID입니다.")
+			return
+		}
+		league, err = h.leagueRepo.GetByID(ctx, leagueID)
+		if err != nil {
+			respondError(s, i, "리그를 찾을 수 없습니다.")
+			return
+		}
+	}
+
+	var leagueID *uuid.UUID
+	if league != nil {
+		leagueID = &league.ID
+	}
+
+	racesHeld, err := h.trackRepo.CountRaces(ctx, track.ID, leagueID)
+	if err != nil {
+		respondError(s, i, "서킷 기록을 불러올 수 없습니다.")
+		return
+	}
+
+	winners, err := h.trackRepo.ListWinners(ctx, track.ID, leagueID)
+	if err != nil {
+		respondError(s, i, "서킷 기록을 불러올 수 없습니다.")
+		return
+	}
+
+	records, err := h.trackRepo.ListLapRecords(ctx, track.ID, leagueID)
+	if err != nil {
+		respondError(s, i, "서킷 기록을 불러올 수 없습니다.")
+		return
+	}
+
+	respondEmbed(s, i, buildTrackEmbed(track, league, racesHeld, winners, records))
+}
+
 // parseLeagueOption extracts the league UUID from the first command option.
 func parseLeagueOption(i *discordgo.InteractionCreate) (uuid.UUID, error) {
 	opts := i.ApplicationCommandData().Options
@@ -213,6 +336,17 @@ func parseLeagueOption(i *discordgo.InteractionCreate) (uuid.UUID, error) {
 	return uuid.Parse(opts[0].StringValue())
 }
 
+// optionMap collects the string values of the command options by name.
+func optionMap(i *discordgo.InteractionCreate) map[string]string {
+	opts := make(map[string]string)
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Type == discordgo.ApplicationCommandOptionString {
+			opts[opt.Name] = opt.StringValue()
+		}
+	}
+	return opts
+}
+
 func respondEmbed(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) {
 	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
@@ -0,0 +1,554 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type AttendanceHandler struct {
+	attendanceRepo    *repository.AttendanceRepository
+	leagueRepo        *repository.LeagueRepository
+	matchRepo         *repository.MatchRepository
+	participantRepo   *repository.ParticipantRepository
+	attendanceService *service.AttendanceService
+}
+
+func NewAttendanceHandler(
+	attendanceRepo *repository.AttendanceRepository,
+	leagueRepo *repository.LeagueRepository,
+	matchRepo *repository.MatchRepository,
+	participantRepo *repository.ParticipantRepository,
+	attendanceService *service.AttendanceService,
+) *AttendanceHandler {
+	return &AttendanceHandler{
+		attendanceRepo:    attendanceRepo,
+		leagueRepo:        leagueRepo,
+		matchRepo:         matchRepo,
+		participantRepo:   participantRepo,
+		attendanceService: attendanceService,
+	}
+}
+
+// GetSettings handles GET /api/v1/leagues/:id/attendance-settings
+func (h *AttendanceHandler) GetSettings(c echo.Context) error {
+	leagueID, ok, err := h.loadLeagueID(c, "Attendance.GetSettings")
+	if !ok {
+		return err
+	}
+
+	settings, err := h.attendanceService.SettingsFor(c.Request().Context(), leagueID)
+	if err != nil {
+		slog.Error("Attendance.GetSettings: failed to get attendance settings", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출석 설정을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, settings)
+}
+
+// UpdateSettings handles PUT /api/v1/admin/leagues/:id/attendance-settings
+func (h *AttendanceHandler) UpdateSettings(c echo.Context) error {
+	var req model.UpdateAttendanceSettingsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if err := validateAttendanceSettingsRequest(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	leagueID, ok, err := h.loadLeagueID(c, "Attendance.UpdateSettings")
+	if !ok {
+		return err
+	}
+
+	settings := &model.AttendanceSettings{
+		LeagueID:            leagueID,
+		RSVPDeadlineHours:   req.RSVPDeadlineHours,
+		OfferTimeoutMinutes: req.OfferTimeoutMinutes,
+		MissedRSVPStrikes:   req.MissedRSVPStrikes,
+	}
+
+	if err := h.attendanceRepo.UpsertSettings(c.Request().Context(), settings); err != nil {
+		slog.Error("Attendance.UpdateSettings: failed to upsert attendance settings", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출석 설정 저장에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, settings)
+}
+
+// UpdateReservePriority handles PUT /api/v1/admin/leagues/:id/reserve-priority
+func (h *AttendanceHandler) UpdateReservePriority(c echo.Context) error {
+	var req model.UpdateReservePriorityRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	leagueID, ok, err := h.loadLeagueID(c, "Attendance.UpdateReservePriority")
+	if !ok {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	if err := h.attendanceRepo.SetReservePriority(ctx, leagueID, req.ParticipantIDs); err != nil {
+		if errors.Is(err, repository.ErrParticipantNotFound) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "validation_error",
+				Message: "리그의 리저브 선수가 아닌 참가자가 포함되어 있습니다",
+			})
+		}
+		slog.Error("Attendance.UpdateReservePriority: failed to set reserve priority", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리저브 우선순위 저장에 실패했습니다",
+		})
+	}
+
+	reserves, err := h.attendanceRepo.ListReserves(ctx, leagueID)
+	if err != nil {
+		slog.Error("Attendance.UpdateReservePriority: failed to list reserves", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리저브 목록을 불러오는데 실패했습니다",
+		})
+	}
+	if reserves == nil {
+		reserves = []*model.LeagueParticipant{}
+	}
+
+	return c.JSON(http.StatusOK, model.ListParticipantsResponse{
+		Participants: reserves,
+		Total:        len(reserves),
+	})
+}
+
+// ListStrikes handles GET /api/v1/admin/leagues/:id/attendance-strikes
+func (h *AttendanceHandler) ListStrikes(c echo.Context) error {
+	leagueID, ok, err := h.loadLeagueID(c, "Attendance.ListStrikes")
+	if !ok {
+		return err
+	}
+
+	strikes, err := h.attendanceRepo.ListStrikesByLeague(c.Request().Context(), leagueID)
+	if err != nil {
+		slog.Error("Attendance.ListStrikes: failed to list strikes", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출석 경고 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.ListAttendanceStrikesResponse{
+		Strikes: strikes,
+		Total:   len(strikes),
+	})
+}
+
+// RSVP handles PUT /api/v1/matches/:id/rsvp
+func (h *AttendanceHandler) RSVP(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	var req model.UpdateRSVPRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	switch req.Status {
+	case model.RSVPAttending, model.RSVPNotAttending, model.RSVPUnsure:
+	default:
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "참석 여부는 attending, not_attending, unsure 중 하나여야 합니다",
+		})
+	}
+	if req.Note != nil {
+		note := strings.TrimSpace(*req.Note)
+		req.Note = &note
+	}
+
+	match, ok, err := h.loadMatch(c, "Attendance.RSVP")
+	if !ok {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	driver, err := h.participantRepo.GetByLeagueAndUser(ctx, match.LeagueID, userID)
+	if err != nil && !errors.Is(err, repository.ErrParticipantNotFound) {
+		slog.Error("Attendance.RSVP: failed to get participant", "error", err, "league_id", match.LeagueID, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "참가자 정보를 불러오는데 실패했습니다",
+		})
+	}
+	if driver == nil || driver.Status != model.ParticipantStatusApproved || !slices.Contains(driver.Roles, string(model.RolePlayer)) {
+		return c.JSON(http.StatusForbidden, model.ErrorResponse{
+			Error:   "forbidden",
+			Message: "리그의 정규 선수만 참석 여부를 응답할 수 있습니다",
+		})
+	}
+
+	rsvp := &model.MatchRSVP{
+		MatchID:       match.ID,
+		ParticipantID: driver.ID,
+		Status:        req.Status,
+		Note:          req.Note,
+	}
+
+	offer, err := h.attendanceService.Respond(ctx, match, driver, rsvp, time.Now())
+	if err != nil {
+		if errors.Is(err, service.ErrRSVPClosed) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "rsvp_closed",
+				Message: "참석 여부 응답이 마감되었습니다",
+			})
+		}
+		slog.Error("Attendance.RSVP: failed to save rsvp", "error", err, "match_id", match.ID, "participant_id", driver.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "참석 여부 저장에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.RSVPResponse{
+		RSVP:  rsvp,
+		Offer: offer,
+	})
+}
+
+// TeamAvailability handles GET /api/v1/matches/:id/availability, showing directors their own teams
+func (h *AttendanceHandler) TeamAvailability(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	match, ok, err := h.loadMatch(c, "Attendance.TeamAvailability")
+	if !ok {
+		return err
+	}
+
+	teams, err := h.participantRepo.GetDirectorTeams(c.Request().Context(), match.LeagueID, userID)
+	if err != nil {
+		slog.Error("Attendance.TeamAvailability: failed to get director teams", "error", err, "league_id", match.LeagueID, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "팀 정보를 불러오는데 실패했습니다",
+		})
+	}
+	if len(teams) == 0 {
+		return c.JSON(http.StatusForbidden, model.ErrorResponse{
+			Error:   "forbidden",
+			Message: "팀 감독만 출석 현황을 볼 수 있습니다",
+		})
+	}
+
+	return h.availability(c, match, teams, "Attendance.TeamAvailability")
+}
+
+// Availability handles GET /api/v1/admin/matches/:id/availability
+func (h *AttendanceHandler) Availability(c echo.Context) error {
+	match, ok, err := h.loadMatch(c, "Attendance.Availability")
+	if !ok {
+		return err
+	}
+
+	return h.availability(c, match, nil, "Attendance.Availability")
+}
+
+// ListMyOffers handles GET /api/v1/me/reserve-offers
+func (h *AttendanceHandler) ListMyOffers(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	offers, err := h.attendanceRepo.ListPendingOffersByUser(c.Request().Context(), userID)
+	if err != nil {
+		slog.Error("Attendance.ListMyOffers: failed to list offers", "error", err, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출전 제안 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.ListReserveOffersResponse{
+		Offers: offers,
+		Total:  len(offers),
+	})
+}
+
+// AcceptOffer handles POST /api/v1/reserve-offers/:id/accept
+func (h *AttendanceHandler) AcceptOffer(c echo.Context) error {
+	offer, userID, ok, err := h.loadMyOffer(c, "Attendance.AcceptOffer")
+	if !ok {
+		return err
+	}
+
+	sub, err := h.attendanceService.Accept(c.Request().Context(), offer, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrReserveOfferNotPending) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "offer_closed",
+				Message: "이미 마감된 출전 제안입니다",
+			})
+		}
+		if errors.Is(err, repository.ErrReserveAlreadyUsed) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "already_substituting",
+				Message: "이 경기에 이미 대체 출전하고 있습니다",
+			})
+		}
+		if errors.Is(err, repository.ErrDriverAlreadyReplaced) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "already_replaced",
+				Message: "이미 다른 리저브 선수가 대체 출전합니다",
+			})
+		}
+		slog.Error("Attendance.AcceptOffer: failed to accept offer", "error", err, "offer_id", offer.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출전 제안 수락에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, sub)
+}
+
+// DeclineOffer handles POST /api/v1/reserve-offers/:id/decline
+func (h *AttendanceHandler) DeclineOffer(c echo.Context) error {
+	offer, _, ok, err := h.loadMyOffer(c, "Attendance.DeclineOffer")
+	if !ok {
+		return err
+	}
+
+	if _, err := h.attendanceService.Decline(c.Request().Context(), offer, time.Now()); err != nil {
+		if errors.Is(err, repository.ErrReserveOfferNotPending) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "offer_closed",
+				Message: "이미 마감된 출전 제안입니다",
+			})
+		}
+		slog.Error("Attendance.DeclineOffer: failed to decline offer", "error", err, "offer_id", offer.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출전 제안 거절에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "출전 제안을 거절했습니다",
+	})
+}
+
+// availability writes the attendance of a match's drivers, limited to the given teams when any are given
+func (h *AttendanceHandler) availability(c echo.Context, match *model.Match, teams []string, op string) error {
+	ctx := c.Request().Context()
+
+	settings, err := h.attendanceService.SettingsFor(ctx, match.LeagueID)
+	if err != nil {
+		slog.Error(op+": failed to get attendance settings", "error", err, "league_id", match.LeagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출석 설정을 불러오는데 실패했습니다",
+		})
+	}
+
+	drivers, err := h.attendanceRepo.ListAvailability(ctx, match.LeagueID, match.ID)
+	if err != nil {
+		slog.Error(op+": failed to list availability", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출석 현황을 불러오는데 실패했습니다",
+		})
+	}
+
+	offers, err := h.attendanceRepo.ListOffersByMatch(ctx, match.ID)
+	if err != nil {
+		slog.Error(op+": failed to list offers", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출석 현황을 불러오는데 실패했습니다",
+		})
+	}
+
+	if teams != nil {
+		drivers = slices.DeleteFunc(drivers, func(d model.DriverAvailability) bool {
+			return d.TeamName == nil || !slices.Contains(teams, *d.TeamName)
+		})
+		offers = slices.DeleteFunc(offers, func(o *model.ReserveOffer) bool {
+			return !slices.Contains(teams, o.TeamName)
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.MatchAvailabilityResponse{
+		MatchID:      match.ID,
+		RSVPDeadline: service.RSVPDeadline(match, settings),
+		Drivers:      drivers,
+		Offers:       offers,
+	})
+}
+
+// loadLeagueID parses the :id parameter and checks the league exists.
+// It writes the error response itself and reports false when the league cannot be used.
+func (h *AttendanceHandler) loadLeagueID(c echo.Context, op string) (uuid.UUID, bool, error) {
+	leagueID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return uuid.Nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	if _, err := h.leagueRepo.GetByID(c.Request().Context(), leagueID); err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return uuid.Nil, false, c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get league", "error", err, "league_id", leagueID)
+		return uuid.Nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return leagueID, true, nil
+}
+
+// loadMatch loads the match named by the :id parameter.
+// It writes the error response itself and reports false when the match cannot be loaded.
+func (h *AttendanceHandler) loadMatch(c echo.Context, op string) (*model.Match, bool, error) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	match, err := h.matchRepo.GetByID(c.Request().Context(), matchID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return nil, false, c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get match", "error", err, "match_id", matchID)
+		return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return match, true, nil
+}
+
+// loadMyOffer loads the seat offer named by the :id parameter and checks it was made to the current user.
+// It writes the error response itself and reports false when the offer cannot be answered.
+func (h *AttendanceHandler) loadMyOffer(c echo.Context, op string) (*model.ReserveOffer, uuid.UUID, bool, error) {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return nil, uuid.Nil, false, c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, uuid.Nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 출전 제안 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	offer, err := h.attendanceRepo.GetOffer(ctx, offerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrReserveOfferNotFound) {
+			return nil, uuid.Nil, false, c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "출전 제안을 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get offer", "error", err, "offer_id", offerID)
+		return nil, uuid.Nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출전 제안을 불러오는데 실패했습니다",
+		})
+	}
+
+	reserve, err := h.participantRepo.GetByID(ctx, offer.ReserveParticipantID)
+	if err != nil {
+		slog.Error(op+": failed to get participant", "error", err, "participant_id", offer.ReserveParticipantID)
+		return nil, uuid.Nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "참가자 정보를 불러오는데 실패했습니다",
+		})
+	}
+	if reserve.UserID != userID {
+		return nil, uuid.Nil, false, c.JSON(http.StatusForbidden, model.ErrorResponse{
+			Error:   "forbidden",
+			Message: "본인에게 온 출전 제안만 응답할 수 있습니다",
+		})
+	}
+
+	return offer, userID, true, nil
+}
+
+func validateAttendanceSettingsRequest(req *model.UpdateAttendanceSettingsRequest) error {
+	if req.RSVPDeadlineHours < 0 {
+		return errors.New("응답 마감 시간은 0 이상이어야 합니다")
+	}
+	if req.OfferTimeoutMinutes < 1 {
+		return errors.New("출전 제안 유효 시간은 1분 이상이어야 합니다")
+	}
+	if req.MissedRSVPStrikes < 0 {
+		return errors.New("미응답 경고 수는 0 이상이어야 합니다")
+	}
+	return nil
+}
@@ -0,0 +1,378 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const calendarDateLayout = "2006-01-02"
+
+// PreviewCalendar handles POST /api/v1/admin/leagues/:id/calendar/preview
+func (h *MatchHandler) PreviewCalendar(c echo.Context) error {
+	matches, ok, err := h.buildCalendar(c, "Match.PreviewCalendar")
+	if !ok {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, model.CalendarResponse{
+		Matches: matches,
+		Total:   len(matches),
+	})
+}
+
+// GenerateCalendar handles POST /api/v1/admin/leagues/:id/calendar
+func (h *MatchHandler) GenerateCalendar(c echo.Context) error {
+	matches, ok, err := h.buildCalendar(c, "Match.GenerateCalendar")
+	if !ok {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	if err := h.matchRepo.CreateBatch(ctx, matches); err != nil {
+		if errors.Is(err, repository.ErrDuplicateRound) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "duplicate_round",
+				Message: "이미 해당 라운드가 존재합니다",
+			})
+		}
+		slog.Error("Match.GenerateCalendar: failed to create matches", "error", err, "league_id", matches[0].LeagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 일정 생성에 실패했습니다",
+		})
+	}
+
+	created, err := h.matchRepo.ListByLeague(ctx, matches[0].LeagueID)
+	if err == nil {
+		first := matches[0].Round
+		created = slices.DeleteFunc(created, func(m *model.Match) bool { return m.Round < first })
+		matches = created
+	}
+
+	return c.JSON(http.StatusCreated, model.CalendarResponse{
+		Matches: matches,
+		Total:   len(matches),
+	})
+}
+
+// InsertRound handles POST /api/v1/admin/leagues/:id/calendar/rounds
+func (h *MatchHandler) InsertRound(c echo.Context) error {
+	leagueID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	var req model.InsertRoundRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if (req.Track == "" && req.TrackID == nil) || req.Round < 1 {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "라운드와 트랙은 필수입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	existing, err := h.matchRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		slog.Error("Match.InsertRound: failed to list matches", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 일정을 불러오는데 실패했습니다",
+		})
+	}
+
+	lastRound := 0
+	for _, m := range existing {
+		lastRound = max(lastRound, m.Round)
+	}
+	if req.Round > lastRound+1 {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "라운드 번호가 시즌 일정 범위를 벗어났습니다",
+		})
+	}
+
+	match := &model.Match{
+		LeagueID:     leagueID,
+		Round:        req.Round,
+		MatchDate:    safeString(req.MatchDate),
+		MatchTime:    req.MatchTime,
+		HasSprint:    req.HasSprint,
+		SprintStatus: model.MatchStatusUpcoming,
+		Status:       model.MatchStatusUpcoming,
+		Description:  req.Description,
+	}
+
+	// The new round takes over the slot of the round it displaces unless told otherwise
+	if i := slices.IndexFunc(existing, func(m *model.Match) bool { return m.Round == req.Round }); i >= 0 {
+		if match.MatchDate == "" {
+			match.MatchDate = existing[i].MatchDate
+		}
+		if match.MatchTime == nil {
+			match.MatchTime = existing[i].MatchTime
+		}
+	}
+	if _, err := time.Parse(calendarDateLayout, match.MatchDate); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "날짜 형식이 올바르지 않습니다 (YYYY-MM-DD)",
+		})
+	}
+	if match.MatchTime != nil && !validClock(*match.MatchTime) {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "시간 형식이 올바르지 않습니다 (HH:MM)",
+		})
+	}
+
+	if ok, err := h.resolveTrack(c, match, req.TrackID, req.Track, "Match.InsertRound"); !ok {
+		return err
+	}
+
+	if err := h.matchRepo.InsertRound(ctx, match, req.ShiftDays); err != nil {
+		if errors.Is(err, repository.ErrRoundAlreadyPlayed) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "round_already_played",
+				Message: "이미 진행된 라운드 앞에는 라운드를 추가할 수 없습니다",
+			})
+		}
+		slog.Error("Match.InsertRound: failed to insert round", "error", err, "league_id", leagueID, "round", req.Round)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "라운드 추가에 실패했습니다",
+		})
+	}
+
+	matches, err := h.matchRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		slog.Error("Match.InsertRound: failed to reload matches", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusCreated, model.CalendarResponse{Matches: []*model.Match{match}, Total: 1})
+	}
+
+	return c.JSON(http.StatusCreated, model.CalendarResponse{
+		Matches: matches,
+		Total:   len(matches),
+	})
+}
+
+// buildCalendar validates a calendar request and lays out the matches it describes without saving them.
+// It writes the error response itself and reports false when the calendar cannot be built.
+func (h *MatchHandler) buildCalendar(c echo.Context, op string) ([]*model.Match, bool, error) {
+	leagueID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	var req model.GenerateCalendarRequest
+	if err := c.Bind(&req); err != nil {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	league, err := h.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return nil, false, c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get league", "error", err, "league_id", leagueID)
+		return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	invalid := func(msg string) ([]*model.Match, bool, error) {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: msg,
+		})
+	}
+
+	if req.Recurrence == "" {
+		req.Recurrence = model.CalendarRecurrenceWeekly
+	}
+	if req.Recurrence != model.CalendarRecurrenceWeekly && req.Recurrence != model.CalendarRecurrenceBiweekly {
+		return invalid("반복 주기는 weekly 또는 biweekly여야 합니다")
+	}
+	if len(req.Tracks) == 0 {
+		return invalid("트랙 목록이 비어 있습니다")
+	}
+
+	start, end, ok := calendarRange(req, league)
+	if !ok {
+		return invalid("날짜 형식이 올바르지 않습니다 (YYYY-MM-DD)")
+	}
+	if start == nil {
+		return invalid("시작일을 지정하거나 리그 시작일을 설정해주세요")
+	}
+	for _, d := range req.ExcludedDates {
+		if _, err := time.Parse(calendarDateLayout, d); err != nil {
+			return invalid("날짜 형식이 올바르지 않습니다 (YYYY-MM-DD)")
+		}
+	}
+
+	weekday := start.Weekday()
+	if req.Weekday != nil {
+		if *req.Weekday < 0 || *req.Weekday > 6 {
+			return invalid("요일은 0(일요일)부터 6(토요일) 사이여야 합니다")
+		}
+		weekday = time.Weekday(*req.Weekday)
+	}
+
+	matchTime := league.MatchTime
+	if req.MatchTime != nil {
+		matchTime = req.MatchTime
+	}
+	if matchTime != nil && !validClock(*matchTime) {
+		return invalid("시간 형식이 올바르지 않습니다 (HH:MM)")
+	}
+
+	existing, err := h.matchRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		slog.Error(op+": failed to list matches", "error", err, "league_id", leagueID)
+		return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 일정을 불러오는데 실패했습니다",
+		})
+	}
+	firstRound := 1
+	for _, m := range existing {
+		firstRound = max(firstRound, m.Round+1)
+	}
+	lastRound := firstRound + len(req.Tracks) - 1
+	for _, r := range req.SprintRounds {
+		if r < firstRound || r > lastRound {
+			return invalid("스프린트 라운드가 생성 범위를 벗어났습니다")
+		}
+	}
+
+	dates, err := service.CalendarDates(service.CalendarPlan{
+		Start:      *start,
+		End:        end,
+		Weekday:    weekday,
+		Recurrence: req.Recurrence,
+		Excluded:   req.ExcludedDates,
+		Rounds:     len(req.Tracks),
+	})
+	if err != nil {
+		return invalid(err.Error())
+	}
+
+	loc, err := time.LoadLocation(league.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	matches := make([]*model.Match, 0, len(req.Tracks))
+	for i, t := range req.Tracks {
+		if t.Track == "" && t.TrackID == nil {
+			return invalid("트랙 목록에 비어 있는 항목이 있습니다")
+		}
+
+		match := &model.Match{
+			LeagueID:     leagueID,
+			Round:        firstRound + i,
+			MatchDate:    dates[i],
+			MatchTime:    matchTime,
+			Timezone:     league.Timezone,
+			HasSprint:    slices.Contains(req.SprintRounds, firstRound+i),
+			SprintStatus: model.MatchStatusUpcoming,
+			Status:       model.MatchStatusUpcoming,
+		}
+		match.StartsAt = calendarStartsAt(match.MatchDate, match.MatchTime, loc)
+		if match.HasSprint {
+			match.SprintDate = &match.MatchDate
+		}
+
+		if ok, err := h.resolveTrack(c, match, t.TrackID, t.Track, op); !ok {
+			return nil, false, err
+		}
+		matches = append(matches, match)
+	}
+
+	return matches, true, nil
+}
+
+// calendarRange returns the first and last dates a generated calendar may use, falling back to the league's
+// season dates. It reports false when a given date cannot be parsed.
+func calendarRange(req model.GenerateCalendarRequest, league *model.League) (*time.Time, *time.Time, bool) {
+	parse := func(given *string, fallback *time.Time) (*time.Time, bool) {
+		if given == nil || *given == "" {
+			if fallback == nil {
+				return nil, true
+			}
+			t := time.Date(fallback.Year(), fallback.Month(), fallback.Day(), 0, 0, 0, 0, time.UTC)
+			return &t, true
+		}
+		t, err := time.Parse(calendarDateLayout, *given)
+		if err != nil {
+			return nil, false
+		}
+		return &t, true
+	}
+
+	start, ok := parse(req.StartDate, league.StartDate)
+	if !ok {
+		return nil, nil, false
+	}
+	end, ok := parse(req.EndDate, league.EndDate)
+	if !ok {
+		return nil, nil, false
+	}
+	return start, end, true
+}
+
+// calendarStartsAt turns a local match date and time into its start instant
+func calendarStartsAt(date string, clock *string, loc *time.Location) *time.Time {
+	value, layout := date, calendarDateLayout
+	if clock != nil {
+		value, layout = date+" "+*clock, calendarDateLayout+" 15:04"
+		if len(*clock) > len("15:04") {
+			layout += ":05"
+		}
+	}
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// validClock reports whether a time of day is given as HH:MM or HH:MM:SS
+func validClock(clock string) bool {
+	if _, err := time.Parse("15:04", clock); err == nil {
+		return true
+	}
+	_, err := time.Parse("15:04:05", clock)
+	return err == nil
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type CalendarFeedHandler struct {
+	feedRepo        *repository.CalendarFeedRepository
+	leagueRepo      *repository.LeagueRepository
+	matchRepo       *repository.MatchRepository
+	participantRepo *repository.ParticipantRepository
+	eventDuration   time.Duration
+}
+
+// NewCalendarFeedHandler creates a new CalendarFeedHandler. eventDuration is how long each race or sprint
+// entry lasts in the feed.
+func NewCalendarFeedHandler(feedRepo *repository.CalendarFeedRepository, leagueRepo *repository.LeagueRepository, matchRepo *repository.MatchRepository, participantRepo *repository.ParticipantRepository, eventDuration time.Duration) *CalendarFeedHandler {
+	return &CalendarFeedHandler{
+		feedRepo:        feedRepo,
+		leagueRepo:      leagueRepo,
+		matchRepo:       matchRepo,
+		participantRepo: participantRepo,
+		eventDuration:   eventDuration,
+	}
+}
+
+// LeagueFeed handles GET /api/v1/leagues/:id/calendar.ics
+func (h *CalendarFeedHandler) LeagueFeed(c echo.Context) error {
+	leagueID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	league, err := h.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("CalendarFeed.LeagueFeed: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	events, err := h.leagueEvents(c, league)
+	if err != nil {
+		slog.Error("CalendarFeed.LeagueFeed: failed to list matches", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 일정을 불러오는데 실패했습니다",
+		})
+	}
+
+	return sendICalendar(c, service.BuildICalendar(league.Name, events))
+}
+
+// PersonalFeed handles GET /api/v1/calendar/:token
+func (h *CalendarFeedHandler) PersonalFeed(c echo.Context) error {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+	ctx := c.Request().Context()
+
+	userID, err := h.feedRepo.GetUserID(ctx, token)
+	if err != nil {
+		if errors.Is(err, repository.ErrCalendarFeedNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "캘린더를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("CalendarFeed.PersonalFeed: failed to get token", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "캘린더를 불러오는데 실패했습니다",
+		})
+	}
+
+	participations, err := h.participantRepo.ListByUser(ctx, userID)
+	if err != nil {
+		slog.Error("CalendarFeed.PersonalFeed: failed to list participations", "error", err, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "캘린더를 불러오는데 실패했습니다",
+		})
+	}
+
+	var events []service.CalendarEvent
+	for _, p := range participations {
+		if p.Status != model.ParticipantStatusApproved {
+			continue
+		}
+		league, err := h.leagueRepo.GetByID(ctx, p.LeagueID)
+		if err != nil {
+			slog.Error("CalendarFeed.PersonalFeed: failed to get league", "error", err, "league_id", p.LeagueID)
+			continue
+		}
+		leagueEvents, err := h.leagueEvents(c, league)
+		if err != nil {
+			slog.Error("CalendarFeed.PersonalFeed: failed to list matches", "error", err, "league_id", p.LeagueID)
+			continue
+		}
+		events = append(events, leagueEvents...)
+	}
+
+	return sendICalendar(c, service.BuildICalendar("F1 Rivals Cup 내 일정", events))
+}
+
+// GetMyFeed handles GET /api/v1/me/calendar, issuing a personal feed token on first use
+func (h *CalendarFeedHandler) GetMyFeed(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	token, err := h.feedRepo.GetToken(c.Request().Context(), userID)
+	if errors.Is(err, repository.ErrCalendarFeedNotFound) {
+		return h.issueToken(c, userID, "CalendarFeed.GetMyFeed")
+	}
+	if err != nil {
+		slog.Error("CalendarFeed.GetMyFeed: failed to get token", "error", err, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "캘린더 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, calendarFeedResponse(token))
+}
+
+// RotateMyFeed handles POST /api/v1/me/calendar/rotate, revoking the previous feed URL
+func (h *CalendarFeedHandler) RotateMyFeed(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	return h.issueToken(c, userID, "CalendarFeed.RotateMyFeed")
+}
+
+func (h *CalendarFeedHandler) issueToken(c echo.Context, userID uuid.UUID, op string) error {
+	token, err := generateToken(32)
+	if err == nil {
+		err = h.feedRepo.SetToken(c.Request().Context(), userID, token)
+	}
+	if err != nil {
+		slog.Error(op+": failed to issue token", "error", err, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "캘린더 주소 발급에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, calendarFeedResponse(token))
+}
+
+// leagueEvents builds the feed events of every match in a league
+func (h *CalendarFeedHandler) leagueEvents(c echo.Context, league *model.League) ([]service.CalendarEvent, error) {
+	matches, err := h.matchRepo.ListByLeague(c.Request().Context(), league.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []service.CalendarEvent
+	for _, m := range matches {
+		events = append(events, service.MatchCalendarEvents(m, league.Name, h.eventDuration)...)
+	}
+	return events, nil
+}
+
+func calendarFeedResponse(token string) model.CalendarFeedResponse {
+	return model.CalendarFeedResponse{
+		Token: token,
+		Path:  "/api/v1/calendar/" + token + ".ics",
+	}
+}
+
+func sendICalendar(c echo.Context, body string) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+	return c.Blob(http.StatusOK, "text/calendar; charset=utf-8", []byte(body))
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var leagueStatuses = []model.LeagueStatus{
+	model.LeagueStatusDraft,
+	model.LeagueStatusOpen,
+	model.LeagueStatusInProgress,
+	model.LeagueStatusCompleted,
+	model.LeagueStatusCancelled,
+}
+
+type CareerHandler struct {
+	userRepo   *repository.UserRepository
+	careerRepo *repository.CareerRepository
+}
+
+func NewCareerHandler(userRepo *repository.UserRepository, careerRepo *repository.CareerRepository) *CareerHandler {
+	return &CareerHandler{
+		userRepo:   userRepo,
+		careerRepo: careerRepo,
+	}
+}
+
+// Get handles GET /api/v1/users/:id/career?season=&league_status=
+func (h *CareerHandler) Get(c echo.Context) error {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 사용자 ID입니다",
+		})
+	}
+
+	var season int
+	if s := c.QueryParam("season"); s != "" {
+		season, err = strconv.Atoi(s)
+		if err != nil || season < 1 {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_request",
+				Message: "잘못된 시즌입니다",
+			})
+		}
+	}
+
+	status := c.QueryParam("league_status")
+	if status != "" && !slices.Contains(leagueStatuses, model.LeagueStatus(status)) {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 상태입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	user, err := h.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "사용자를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Career.Get: failed to get user", "error", err, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "사용자 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	leagues, err := h.careerRepo.ListLeagueStats(ctx, userID, season, status)
+	if err != nil {
+		slog.Error("Career.Get: failed to list league stats", "error", err, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "커리어 기록을 불러오는데 실패했습니다",
+		})
+	}
+
+	career, seasons := service.BuildCareer(leagues)
+
+	return c.JSON(http.StatusOK, model.CareerProfileResponse{
+		UserID:   user.ID,
+		Nickname: user.Nickname,
+		Career:   career,
+		Seasons:  seasons,
+	})
+}
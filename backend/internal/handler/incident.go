@@ -0,0 +1,809 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// maxEvidenceLinks limits the number of video/timestamp links attached to a report
+const maxEvidenceLinks = 10
+
+type IncidentHandler struct {
+	incidentRepo    *repository.IncidentRepository
+	activityRepo    *repository.IncidentActivityRepository
+	participantRepo *repository.ParticipantRepository
+	matchRepo       *repository.MatchRepository
+	sessionRepo     *repository.SessionRepository
+	resultService   *service.ResultService
+	licenceService  *service.LicenceService
+}
+
+func NewIncidentHandler(
+	incidentRepo *repository.IncidentRepository,
+	activityRepo *repository.IncidentActivityRepository,
+	participantRepo *repository.ParticipantRepository,
+	matchRepo *repository.MatchRepository,
+	sessionRepo *repository.SessionRepository,
+	resultService *service.ResultService,
+	licenceService *service.LicenceService,
+) *IncidentHandler {
+	return &IncidentHandler{
+		incidentRepo:    incidentRepo,
+		activityRepo:    activityRepo,
+		participantRepo: participantRepo,
+		matchRepo:       matchRepo,
+		sessionRepo:     sessionRepo,
+		resultService:   resultService,
+		licenceService:  licenceService,
+	}
+}
+
+// Create handles POST /api/v1/leagues/:id/incident-reports
+func (h *IncidentHandler) Create(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	var req model.CreateIncidentReportRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if err := validateIncidentReportRequest(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	// Reporter must be an approved participant of the league
+	reporter, err := h.participantRepo.GetByLeagueAndUser(ctx, leagueID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrParticipantNotFound) {
+			return c.JSON(http.StatusForbidden, model.ErrorResponse{
+				Error:   "forbidden",
+				Message: "리그 참가자만 사고 신고를 할 수 있습니다",
+			})
+		}
+		slog.Error("Incident.Create: failed to get participant", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "참가자 정보를 불러오는데 실패했습니다",
+		})
+	}
+	if reporter.Status != model.ParticipantStatusApproved {
+		return c.JSON(http.StatusForbidden, model.ErrorResponse{
+			Error:   "forbidden",
+			Message: "승인된 참가자만 사고 신고를 할 수 있습니다",
+		})
+	}
+
+	if reporter.ID == req.AccusedID {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "자기 자신을 신고할 수 없습니다",
+		})
+	}
+
+	accused, err := h.participantRepo.GetByID(ctx, req.AccusedID)
+	if err != nil || accused.LeagueID != leagueID {
+		if err != nil && !errors.Is(err, repository.ErrParticipantNotFound) {
+			slog.Error("Incident.Create: failed to get accused participant", "error", err)
+		}
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "신고 대상 참가자를 찾을 수 없습니다",
+		})
+	}
+
+	match, err := h.matchRepo.GetByID(ctx, req.MatchID)
+	if err != nil || match.LeagueID != leagueID {
+		if err != nil && !errors.Is(err, repository.ErrMatchNotFound) {
+			slog.Error("Incident.Create: failed to get match", "error", err)
+		}
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "해당 리그의 경기를 찾을 수 없습니다",
+		})
+	}
+
+	if _, ok, err := penaltySession(c, h.sessionRepo, match.ID, req.Session, "Incident.Create"); !ok {
+		return err
+	}
+
+	evidenceLinks := req.EvidenceLinks
+	if evidenceLinks == nil {
+		evidenceLinks = []string{}
+	}
+
+	report := &model.IncidentReport{
+		MatchID:       req.MatchID,
+		Session:       req.Session,
+		ReporterID:    reporter.ID,
+		AccusedID:     req.AccusedID,
+		Lap:           req.Lap,
+		Description:   strings.TrimSpace(req.Description),
+		EvidenceLinks: evidenceLinks,
+		Status:        model.IncidentStatusSubmitted,
+	}
+
+	if err := h.incidentRepo.Create(ctx, report); err != nil {
+		slog.Error("Incident.Create: failed to create report", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "사고 신고 접수에 실패했습니다",
+		})
+	}
+
+	h.logActivity(c, userID, report.ID, model.IncidentActionCreate, map[string]any{
+		"match_id":   report.MatchID,
+		"session":    report.Session,
+		"accused_id": report.AccusedID,
+	})
+
+	return c.JSON(http.StatusCreated, report)
+}
+
+// ListMine handles GET /api/v1/leagues/:id/my-incident-reports
+func (h *IncidentHandler) ListMine(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	participant, err := h.participantRepo.GetByLeagueAndUser(ctx, leagueID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrParticipantNotFound) {
+			return c.JSON(http.StatusOK, model.IncidentReportListResponse{
+				Reports: []*model.IncidentReport{},
+				Total:   0,
+			})
+		}
+		slog.Error("Incident.ListMine: failed to get participant", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "참가자 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	// Both the reporter and the accused are parties to a report
+	reports, err := h.incidentRepo.ListByParticipant(ctx, participant.ID)
+	if err != nil {
+		slog.Error("Incident.ListMine: failed to list reports", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "사고 신고 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	if reports == nil {
+		reports = []*model.IncidentReport{}
+	}
+
+	return c.JSON(http.StatusOK, model.IncidentReportListResponse{
+		Reports: reports,
+		Total:   len(reports),
+	})
+}
+
+// Withdraw handles DELETE /api/v1/leagues/:id/incident-reports/:reportId
+func (h *IncidentHandler) Withdraw(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	reportIDStr := c.Param("reportId")
+	reportID, err := uuid.Parse(reportIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 신고 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	report, err := h.getReport(c, reportID, "Incident.Withdraw")
+	if report == nil {
+		return err
+	}
+
+	participant, err := h.participantRepo.GetByLeagueAndUser(ctx, leagueID, userID)
+	if err != nil || report.LeagueID == nil || *report.LeagueID != leagueID || participant.ID != report.ReporterID {
+		return c.JSON(http.StatusForbidden, model.ErrorResponse{
+			Error:   "forbidden",
+			Message: "본인이 접수한 신고만 철회할 수 있습니다",
+		})
+	}
+
+	if report.Status != model.IncidentStatusSubmitted && report.Status != model.IncidentStatusUnderReview {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "already_processed",
+			Message: "이미 판정된 신고는 철회할 수 없습니다",
+		})
+	}
+
+	if err := h.incidentRepo.UpdateStatus(ctx, reportID, model.IncidentStatusWithdrawn); err != nil {
+		slog.Error("Incident.Withdraw: failed to withdraw report", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "사고 신고 철회에 실패했습니다",
+		})
+	}
+
+	h.logActivity(c, userID, reportID, model.IncidentActionWithdraw, map[string]any{
+		"previous_status": report.Status,
+	})
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "사고 신고가 철회되었습니다",
+	})
+}
+
+// ListDecisions handles GET /api/v1/leagues/:id/incident-decisions
+func (h *IncidentHandler) ListDecisions(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	reports, err := h.incidentRepo.ListPublishedByLeague(ctx, leagueID)
+	if err != nil {
+		slog.Error("Incident.ListDecisions: failed to list decisions", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "판정 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	if reports == nil {
+		reports = []*model.IncidentReport{}
+	}
+
+	return c.JSON(http.StatusOK, model.IncidentReportListResponse{
+		Reports: reports,
+		Total:   len(reports),
+	})
+}
+
+// ListByLeague handles GET /api/v1/admin/leagues/:id/incident-reports
+func (h *IncidentHandler) ListByLeague(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	status := c.QueryParam("status")
+	ctx := c.Request().Context()
+
+	reports, err := h.incidentRepo.ListByLeague(ctx, leagueID, status)
+	if err != nil {
+		slog.Error("Incident.ListByLeague: failed to list reports", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "사고 신고 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	if reports == nil {
+		reports = []*model.IncidentReport{}
+	}
+
+	return c.JSON(http.StatusOK, model.IncidentReportListResponse{
+		Reports: reports,
+		Total:   len(reports),
+	})
+}
+
+// ListActivity handles GET /api/v1/admin/incident-reports/:id/activity
+func (h *IncidentHandler) ListActivity(c echo.Context) error {
+	reportIDStr := c.Param("id")
+	reportID, err := uuid.Parse(reportIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 신고 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	activities, err := h.activityRepo.ListByReport(ctx, reportID)
+	if err != nil {
+		slog.Error("Incident.ListActivity: failed to list activities", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "활동 로그를 불러오는데 실패했습니다",
+		})
+	}
+
+	if activities == nil {
+		activities = []*model.IncidentActivityLog{}
+	}
+
+	return c.JSON(http.StatusOK, model.IncidentActivityListResponse{
+		Activities: activities,
+		Total:      len(activities),
+	})
+}
+
+// StartReview handles PUT /api/v1/admin/incident-reports/:id/review
+func (h *IncidentHandler) StartReview(c echo.Context) error {
+	reportIDStr := c.Param("id")
+	reportID, err := uuid.Parse(reportIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 신고 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	report, err := h.getReport(c, reportID, "Incident.StartReview")
+	if report == nil {
+		return err
+	}
+
+	if report.Status != model.IncidentStatusSubmitted {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_status",
+			Message: "접수 상태의 신고만 심의를 시작할 수 있습니다",
+		})
+	}
+
+	if err := h.incidentRepo.StartReview(ctx, reportID, userID); err != nil {
+		slog.Error("Incident.StartReview: failed to start review", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "심의 시작에 실패했습니다",
+		})
+	}
+
+	h.logActivity(c, userID, reportID, model.IncidentActionReview, map[string]any{})
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "심의가 시작되었습니다",
+	})
+}
+
+// AddNote handles POST /api/v1/admin/incident-reports/:id/notes
+func (h *IncidentHandler) AddNote(c echo.Context) error {
+	reportIDStr := c.Param("id")
+	reportID, err := uuid.Parse(reportIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 신고 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	var req model.AddIncidentNoteRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	note := strings.TrimSpace(req.Note)
+	if note == "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "메모 내용을 입력해주세요",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if report, err := h.getReport(c, reportID, "Incident.AddNote"); report == nil {
+		return err
+	}
+
+	activityLog := &model.IncidentActivityLog{
+		ActorID:    userID,
+		ReportID:   reportID,
+		ActionType: model.IncidentActionNote,
+		Details:    map[string]any{"note": note},
+	}
+	if err := h.activityRepo.Create(ctx, activityLog); err != nil {
+		slog.Error("Incident.AddNote: failed to add note", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "메모 저장에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, activityLog)
+}
+
+// Decide handles PUT /api/v1/admin/incident-reports/:id/decision
+func (h *IncidentHandler) Decide(c echo.Context) error {
+	reportIDStr := c.Param("id")
+	reportID, err := uuid.Parse(reportIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 신고 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	var req model.DecideIncidentRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	switch req.Decision {
+	case model.IncidentDecisionNoFurtherAction, model.IncidentDecisionWarning, model.IncidentDecisionPenalty:
+	default:
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "판정은 no_further_action, warning, penalty 중 하나여야 합니다",
+		})
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "판정 사유를 입력해주세요",
+		})
+	}
+
+	if req.Decision == model.IncidentDecisionPenalty && req.Penalty == nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "페널티 판정에는 페널티 내용이 필요합니다",
+		})
+	}
+
+	if req.LicencePoints < 0 || (req.LicencePoints > 0 && req.Decision == model.IncidentDecisionNoFurtherAction) {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "라이선스 벌점은 경고 또는 페널티 판정에만 부과할 수 있습니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	report, err := h.getReport(c, reportID, "Incident.Decide")
+	if report == nil {
+		return err
+	}
+
+	if report.Status != model.IncidentStatusSubmitted && report.Status != model.IncidentStatusUnderReview {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "already_processed",
+			Message: "이미 판정되었거나 철회된 신고입니다",
+		})
+	}
+
+	details := map[string]any{
+		"decision": req.Decision,
+		"reason":   reason,
+	}
+
+	var penaltyID *uuid.UUID
+	if req.Decision == model.IncidentDecisionPenalty {
+		penaltyReq := model.CreatePenaltyRequest{
+			ParticipantID: report.AccusedID,
+			Session:       report.Session,
+			Type:          req.Penalty.Type,
+			Value:         req.Penalty.Value,
+			Reason:        reason,
+		}
+		if err := validatePenaltyRequest(&penaltyReq); err != nil {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+
+		penalty, errResp := h.issuePenalty(c, report, &penaltyReq, userID)
+		if penalty == nil {
+			return errResp
+		}
+		penaltyID = &penalty.ID
+		details["penalty_id"] = penalty.ID
+		details["penalty_type"] = penalty.Type
+		details["penalty_value"] = penalty.Value
+	}
+
+	if req.LicencePoints > 0 {
+		awarded, err := h.licenceService.Award(ctx, *report.LeagueID, &model.LicencePoint{
+			ParticipantID:    report.AccusedID,
+			MatchID:          report.MatchID,
+			Points:           req.LicencePoints,
+			Reason:           reason,
+			IncidentReportID: &report.ID,
+			IssuedBy:         userID,
+		})
+		if err != nil {
+			slog.Error("Incident.Decide: failed to award licence points", "error", err, "report_id", reportID)
+			return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "라이선스 벌점 부과에 실패했습니다",
+			})
+		}
+		details["licence_points"] = req.LicencePoints
+		if awarded.Ban != nil {
+			details["race_ban_id"] = awarded.Ban.ID
+		}
+	}
+
+	if err := h.incidentRepo.Decide(ctx, reportID, req.Decision, reason, penaltyID, userID); err != nil {
+		slog.Error("Incident.Decide: failed to record decision", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "판정 저장에 실패했습니다",
+		})
+	}
+
+	h.logActivity(c, userID, reportID, model.IncidentActionDecide, details)
+
+	report, err = h.getReport(c, reportID, "Incident.Decide")
+	if report == nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
+
+// Publish handles PUT /api/v1/admin/incident-reports/:id/publish
+func (h *IncidentHandler) Publish(c echo.Context) error {
+	reportIDStr := c.Param("id")
+	reportID, err := uuid.Parse(reportIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 신고 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	report, err := h.getReport(c, reportID, "Incident.Publish")
+	if report == nil {
+		return err
+	}
+
+	if report.Status != model.IncidentStatusDecided {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_status",
+			Message: "판정이 완료된 신고만 공개할 수 있습니다",
+		})
+	}
+
+	if err := h.incidentRepo.UpdateStatus(ctx, reportID, model.IncidentStatusPublished); err != nil {
+		slog.Error("Incident.Publish: failed to publish decision", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "판정 공개에 실패했습니다",
+		})
+	}
+
+	h.logActivity(c, userID, reportID, model.IncidentActionPublish, map[string]any{})
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "판정이 공개되었습니다",
+	})
+}
+
+// issuePenalty applies the penalty decided on a report to the accused participant.
+// On failure it returns nil and the error response that was written.
+func (h *IncidentHandler) issuePenalty(c echo.Context, report *model.IncidentReport, req *model.CreatePenaltyRequest, userID uuid.UUID) (*model.Penalty, error) {
+	ctx := c.Request().Context()
+
+	match, err := h.matchRepo.GetByID(ctx, report.MatchID)
+	if err != nil {
+		slog.Error("Incident.Decide: failed to get match", "error", err, "match_id", report.MatchID)
+		return nil, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	session, ok, err := penaltySession(c, h.sessionRepo, report.MatchID, req.Session, "Incident.Decide")
+	if !ok {
+		return nil, err
+	}
+
+	results, err := h.sessionRepo.ListResults(ctx, session.ID)
+	if err != nil {
+		slog.Error("Incident.Decide: failed to list results", "error", err, "session_id", session.ID)
+		return nil, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 결과를 불러오는데 실패했습니다",
+		})
+	}
+	if !hasResultFor(results, report.AccusedID) {
+		return nil, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "신고 대상 참가자의 경기 결과가 없어 페널티를 적용할 수 없습니다",
+		})
+	}
+
+	penalty := &model.Penalty{
+		MatchID:       report.MatchID,
+		ParticipantID: req.ParticipantID,
+		Session:       req.Session,
+		Type:          req.Type,
+		Value:         req.Value,
+		Reason:        req.Reason,
+		IssuedBy:      userID,
+	}
+
+	if _, err := h.resultService.IssuePenalty(ctx, match, penalty); err != nil {
+		if errors.Is(err, service.ErrPenaltyRequiresTime) {
+			return nil, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "missing_times",
+				Message: "시간 페널티를 적용하려면 완주자 전원의 기록 시간이 필요합니다",
+			})
+		}
+		slog.Error("Incident.Decide: failed to issue penalty", "error", err, "match_id", report.MatchID)
+		return nil, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "페널티 적용에 실패했습니다",
+		})
+	}
+
+	return penalty, nil
+}
+
+// getReport loads an incident report. On failure it returns nil and the error response that was written.
+func (h *IncidentHandler) getReport(c echo.Context, reportID uuid.UUID, op string) (*model.IncidentReport, error) {
+	report, err := h.incidentRepo.GetByID(c.Request().Context(), reportID)
+	if err != nil {
+		if errors.Is(err, repository.ErrIncidentReportNotFound) {
+			return nil, c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "사고 신고를 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get report", "error", err, "report_id", reportID)
+		return nil, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "사고 신고 정보를 불러오는데 실패했습니다",
+		})
+	}
+	return report, nil
+}
+
+// logActivity records an incident activity log entry (non-blocking)
+func (h *IncidentHandler) logActivity(c echo.Context, actorID, reportID uuid.UUID, action model.IncidentActionType, details map[string]any) {
+	activityLog := &model.IncidentActivityLog{
+		ActorID:    actorID,
+		ReportID:   reportID,
+		ActionType: action,
+		Details:    details,
+	}
+	if err := h.activityRepo.Create(c.Request().Context(), activityLog); err != nil {
+		slog.Error("Incident: failed to log activity", "error", err)
+	}
+}
+
+func validateIncidentReportRequest(req *model.CreateIncidentReportRequest) error {
+	if req.MatchID == uuid.Nil {
+		return errors.New("경기를 선택해주세요")
+	}
+	if req.AccusedID == uuid.Nil {
+		return errors.New("신고 대상 참가자를 선택해주세요")
+	}
+	if !slices.Contains(model.PenaltySessions, req.Session) {
+		return errors.New("세션은 sprint, race, feature_race, reverse_grid_race, endurance_stint 중 하나여야 합니다")
+	}
+	if req.Lap != nil && *req.Lap < 1 {
+		return errors.New("랩 번호는 1 이상이어야 합니다")
+	}
+	if strings.TrimSpace(req.Description) == "" {
+		return errors.New("사고 내용을 입력해주세요")
+	}
+	if len(req.EvidenceLinks) > maxEvidenceLinks {
+		return errors.New("영상 링크는 최대 10개까지 첨부할 수 있습니다")
+	}
+	for _, link := range req.EvidenceLinks {
+		if !strings.HasPrefix(link, "http://") && !strings.HasPrefix(link, "https://") {
+			return errors.New("영상 링크는 http:// 또는 https://로 시작해야 합니다")
+		}
+	}
+	return nil
+}
@@ -6,22 +6,29 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/f1-rivals-cup/backend/internal/model"
 	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
+// defaultLeagueTimezone is used for leagues created without a timezone
+const defaultLeagueTimezone = "Asia/Seoul"
+
 // LeagueHandler handles league requests
 type LeagueHandler struct {
-	leagueRepo *repository.LeagueRepository
+	leagueRepo    *repository.LeagueRepository
+	resultService *service.ResultService
 }
 
 // NewLeagueHandler creates a new LeagueHandler
-func NewLeagueHandler(leagueRepo *repository.LeagueRepository) *LeagueHandler {
+func NewLeagueHandler(leagueRepo *repository.LeagueRepository, resultService *service.ResultService) *LeagueHandler {
 	return &LeagueHandler{
-		leagueRepo: leagueRepo,
+		leagueRepo:    leagueRepo,
+		resultService: resultService,
 	}
 }
 
@@ -56,6 +63,11 @@ func (h *LeagueHandler) Create(c echo.Context) error {
 	startDate, _ := repository.ParseTime(safeString(req.StartDate))
 	endDate, _ := repository.ParseTime(safeString(req.EndDate))
 
+	timezone := defaultLeagueTimezone
+	if req.Timezone != nil {
+		timezone = *req.Timezone
+	}
+
 	// Set default season
 	season := req.Season
 	if season < 1 {
@@ -63,17 +75,19 @@ func (h *LeagueHandler) Create(c echo.Context) error {
 	}
 
 	league := &model.League{
-		Name:        req.Name,
-		Description: req.Description,
-		Status:      model.LeagueStatusDraft,
-		Season:      season,
-		CreatedBy:   userID,
-		StartDate:   startDate,
-		EndDate:     endDate,
-		MatchTime:   req.MatchTime,
-		Rules:       req.Rules,
-		Settings:    req.Settings,
-		ContactInfo: req.ContactInfo,
+		Name:         req.Name,
+		Description:  req.Description,
+		Status:       model.LeagueStatusDraft,
+		Season:       season,
+		CreatedBy:    userID,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		MatchTime:    req.MatchTime,
+		Timezone:     timezone,
+		Rules:        req.Rules,
+		Settings:     req.Settings,
+		SettingsNote: req.SettingsNote,
+		ContactInfo:  req.ContactInfo,
 	}
 
 	ctx := c.Request().Context()
@@ -219,11 +233,31 @@ func (h *LeagueHandler) Update(c echo.Context) error {
 	if req.MatchTime != nil {
 		league.MatchTime = req.MatchTime
 	}
+	if req.Timezone != nil {
+		if !validTimezone(*req.Timezone) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "validation_error",
+				Message: "올바르지 않은 시간대입니다",
+			})
+		}
+		league.Timezone = *req.Timezone
+	}
 	if req.Rules != nil {
 		league.Rules = req.Rules
 	}
+	pointsTable := service.LeagueSettingsFor(league).PointsTable
 	if req.Settings != nil {
-		league.Settings = req.Settings
+		settings := service.ApplyLeagueSettingsDefaults(req.Settings)
+		if err := service.ValidateLeagueSettings(settings); err != nil {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+		league.Settings = settings
+	}
+	if req.SettingsNote != nil {
+		league.SettingsNote = req.SettingsNote
 	}
 	if req.ContactInfo != nil {
 		league.ContactInfo = req.ContactInfo
@@ -237,6 +271,17 @@ func (h *LeagueHandler) Update(c echo.Context) error {
 		})
 	}
 
+	// Switching between the standard and a custom points table rescores every stored result
+	if service.LeagueSettingsFor(league).PointsTable != pointsTable {
+		if _, err := h.resultService.RecalculateLeague(ctx, id); err != nil {
+			slog.Error("League.Update: failed to recalculate results", "error", err, "id", id)
+			return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "경기 결과 포인트 재계산에 실패했습니다",
+			})
+		}
+	}
+
 	return c.JSON(http.StatusOK, league)
 }
 
@@ -272,6 +317,11 @@ func (h *LeagueHandler) Delete(c echo.Context) error {
 	})
 }
 
+// GetSettingsSchema handles GET /api/v1/leagues/settings-schema
+func (h *LeagueHandler) GetSettingsSchema(c echo.Context) error {
+	return c.JSON(http.StatusOK, service.LeagueSettingsSchema())
+}
+
 func validateCreateLeagueRequest(req *model.CreateLeagueRequest) error {
 	req.Name = strings.TrimSpace(req.Name)
 
@@ -284,10 +334,28 @@ func validateCreateLeagueRequest(req *model.CreateLeagueRequest) error {
 	if len(req.Name) > 100 {
 		return errors.New("리그 이름은 최대 100자까지 가능합니다")
 	}
+	if req.Timezone != nil && !validTimezone(*req.Timezone) {
+		return errors.New("올바르지 않은 시간대입니다")
+	}
+	if req.Settings != nil {
+		req.Settings = service.ApplyLeagueSettingsDefaults(req.Settings)
+		if err := service.ValidateLeagueSettings(req.Settings); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// validTimezone reports whether name is an IANA timezone such as "Europe/London"
+func validTimezone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
 func safeString(s *string) string {
 	if s == nil {
 		return ""
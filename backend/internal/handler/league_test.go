@@ -0,0 +1,23 @@
+package handler
+
+import "testing"
+
+func TestValidTimezone(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"Asia/Seoul", true},
+		{"Europe/London", true},
+		{"UTC", true},
+		{"", false},
+		{"Local", false},
+		{"Mars/Olympus", false},
+	}
+
+	for _, tt := range tests {
+		if got := validTimezone(tt.name); got != tt.want {
+			t.Errorf("validTimezone(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
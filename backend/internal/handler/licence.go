@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type LicenceHandler struct {
+	licenceRepo     *repository.LicenceRepository
+	leagueRepo      *repository.LeagueRepository
+	matchRepo       *repository.MatchRepository
+	participantRepo *repository.ParticipantRepository
+	licenceService  *service.LicenceService
+}
+
+func NewLicenceHandler(
+	licenceRepo *repository.LicenceRepository,
+	leagueRepo *repository.LeagueRepository,
+	matchRepo *repository.MatchRepository,
+	participantRepo *repository.ParticipantRepository,
+	licenceService *service.LicenceService,
+) *LicenceHandler {
+	return &LicenceHandler{
+		licenceRepo:     licenceRepo,
+		leagueRepo:      leagueRepo,
+		matchRepo:       matchRepo,
+		participantRepo: participantRepo,
+		licenceService:  licenceService,
+	}
+}
+
+// GetSettings handles GET /api/v1/leagues/:id/licence-settings
+func (h *LicenceHandler) GetSettings(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.leagueRepo.GetByID(ctx, leagueID); err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Licence.GetSettings: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	settings, err := h.licenceService.SettingsFor(ctx, leagueID)
+	if err != nil {
+		slog.Error("Licence.GetSettings: failed to get licence settings", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "라이선스 설정을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, settings)
+}
+
+// UpdateSettings handles PUT /api/v1/admin/leagues/:id/licence-settings
+func (h *LicenceHandler) UpdateSettings(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	var req model.UpdateLicenceSettingsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if err := validateLicenceSettingsRequest(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.leagueRepo.GetByID(ctx, leagueID); err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Licence.UpdateSettings: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	settings := &model.LicenceSettings{
+		LeagueID:     leagueID,
+		BanThreshold: req.BanThreshold,
+		ExpiryRounds: req.ExpiryRounds,
+		ExpiryDays:   req.ExpiryDays,
+	}
+
+	if err := h.licenceRepo.UpsertSettings(ctx, settings); err != nil {
+		slog.Error("Licence.UpdateSettings: failed to upsert licence settings", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "라이선스 설정 저장에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, settings)
+}
+
+// History handles GET /api/v1/admin/leagues/:id/licence-history
+func (h *LicenceHandler) History(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.leagueRepo.GetByID(ctx, leagueID); err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Licence.History: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	history, err := h.licenceService.History(ctx, leagueID)
+	if err != nil {
+		slog.Error("Licence.History: failed to get licence history", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "라이선스 기록을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, history)
+}
+
+// Award handles POST /api/v1/admin/leagues/:id/licence-points
+func (h *LicenceHandler) Award(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	var req model.AwardLicencePointsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if req.Points < 1 {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "벌점은 1점 이상이어야 합니다",
+		})
+	}
+	if reason == "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "벌점 사유를 입력해주세요",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	match, err := h.matchRepo.GetByID(ctx, req.MatchID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Licence.Award: failed to get match", "error", err, "match_id", req.MatchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	participant, err := h.participantRepo.GetByID(ctx, req.ParticipantID)
+	if err != nil {
+		if errors.Is(err, repository.ErrParticipantNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "참가자를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Licence.Award: failed to get participant", "error", err, "participant_id", req.ParticipantID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "참가자 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	if match.LeagueID != leagueID || participant.LeagueID != leagueID {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "해당 리그의 경기와 참가자가 아닙니다",
+		})
+	}
+
+	resp, err := h.licenceService.Award(ctx, leagueID, &model.LicencePoint{
+		ParticipantID: req.ParticipantID,
+		MatchID:       req.MatchID,
+		Points:        req.Points,
+		Reason:        reason,
+		IssuedBy:      userID,
+	})
+	if err != nil {
+		slog.Error("Licence.Award: failed to award licence points", "error", err, "participant_id", req.ParticipantID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "라이선스 벌점 부과에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func validateLicenceSettingsRequest(req *model.UpdateLicenceSettingsRequest) error {
+	if req.BanThreshold < 1 {
+		return errors.New("출전 정지 기준 점수는 1 이상이어야 합니다")
+	}
+	if req.ExpiryRounds != nil && *req.ExpiryRounds < 1 {
+		return errors.New("벌점 만료 라운드 수는 1 이상이어야 합니다")
+	}
+	if req.ExpiryDays != nil && *req.ExpiryDays < 1 {
+		return errors.New("벌점 만료 일수는 1 이상이어야 합니다")
+	}
+	return nil
+}
+
+// checkRaceBans rejects a submission containing participants banned from the match.
+// It returns false along with the response already written when the request must stop.
+func checkRaceBans(c echo.Context, licenceService *service.LicenceService, matchID uuid.UUID, participantIDs []uuid.UUID, op string) (bool, error) {
+	banned, err := licenceService.BannedParticipants(c.Request().Context(), matchID)
+	if err != nil {
+		slog.Error(op+": failed to list race bans", "error", err, "match_id", matchID)
+		return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "출전 정지 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	for _, id := range participantIDs {
+		if ban, ok := banned[id]; ok {
+			name := "참가자"
+			if ban.ParticipantName != nil {
+				name = *ban.ParticipantName
+			}
+			return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "driver_banned",
+				Message: name + " 선수는 이 경기에 출전 정지 상태입니다",
+			})
+		}
+	}
+
+	return true, nil
+}
@@ -2,24 +2,37 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 
 	"github.com/f1-rivals-cup/backend/internal/model"
 	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
 type MatchHandler struct {
-	matchRepo  *repository.MatchRepository
-	leagueRepo *repository.LeagueRepository
+	matchRepo       *repository.MatchRepository
+	leagueRepo      *repository.LeagueRepository
+	sessionRepo     *repository.SessionRepository
+	trackRepo       *repository.TrackRepository
+	statusEventRepo *repository.MatchStatusEventRepository
+	rescheduleRepo  *repository.MatchRescheduleRepository
+	lifecycle       *service.MatchLifecycleService
 }
 
-func NewMatchHandler(matchRepo *repository.MatchRepository, leagueRepo *repository.LeagueRepository) *MatchHandler {
+func NewMatchHandler(matchRepo *repository.MatchRepository, leagueRepo *repository.LeagueRepository, sessionRepo *repository.SessionRepository, trackRepo *repository.TrackRepository, statusEventRepo *repository.MatchStatusEventRepository, rescheduleRepo *repository.MatchRescheduleRepository, lifecycle *service.MatchLifecycleService) *MatchHandler {
 	return &MatchHandler{
-		matchRepo:  matchRepo,
-		leagueRepo: leagueRepo,
+		matchRepo:       matchRepo,
+		leagueRepo:      leagueRepo,
+		sessionRepo:     sessionRepo,
+		trackRepo:       trackRepo,
+		statusEventRepo: statusEventRepo,
+		rescheduleRepo:  rescheduleRepo,
+		lifecycle:       lifecycle,
 	}
 }
 
@@ -42,7 +55,7 @@ func (h *MatchHandler) Create(c echo.Context) error {
 		})
 	}
 
-	if req.Track == "" || req.MatchDate == "" || req.Round < 1 {
+	if (req.Track == "" && req.TrackID == nil) || req.MatchDate == "" || req.Round < 1 {
 		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
 			Error:   "invalid_request",
 			Message: "라운드, 트랙, 날짜는 필수입니다",
@@ -81,6 +94,10 @@ func (h *MatchHandler) Create(c echo.Context) error {
 		Description:  req.Description,
 	}
 
+	if ok, err := h.resolveTrack(c, match, req.TrackID, req.Track, "Match.Create"); !ok {
+		return err
+	}
+
 	if err := h.matchRepo.Create(ctx, match); err != nil {
 		if errors.Is(err, repository.ErrDuplicateRound) {
 			return c.JSON(http.StatusConflict, model.ErrorResponse{
@@ -95,6 +112,11 @@ func (h *MatchHandler) Create(c echo.Context) error {
 		})
 	}
 
+	// Reload to pick up the start times computed in the league's timezone
+	if created, err := h.matchRepo.GetByID(ctx, match.ID); err == nil {
+		match = created
+	}
+
 	return c.JSON(http.StatusCreated, match)
 }
 
@@ -158,6 +180,16 @@ func (h *MatchHandler) Get(c echo.Context) error {
 		})
 	}
 
+	sessions, err := h.sessionRepo.ListByMatch(ctx, id)
+	if err != nil {
+		slog.Error("Match.Get: failed to list sessions", "error", err, "match_id", id)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 정보를 불러오는데 실패했습니다",
+		})
+	}
+	match.Sessions = sessions
+
 	return c.JSON(http.StatusOK, match)
 }
 
@@ -197,12 +229,20 @@ func (h *MatchHandler) Update(c echo.Context) error {
 		})
 	}
 
+	prevDate, prevTime := match.MatchDate, match.MatchTime
+
 	// Update fields if provided
 	if req.Round != nil {
 		match.Round = *req.Round
 	}
-	if req.Track != nil {
-		match.Track = *req.Track
+	if req.TrackID != nil || req.Track != nil {
+		name := match.Track
+		if req.Track != nil {
+			name = *req.Track
+		}
+		if ok, err := h.resolveTrack(c, match, req.TrackID, name, "Match.Update"); !ok {
+			return err
+		}
 	}
 	if req.MatchDate != nil {
 		match.MatchDate = *req.MatchDate
@@ -219,10 +259,29 @@ func (h *MatchHandler) Update(c echo.Context) error {
 	if req.SprintTime != nil {
 		match.SprintTime = req.SprintTime
 	}
+	prevStatus, prevSprintStatus := match.Status, match.SprintStatus
 	if req.SprintStatus != nil {
+		if !service.CanTransitionMatchStatus(match.SprintStatus, *req.SprintStatus) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_transition",
+				Message: fmt.Sprintf("스프린트 상태를 %s에서 %s(으)로 변경할 수 없습니다", match.SprintStatus, *req.SprintStatus),
+			})
+		}
 		match.SprintStatus = *req.SprintStatus
 	}
 	if req.Status != nil {
+		if *req.Status == model.MatchStatusPostponed && match.Status != model.MatchStatusPostponed {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_transition",
+				Message: "경기 연기는 연기 요청으로만 할 수 있습니다",
+			})
+		}
+		if !service.CanTransitionMatchStatus(match.Status, *req.Status) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_transition",
+				Message: fmt.Sprintf("경기 상태를 %s에서 %s(으)로 변경할 수 없습니다", match.Status, *req.Status),
+			})
+		}
 		match.Status = *req.Status
 	}
 	if req.Description != nil {
@@ -243,6 +302,17 @@ func (h *MatchHandler) Update(c echo.Context) error {
 		})
 	}
 
+	actorID := contextUserID(c)
+	h.lifecycle.Record(ctx, match.ID, nil, model.MatchStatusSubjectMatch, prevStatus, match.Status, model.MatchStatusReasonAdmin, actorID)
+	h.recordDateEdit(c, match, prevDate, prevTime)
+	if match.HasSprint {
+		h.lifecycle.Record(ctx, match.ID, nil, string(model.SessionTypeSprint), prevSprintStatus, match.SprintStatus, model.MatchStatusReasonAdmin, actorID)
+	}
+
+	if updated, err := h.matchRepo.GetByID(ctx, match.ID); err == nil {
+		match = updated
+	}
+
 	return c.JSON(http.StatusOK, match)
 }
 
@@ -277,3 +347,80 @@ func (h *MatchHandler) Delete(c echo.Context) error {
 		"message": "경기가 삭제되었습니다",
 	})
 }
+
+// ListStatusEvents handles GET /api/v1/admin/matches/:id/status-events
+func (h *MatchHandler) ListStatusEvents(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	events, err := h.statusEventRepo.ListByMatch(c.Request().Context(), id)
+	if err != nil {
+		slog.Error("Match.ListStatusEvents: failed to list status events", "error", err, "match_id", id)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "상태 변경 이력을 불러오는데 실패했습니다",
+		})
+	}
+
+	if events == nil {
+		events = []*model.MatchStatusEvent{}
+	}
+
+	return c.JSON(http.StatusOK, model.ListMatchStatusEventsResponse{
+		Events: events,
+		Total:  len(events),
+	})
+}
+
+// contextUserID returns the authenticated user's ID, or nil when the request is anonymous
+func contextUserID(c echo.Context) *uuid.UUID {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return nil
+	}
+	return &userID
+}
+
+// resolveTrack links a match to a catalogue track, either the one chosen by ID or the one a free-text name refers to.
+// Names that match no catalogue entry are kept as entered. It writes the error response itself and reports false on failure.
+func (h *MatchHandler) resolveTrack(c echo.Context, match *model.Match, trackID *uuid.UUID, name, op string) (bool, error) {
+	ctx := c.Request().Context()
+
+	var track *model.Track
+	var err error
+	if trackID != nil {
+		track, err = h.trackRepo.GetByID(ctx, *trackID)
+		if errors.Is(err, repository.ErrTrackNotFound) {
+			return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_request",
+				Message: "트랙을 찾을 수 없습니다",
+			})
+		}
+	} else {
+		track, err = h.trackRepo.Resolve(ctx, name)
+		if errors.Is(err, repository.ErrTrackNotFound) {
+			match.Track = strings.TrimSpace(name)
+			match.TrackID = nil
+			match.TrackFlag = ""
+			return true, nil
+		}
+	}
+	if err != nil {
+		slog.Error(op+": failed to resolve track", "error", err, "track", name)
+		return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "트랙 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	match.Track = track.Name
+	match.TrackID = &track.ID
+	match.TrackFlag = track.Flag
+	return true, nil
+}
@@ -0,0 +1,280 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Postpone handles POST /api/v1/admin/matches/:id/postpone
+func (h *MatchHandler) Postpone(c echo.Context) error {
+	var req model.PostponeMatchRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "연기 사유는 필수입니다",
+		})
+	}
+	if req.ProposedDate != nil {
+		if _, err := time.Parse(calendarDateLayout, *req.ProposedDate); err != nil {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "validation_error",
+				Message: "날짜 형식이 올바르지 않습니다 (YYYY-MM-DD)",
+			})
+		}
+	}
+	if req.ProposedTime != nil && !validClock(*req.ProposedTime) {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "시간 형식이 올바르지 않습니다 (HH:MM)",
+		})
+	}
+
+	match, ok, err := h.rescheduleTarget(c, "Match.Postpone")
+	if !ok {
+		return err
+	}
+	if !service.CanTransitionMatchStatus(match.Status, model.MatchStatusPostponed) || match.Status == model.MatchStatusPostponed {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_transition",
+			Message: fmt.Sprintf("%s 상태의 경기는 연기할 수 없습니다", match.Status),
+		})
+	}
+
+	ctx := c.Request().Context()
+	actorID := contextUserID(c)
+	entry := &model.MatchReschedule{
+		MatchID:  match.ID,
+		Kind:     model.MatchReschedulePostponed,
+		FromDate: match.MatchDate,
+		FromTime: match.MatchTime,
+		ToDate:   req.ProposedDate,
+		ToTime:   req.ProposedTime,
+		Reason:   &req.Reason,
+		ActorID:  actorID,
+	}
+	if err := h.rescheduleRepo.Postpone(ctx, match, entry); err != nil {
+		slog.Error("Match.Postpone: failed to postpone match", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 연기에 실패했습니다",
+		})
+	}
+	h.lifecycle.Record(ctx, match.ID, nil, model.MatchStatusSubjectMatch, match.Status, model.MatchStatusPostponed, model.MatchStatusReasonAdmin, actorID)
+
+	if updated, err := h.matchRepo.GetByID(ctx, match.ID); err == nil {
+		match = updated
+	}
+
+	return c.JSON(http.StatusOK, match)
+}
+
+// Reschedule handles POST /api/v1/admin/matches/:id/reschedule
+func (h *MatchHandler) Reschedule(c echo.Context) error {
+	var req model.RescheduleMatchRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	match, ok, err := h.rescheduleTarget(c, "Match.Reschedule")
+	if !ok {
+		return err
+	}
+	if match.Status != model.MatchStatusUpcoming && match.Status != model.MatchStatusPostponed {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_transition",
+			Message: fmt.Sprintf("%s 상태의 경기는 일정을 변경할 수 없습니다", match.Status),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	// A postponed match moves to the date proposed when it was called off unless told otherwise
+	date, clock := req.MatchDate, req.MatchTime
+	if date == nil && match.Status == model.MatchStatusPostponed {
+		proposedDate, proposedTime, err := h.rescheduleRepo.LatestProposal(ctx, match.ID)
+		if err != nil {
+			slog.Error("Match.Reschedule: failed to get proposed date", "error", err, "match_id", match.ID)
+			return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "일정 변경 이력을 불러오는데 실패했습니다",
+			})
+		}
+		date = proposedDate
+		if clock == nil {
+			clock = proposedTime
+		}
+	}
+	if date == nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "변경할 날짜는 필수입니다",
+		})
+	}
+	to, err := time.Parse(calendarDateLayout, *date)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "날짜 형식이 올바르지 않습니다 (YYYY-MM-DD)",
+		})
+	}
+	if clock == nil {
+		clock = match.MatchTime
+	} else if !validClock(*clock) {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "시간 형식이 올바르지 않습니다 (HH:MM)",
+		})
+	}
+	from, err := time.Parse(calendarDateLayout, match.MatchDate)
+	if err != nil {
+		from = to
+	}
+	days := int(to.Sub(from).Hours() / 24)
+
+	actorID := contextUserID(c)
+	entry := &model.MatchReschedule{
+		MatchID:  match.ID,
+		Kind:     model.MatchRescheduleRescheduled,
+		FromDate: match.MatchDate,
+		FromTime: match.MatchTime,
+		ToDate:   date,
+		ToTime:   clock,
+		Reason:   req.Reason,
+		ActorID:  actorID,
+	}
+	prevStatus := match.Status
+	match.MatchDate, match.MatchTime = *date, clock
+
+	shiftedIDs, err := h.rescheduleRepo.Reschedule(ctx, match, entry, days, req.Cascade)
+	if err != nil {
+		slog.Error("Match.Reschedule: failed to reschedule match", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 일정 변경에 실패했습니다",
+		})
+	}
+	h.lifecycle.Record(ctx, match.ID, nil, model.MatchStatusSubjectMatch, prevStatus, model.MatchStatusUpcoming, model.MatchStatusReasonAdmin, actorID)
+
+	if updated, err := h.matchRepo.GetByID(ctx, match.ID); err == nil {
+		match = updated
+	}
+	shifted := make([]*model.Match, 0, len(shiftedIDs))
+	for _, id := range shiftedIDs {
+		m, err := h.matchRepo.GetByID(ctx, id)
+		if err != nil {
+			slog.Error("Match.Reschedule: failed to get shifted match", "error", err, "match_id", id)
+			continue
+		}
+		shifted = append(shifted, m)
+	}
+
+	return c.JSON(http.StatusOK, model.RescheduleMatchResponse{
+		Match:   match,
+		Shifted: shifted,
+	})
+}
+
+// ListReschedules handles GET /api/v1/matches/:id/reschedules
+func (h *MatchHandler) ListReschedules(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	reschedules, err := h.rescheduleRepo.ListByMatch(c.Request().Context(), id)
+	if err != nil {
+		slog.Error("Match.ListReschedules: failed to list reschedules", "error", err, "match_id", id)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "일정 변경 이력을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.ListMatchReschedulesResponse{
+		Reschedules: reschedules,
+		Total:       len(reschedules),
+	})
+}
+
+// rescheduleTarget loads the match named in the path. It writes the error response itself and reports false on failure.
+func (h *MatchHandler) rescheduleTarget(c echo.Context, op string) (*model.Match, bool, error) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	match, err := h.matchRepo.GetByID(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return nil, false, c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get match", "error", err, "match_id", id)
+		return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return match, true, nil
+}
+
+// recordDateEdit adds a history entry when a direct edit moved a match to another date or time
+func (h *MatchHandler) recordDateEdit(c echo.Context, match *model.Match, fromDate string, fromTime *string) {
+	if match.MatchDate == fromDate && clockOf(match.MatchTime) == clockOf(fromTime) {
+		return
+	}
+
+	entry := &model.MatchReschedule{
+		MatchID:  match.ID,
+		Kind:     model.MatchRescheduleRescheduled,
+		FromDate: fromDate,
+		FromTime: fromTime,
+		ToDate:   &match.MatchDate,
+		ToTime:   match.MatchTime,
+		ActorID:  contextUserID(c),
+	}
+	if err := h.rescheduleRepo.RecordEdit(c.Request().Context(), entry); err != nil {
+		slog.Error("Match.Update: failed to record reschedule", "error", err, "match_id", match.ID)
+	}
+}
+
+// clockOf trims a stored time of day to HH:MM so "21:00" and "21:00:00" compare equal
+func clockOf(clock *string) string {
+	if clock == nil {
+		return ""
+	}
+	if len(*clock) > 5 {
+		return (*clock)[:5]
+	}
+	return *clock
+}
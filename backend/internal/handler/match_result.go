@@ -4,26 +4,40 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strings"
 
 	"github.com/f1-rivals-cup/backend/internal/model"
 	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
 type MatchResultHandler struct {
-	resultRepo      *repository.MatchResultRepository
-	matchRepo       *repository.MatchRepository
-	leagueRepo      *repository.LeagueRepository
-	participantRepo *repository.ParticipantRepository
+	resultRepo       *repository.MatchResultRepository
+	matchRepo        *repository.MatchRepository
+	sessionRepo      *repository.SessionRepository
+	leagueRepo       *repository.LeagueRepository
+	participantRepo  *repository.ParticipantRepository
+	resultService    *service.ResultService
+	standingsService *service.StandingsService
+	licenceService   *service.LicenceService
+	lifecycle        *service.MatchLifecycleService
+	sessionHandler   *SessionHandler
 }
 
-func NewMatchResultHandler(resultRepo *repository.MatchResultRepository, matchRepo *repository.MatchRepository, leagueRepo *repository.LeagueRepository, participantRepo *repository.ParticipantRepository) *MatchResultHandler {
+func NewMatchResultHandler(resultRepo *repository.MatchResultRepository, matchRepo *repository.MatchRepository, sessionRepo *repository.SessionRepository, leagueRepo *repository.LeagueRepository, participantRepo *repository.ParticipantRepository, resultService *service.ResultService, standingsService *service.StandingsService, licenceService *service.LicenceService, lifecycle *service.MatchLifecycleService, sessionHandler *SessionHandler) *MatchResultHandler {
 	return &MatchResultHandler{
-		resultRepo:      resultRepo,
-		matchRepo:       matchRepo,
-		leagueRepo:      leagueRepo,
-		participantRepo: participantRepo,
+		resultRepo:       resultRepo,
+		matchRepo:        matchRepo,
+		sessionRepo:      sessionRepo,
+		leagueRepo:       leagueRepo,
+		participantRepo:  participantRepo,
+		resultService:    resultService,
+		standingsService: standingsService,
+		licenceService:   licenceService,
+		lifecycle:        lifecycle,
+		sessionHandler:   sessionHandler,
 	}
 }
 
@@ -112,33 +126,8 @@ func (h *MatchResultHandler) BulkUpdate(c echo.Context) error {
 		})
 	}
 
-	// Populate team_name for each result from participant's current team
-	for i := range req.Results {
-		if req.Results[i].TeamName == nil {
-			participant, err := h.participantRepo.GetByID(ctx, req.Results[i].ParticipantID)
-			if err != nil {
-				slog.Error("MatchResult.BulkUpdate: failed to get participant", "error", err, "participant_id", req.Results[i].ParticipantID)
-				continue
-			}
-			req.Results[i].TeamName = participant.TeamName
-		}
-	}
-
-	// Bulk upsert results
-	if err := h.resultRepo.BulkUpsert(ctx, matchID, req.Results); err != nil {
-		slog.Error("MatchResult.BulkUpdate: failed to bulk upsert results", "error", err, "match_id", matchID)
-		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
-			Error:   "server_error",
-			Message: "경기 결과 저장에 실패했습니다",
-		})
-	}
-
-	// Update match status to completed if not already
-	if match.Status != model.MatchStatusCompleted {
-		match.Status = model.MatchStatusCompleted
-		if err := h.matchRepo.Update(ctx, match); err != nil {
-			slog.Error("MatchResult.BulkUpdate: failed to update match status", "error", err, "match_id", matchID)
-		}
+	if ok, err := h.saveResults(c, match, req.Results, "MatchResult.BulkUpdate"); !ok {
+		return err
 	}
 
 	// Return updated results
@@ -159,8 +148,18 @@ func (h *MatchResultHandler) BulkUpdate(c echo.Context) error {
 
 // UpdateSprintResults handles PUT /api/v1/admin/matches/:id/results/sprint
 func (h *MatchResultHandler) UpdateSprintResults(c echo.Context) error {
-	matchIDStr := c.Param("id")
-	matchID, err := uuid.Parse(matchIDStr)
+	return h.updateSessionResults(c, model.SessionTypeSprint, "MatchResult.UpdateSprintResults")
+}
+
+// UpdateRaceResults handles PUT /api/v1/admin/matches/:id/results/race
+func (h *MatchResultHandler) UpdateRaceResults(c echo.Context) error {
+	return h.updateSessionResults(c, model.SessionTypeRace, "MatchResult.UpdateRaceResults")
+}
+
+// updateSessionResults stores the race or sprint half of combined match result entries through the session
+// save path, leaving the other session's results as they are. Race results also complete the match.
+func (h *MatchResultHandler) updateSessionResults(c echo.Context, sessionType model.SessionType, op string) error {
+	matchID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
 			Error:   "invalid_request",
@@ -176,9 +175,16 @@ func (h *MatchResultHandler) UpdateSprintResults(c echo.Context) error {
 		})
 	}
 
+	sessionReq := model.BulkUpdateSessionResultsRequest{Results: sessionResultRequests(req.Results, sessionType)}
+	if err := validateSessionResultsRequest(&sessionReq); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
 	ctx := c.Request().Context()
 
-	// Check if match exists and has sprint
 	match, err := h.matchRepo.GetByID(ctx, matchID)
 	if err != nil {
 		if errors.Is(err, repository.ErrMatchNotFound) {
@@ -187,55 +193,46 @@ func (h *MatchResultHandler) UpdateSprintResults(c echo.Context) error {
 				Message: "경기를 찾을 수 없습니다",
 			})
 		}
-		slog.Error("MatchResult.UpdateSprintResults: failed to get match", "error", err, "match_id", matchID)
+		slog.Error(op+": failed to get match", "error", err, "match_id", matchID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
 			Message: "경기 정보를 불러오는데 실패했습니다",
 		})
 	}
 
-	if !match.HasSprint {
+	if sessionType == model.SessionTypeSprint && !match.HasSprint {
 		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
 			Error:   "invalid_request",
 			Message: "스프린트 레이스가 없는 경기입니다",
 		})
 	}
 
-	// Populate team_name for each result from participant's current team
-	for i := range req.Results {
-		if req.Results[i].TeamName == nil {
-			participant, err := h.participantRepo.GetByID(ctx, req.Results[i].ParticipantID)
-			if err != nil {
-				slog.Error("MatchResult.UpdateSprintResults: failed to get participant", "error", err, "participant_id", req.Results[i].ParticipantID)
-				continue
-			}
-			req.Results[i].TeamName = participant.TeamName
+	session, err := h.sessionRepo.GetByMatchAndType(ctx, matchID, sessionType)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "세션을 찾을 수 없습니다",
+			})
 		}
-	}
-
-	// Bulk upsert sprint results only (preserves existing race results)
-	if err := h.resultRepo.BulkUpsertSprintResults(ctx, matchID, req.Results); err != nil {
-		slog.Error("MatchResult.UpdateSprintResults: failed to bulk upsert results", "error", err, "match_id", matchID)
+		slog.Error(op+": failed to get session", "error", err, "match_id", matchID, "type", sessionType)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
-			Message: "스프린트 결과 저장에 실패했습니다",
+			Message: "세션 정보를 불러오는데 실패했습니다",
 		})
 	}
 
-	// Update sprint_status to completed
-	if match.SprintStatus != model.MatchStatusCompleted {
-		if err := h.matchRepo.UpdateSprintStatus(ctx, matchID, model.MatchStatusCompleted); err != nil {
-			slog.Error("MatchResult.UpdateSprintResults: failed to update sprint status", "error", err, "match_id", matchID)
-		}
+	if ok, err := h.sessionHandler.saveResults(c, match, session, sessionReq.Results, sessionType == model.SessionTypeRace, op); !ok {
+		return err
 	}
 
 	// Return updated results
 	results, err := h.resultRepo.ListByMatch(ctx, matchID)
 	if err != nil {
-		slog.Error("MatchResult.UpdateSprintResults: failed to list results", "error", err, "match_id", matchID)
+		slog.Error(op+": failed to list results", "error", err, "match_id", matchID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
-			Message: "스프린트 결과를 불러오는데 실패했습니다",
+			Message: "경기 결과를 불러오는데 실패했습니다",
 		})
 	}
 
@@ -245,8 +242,8 @@ func (h *MatchResultHandler) UpdateSprintResults(c echo.Context) error {
 	})
 }
 
-// UpdateRaceResults handles PUT /api/v1/admin/matches/:id/results/race
-func (h *MatchResultHandler) UpdateRaceResults(c echo.Context) error {
+// Delete handles DELETE /api/v1/admin/matches/:id/results
+func (h *MatchResultHandler) Delete(c echo.Context) error {
 	matchIDStr := c.Param("id")
 	matchID, err := uuid.Parse(matchIDStr)
 	if err != nil {
@@ -256,106 +253,145 @@ func (h *MatchResultHandler) UpdateRaceResults(c echo.Context) error {
 		})
 	}
 
-	var req model.BulkUpdateResultsRequest
-	if err := c.Bind(&req); err != nil {
+	ctx := c.Request().Context()
+
+	if err := h.resultRepo.DeleteByMatch(ctx, matchID); err != nil {
+		slog.Error("MatchResult.Delete: failed to delete results", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 결과 삭제에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "경기 결과가 삭제되었습니다",
+	})
+}
+
+// Standings handles GET /api/v1/leagues/:id/standings
+func (h *MatchResultHandler) Standings(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
 		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
 			Error:   "invalid_request",
-			Message: "잘못된 요청입니다",
+			Message: "잘못된 리그 ID입니다",
 		})
 	}
 
 	ctx := c.Request().Context()
 
-	// Check if match exists
-	match, err := h.matchRepo.GetByID(ctx, matchID)
+	// Get league info
+	league, err := h.leagueRepo.GetByID(ctx, leagueID)
 	if err != nil {
-		if errors.Is(err, repository.ErrMatchNotFound) {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
 			return c.JSON(http.StatusNotFound, model.ErrorResponse{
 				Error:   "not_found",
-				Message: "경기를 찾을 수 없습니다",
+				Message: "리그를 찾을 수 없습니다",
 			})
 		}
-		slog.Error("MatchResult.UpdateRaceResults: failed to get match", "error", err, "match_id", matchID)
+		slog.Error("MatchResult.Standings: failed to get league", "error", err, "league_id", leagueID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
-			Message: "경기 정보를 불러오는데 실패했습니다",
+			Message: "리그 정보를 불러오는데 실패했습니다",
 		})
 	}
 
-	// Populate team_name for each result from participant's current team
-	for i := range req.Results {
-		if req.Results[i].TeamName == nil {
-			participant, err := h.participantRepo.GetByID(ctx, req.Results[i].ParticipantID)
-			if err != nil {
-				slog.Error("MatchResult.UpdateRaceResults: failed to get participant", "error", err, "participant_id", req.Results[i].ParticipantID)
-				continue
-			}
-			req.Results[i].TeamName = participant.TeamName
-		}
-	}
-
-	// Bulk upsert race results only (preserves existing sprint results)
-	if err := h.resultRepo.BulkUpsertRaceResults(ctx, matchID, req.Results); err != nil {
-		slog.Error("MatchResult.UpdateRaceResults: failed to bulk upsert results", "error", err, "match_id", matchID)
+	// Get total races count
+	matches, err := h.matchRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		slog.Error("MatchResult.Standings: failed to list matches", "error", err, "league_id", leagueID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
-			Message: "본 레이스 결과 저장에 실패했습니다",
+			Message: "경기 정보를 불러오는데 실패했습니다",
 		})
 	}
 
-	// Update status to completed
-	if match.Status != model.MatchStatusCompleted {
-		if err := h.matchRepo.UpdateStatus(ctx, matchID, model.MatchStatusCompleted); err != nil {
-			slog.Error("MatchResult.UpdateRaceResults: failed to update status", "error", err, "match_id", matchID)
-		}
-	}
-
-	// Return updated results
-	results, err := h.resultRepo.ListByMatch(ctx, matchID)
+	// Get driver and team standings with the league's standings rules applied,
+	// leaving out provisional results when only official standings are requested
+	officialOnly := c.QueryParam("official") == "true"
+	standings, err := h.standingsService.Compute(ctx, leagueID, officialOnly)
 	if err != nil {
-		slog.Error("MatchResult.UpdateRaceResults: failed to list results", "error", err, "match_id", matchID)
+		slog.Error("MatchResult.Standings: failed to compute standings", "error", err, "league_id", leagueID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
-			Message: "본 레이스 결과를 불러오는데 실패했습니다",
+			Message: "순위 정보를 불러오는데 실패했습니다",
 		})
 	}
 
-	return c.JSON(http.StatusOK, model.ListMatchResultsResponse{
-		Results: results,
-		Total:   len(results),
+	return c.JSON(http.StatusOK, model.LeagueStandingsResponse{
+		LeagueID:      leagueID,
+		LeagueName:    league.Name,
+		Season:        league.Season,
+		TotalRaces:    len(matches),
+		Standings:     standings.Drivers,
+		TeamStandings: standings.Teams,
+		Rules:         standings.Rules,
+
+		OfficialOnly:      officialOnly,
+		ProvisionalRounds: standings.ProvisionalRounds,
 	})
 }
 
-// Delete handles DELETE /api/v1/admin/matches/:id/results
-func (h *MatchResultHandler) Delete(c echo.Context) error {
-	matchIDStr := c.Param("id")
-	matchID, err := uuid.Parse(matchIDStr)
+// Progression handles GET /api/v1/leagues/:id/standings/progression
+func (h *MatchResultHandler) Progression(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
 			Error:   "invalid_request",
-			Message: "잘못된 경기 ID입니다",
+			Message: "잘못된 리그 ID입니다",
 		})
 	}
 
 	ctx := c.Request().Context()
 
-	if err := h.resultRepo.DeleteByMatch(ctx, matchID); err != nil {
-		slog.Error("MatchResult.Delete: failed to delete results", "error", err, "match_id", matchID)
+	league, err := h.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("MatchResult.Progression: failed to get league", "error", err, "league_id", leagueID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
-			Message: "경기 결과 삭제에 실패했습니다",
+			Message: "리그 정보를 불러오는데 실패했습니다",
 		})
 	}
 
-	return c.JSON(http.StatusOK, map[string]string{
-		"message": "경기 결과가 삭제되었습니다",
+	matches, err := h.matchRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		slog.Error("MatchResult.Progression: failed to list matches", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	progression, err := h.standingsService.Progression(ctx, leagueID, matches)
+	if err != nil {
+		slog.Error("MatchResult.Progression: failed to compute progression", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "순위 변동 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.StandingsProgressionResponse{
+		LeagueID:   leagueID,
+		LeagueName: league.Name,
+		Season:     league.Season,
+		Rounds:     progression.Rounds,
+		Drivers:    progression.Drivers,
+		Teams:      progression.Teams,
 	})
 }
 
-// Standings handles GET /api/v1/leagues/:id/standings
-func (h *MatchResultHandler) Standings(c echo.Context) error {
-	leagueIDStr := c.Param("id")
-	leagueID, err := uuid.Parse(leagueIDStr)
+// Outlook handles GET /api/v1/leagues/:id/standings/outlook
+func (h *MatchResultHandler) Outlook(c echo.Context) error {
+	leagueID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
 			Error:   "invalid_request",
@@ -365,7 +401,6 @@ func (h *MatchResultHandler) Standings(c echo.Context) error {
 
 	ctx := c.Request().Context()
 
-	// Get league info
 	league, err := h.leagueRepo.GetByID(ctx, leagueID)
 	if err != nil {
 		if errors.Is(err, repository.ErrLeagueNotFound) {
@@ -374,49 +409,345 @@ func (h *MatchResultHandler) Standings(c echo.Context) error {
 				Message: "리그를 찾을 수 없습니다",
 			})
 		}
-		slog.Error("MatchResult.Standings: failed to get league", "error", err, "league_id", leagueID)
+		slog.Error("MatchResult.Outlook: failed to get league", "error", err, "league_id", leagueID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
 			Message: "리그 정보를 불러오는데 실패했습니다",
 		})
 	}
 
-	// Get total races count
 	matches, err := h.matchRepo.ListByLeague(ctx, leagueID)
 	if err != nil {
-		slog.Error("MatchResult.Standings: failed to list matches", "error", err, "league_id", leagueID)
+		slog.Error("MatchResult.Outlook: failed to list matches", "error", err, "league_id", leagueID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
 			Message: "경기 정보를 불러오는데 실패했습니다",
 		})
 	}
 
-	// Get driver standings
-	standings, err := h.resultRepo.GetLeagueStandings(ctx, leagueID)
+	sessions, err := h.sessionRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		slog.Error("MatchResult.Outlook: failed to list sessions", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	standings, err := h.standingsService.Compute(ctx, leagueID, false)
 	if err != nil {
-		slog.Error("MatchResult.Standings: failed to get driver standings", "error", err, "league_id", leagueID)
+		slog.Error("MatchResult.Outlook: failed to compute standings", "error", err, "league_id", leagueID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
 			Message: "순위 정보를 불러오는데 실패했습니다",
 		})
 	}
 
-	// Get team standings
-	teamStandings, err := h.resultRepo.GetTeamStandings(ctx, leagueID)
+	ps, err := h.resultService.PointsSystemFor(ctx, leagueID)
 	if err != nil {
-		slog.Error("MatchResult.Standings: failed to get team standings", "error", err, "league_id", leagueID)
+		slog.Error("MatchResult.Outlook: failed to get points system", "error", err, "league_id", leagueID)
 		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
 			Error:   "server_error",
-			Message: "팀 순위 정보를 불러오는데 실패했습니다",
+			Message: "포인트 시스템을 불러오는데 실패했습니다",
 		})
 	}
 
-	return c.JSON(http.StatusOK, model.LeagueStandingsResponse{
-		LeagueID:      leagueID,
-		LeagueName:    league.Name,
-		Season:        league.Season,
-		TotalRaces:    len(matches),
-		Standings:     standings,
-		TeamStandings: teamStandings,
+	outlook := service.ComputeChampionshipOutlook(standings, matches, sessions, ps)
+	if outlook.RemainingRounds == nil {
+		outlook.RemainingRounds = []model.RemainingRound{}
+	}
+
+	return c.JSON(http.StatusOK, model.ChampionshipOutlookResponse{
+		LeagueID:        leagueID,
+		LeagueName:      league.Name,
+		Season:          league.Season,
+		RemainingRounds: outlook.RemainingRounds,
+		Drivers:         outlook.Drivers,
+		Teams:           outlook.Teams,
+	})
+}
+
+// HeadToHead handles GET /api/v1/leagues/:id/head-to-head?a=&b=&type=drivers|teams
+// Without a type, two participant IDs compare drivers and anything else compares team names.
+func (h *MatchResultHandler) HeadToHead(c echo.Context) error {
+	leagueID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	a, b := strings.TrimSpace(c.QueryParam("a")), strings.TrimSpace(c.QueryParam("b"))
+	if a == "" || b == "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "비교할 두 대상을 입력해주세요",
+		})
+	}
+
+	kind := c.QueryParam("type")
+	if kind == "" {
+		kind = "teams"
+		if _, errA := uuid.Parse(a); errA == nil {
+			if _, errB := uuid.Parse(b); errB == nil {
+				kind = "drivers"
+			}
+		}
+	}
+	if kind != "drivers" && kind != "teams" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "비교 종류는 drivers 또는 teams여야 합니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.leagueRepo.GetByID(ctx, leagueID); err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("MatchResult.HeadToHead: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	participants, err := h.participantRepo.ListByLeague(ctx, leagueID, string(model.ParticipantStatusApproved))
+	if err != nil {
+		slog.Error("MatchResult.HeadToHead: failed to list participants", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "참가자 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	subjectA, entryA, okA := service.ResolveHeadToHead(participants, a, kind == "teams")
+	subjectB, entryB, okB := service.ResolveHeadToHead(participants, b, kind == "teams")
+	if !okA || !okB {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			Error:   "not_found",
+			Message: "비교할 드라이버 또는 팀을 찾을 수 없습니다",
+		})
+	}
+	same := entryA.Name == entryB.Name
+	if kind == "drivers" {
+		same = *entryA.ParticipantID == *entryB.ParticipantID
+	}
+	if same {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "서로 다른 두 대상을 선택해주세요",
+		})
+	}
+
+	matches, err := h.matchRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		slog.Error("MatchResult.HeadToHead: failed to list matches", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	h2h, err := h.standingsService.HeadToHead(ctx, leagueID, subjectA, subjectB, matches)
+	if err != nil {
+		slog.Error("MatchResult.HeadToHead: failed to compare", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "맞대결 기록을 불러오는데 실패했습니다",
+		})
+	}
+
+	entryA.Points, entryB.Points = h2h.PointsA, h2h.PointsB
+	rounds := h2h.Rounds
+	if rounds == nil {
+		rounds = []model.HeadToHeadRound{}
+	}
+
+	return c.JSON(http.StatusOK, model.HeadToHeadResponse{
+		LeagueID:   leagueID,
+		Type:       kind,
+		A:          entryA,
+		B:          entryB,
+		Race:       h2h.Race,
+		Sprint:     h2h.Sprint,
+		Qualifying: h2h.Qualifying,
+		Rounds:     rounds,
 	})
 }
+
+// saveResults stores a full race and sprint classification, calculating points, re-applying penalties
+// and completing the match. It returns false along with the response already written when the request must stop.
+func (h *MatchResultHandler) saveResults(c echo.Context, match *model.Match, results []model.CreateMatchResultRequest, op string) (bool, error) {
+	ctx := c.Request().Context()
+
+	sessions, ok, err := h.resultSessions(c, match, []model.SessionType{model.SessionTypeRace}, op)
+	if !ok {
+		return false, err
+	}
+	if ok, err := checkResultsCompletable(c, match, sessions, true); !ok {
+		return false, err
+	}
+
+	// Drivers serving a race ban cannot be classified in this match
+	if ok, err := checkRaceBans(c, h.licenceService, match.ID, resultParticipantIDs(results), op); !ok {
+		return false, err
+	}
+
+	// Populate team_name for each result from participant's current team
+	for i := range results {
+		if results[i].TeamName == nil {
+			participant, err := h.participantRepo.GetByID(ctx, results[i].ParticipantID)
+			if err != nil {
+				slog.Error(op+": failed to get participant", "error", err, "participant_id", results[i].ParticipantID)
+				continue
+			}
+			results[i].TeamName = participant.TeamName
+		}
+	}
+
+	// Calculate points from the league's points table (manual overrides are kept)
+	ps, err := h.resultService.PointsSystemFor(ctx, match.LeagueID)
+	if err != nil {
+		slog.Error(op+": failed to get points system", "error", err, "league_id", match.LeagueID)
+		return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "포인트 시스템을 불러오는데 실패했습니다",
+		})
+	}
+	service.ApplyPoints(ps, results, true, true)
+
+	// Nothing is stored when the active penalties cannot be applied to the entered classification
+	if ok, err := h.checkPenalties(c, match, results, true, true, op); !ok {
+		return false, err
+	}
+
+	// Bulk upsert results
+	if err := h.resultRepo.BulkUpsert(ctx, match.ID, results); err != nil {
+		slog.Error(op+": failed to bulk upsert results", "error", err, "match_id", match.ID)
+		return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 결과 저장에 실패했습니다",
+		})
+	}
+
+	// Re-apply any active penalties on top of the newly entered classification
+	if _, err := h.resultService.ReclassifyMatch(ctx, match); err != nil {
+		if errors.Is(err, service.ErrPenaltyRequiresTime) {
+			return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "missing_times",
+				Message: "시간 페널티가 적용된 경기는 완주자 전원의 기록 시간이 필요합니다",
+			})
+		}
+		slog.Error(op+": failed to apply penalties", "error", err, "match_id", match.ID)
+		return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "페널티 적용에 실패했습니다",
+		})
+	}
+
+	// Update the race session and the match to completed
+	return completeResults(c, h.lifecycle, match, sessions, true, op)
+}
+
+// resultSessions loads the first session of each type in a match, leaving out types the match does not have.
+// It returns false along with the response already written when the request must stop.
+func (h *MatchResultHandler) resultSessions(c echo.Context, match *model.Match, types []model.SessionType, op string) ([]*model.MatchSession, bool, error) {
+	var sessions []*model.MatchSession
+	for _, sessionType := range types {
+		session, err := h.sessionRepo.GetByMatchAndType(c.Request().Context(), match.ID, sessionType)
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			continue
+		}
+		if err != nil {
+			slog.Error(op+": failed to get session", "error", err, "match_id", match.ID, "type", sessionType)
+			return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "세션 정보를 불러오는데 실패했습니다",
+			})
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, true, nil
+}
+
+// checkPenalties makes sure the match's active penalties can be applied to the entered race and/or sprint
+// classification before anything is stored. It returns false along with the response already written when the request must stop.
+func (h *MatchResultHandler) checkPenalties(c echo.Context, match *model.Match, results []model.CreateMatchResultRequest, race, sprint bool, op string) (bool, error) {
+	ctx := c.Request().Context()
+
+	var sessionTypes []model.SessionType
+	if race {
+		sessionTypes = append(sessionTypes, model.SessionTypeRace)
+	}
+	if sprint {
+		sessionTypes = append(sessionTypes, model.SessionTypeSprint)
+	}
+
+	for _, sessionType := range sessionTypes {
+		session, err := h.sessionRepo.GetByMatchAndType(ctx, match.ID, sessionType)
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			continue
+		}
+		if err == nil {
+			err = h.resultService.CheckPenalties(ctx, match, session, sessionResultRequests(results, sessionType))
+		}
+		if err != nil {
+			if errors.Is(err, service.ErrPenaltyRequiresTime) {
+				return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+					Error:   "missing_times",
+					Message: "시간 페널티가 적용된 경기는 완주자 전원의 기록 시간이 필요합니다",
+				})
+			}
+			slog.Error(op+": failed to check penalties", "error", err, "match_id", match.ID)
+			return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "페널티 적용에 실패했습니다",
+			})
+		}
+	}
+
+	return true, nil
+}
+
+// sessionResultRequests picks the race or sprint half of combined match result entries
+func sessionResultRequests(results []model.CreateMatchResultRequest, sessionType model.SessionType) []model.CreateSessionResultRequest {
+	requests := make([]model.CreateSessionResultRequest, len(results))
+	for i, r := range results {
+		if sessionType == model.SessionTypeSprint {
+			requests[i] = model.CreateSessionResultRequest{
+				ParticipantID: r.ParticipantID,
+				Position:      r.SprintPosition,
+				Points:        r.SprintPoints,
+				PointsManual:  r.SprintPointsManual,
+				TimeMs:        r.SprintTimeMs,
+			}
+			continue
+		}
+		requests[i] = model.CreateSessionResultRequest{
+			ParticipantID: r.ParticipantID,
+			Position:      r.Position,
+			Points:        r.Points,
+			PointsManual:  r.PointsManual,
+			FastestLap:    r.FastestLap,
+			DNF:           r.DNF,
+			DNFReason:     r.DNFReason,
+			TimeMs:        r.RaceTimeMs,
+		}
+	}
+	return requests
+}
+
+func resultParticipantIDs(results []model.CreateMatchResultRequest) []uuid.UUID {
+	ids := make([]uuid.UUID, len(results))
+	for i, r := range results {
+		ids[i] = r.ParticipantID
+	}
+	return ids
+}
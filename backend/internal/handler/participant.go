@@ -2,11 +2,14 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 
 	"github.com/f1-rivals-cup/backend/internal/model"
 	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
@@ -15,13 +18,15 @@ type ParticipantHandler struct {
 	participantRepo *repository.ParticipantRepository
 	leagueRepo      *repository.LeagueRepository
 	accountRepo     *repository.AccountRepository
+	licenceService  *service.LicenceService
 }
 
-func NewParticipantHandler(participantRepo *repository.ParticipantRepository, leagueRepo *repository.LeagueRepository, accountRepo *repository.AccountRepository) *ParticipantHandler {
+func NewParticipantHandler(participantRepo *repository.ParticipantRepository, leagueRepo *repository.LeagueRepository, accountRepo *repository.AccountRepository, licenceService *service.LicenceService) *ParticipantHandler {
 	return &ParticipantHandler{
 		participantRepo: participantRepo,
 		leagueRepo:      leagueRepo,
 		accountRepo:     accountRepo,
+		licenceService:  licenceService,
 	}
 }
 
@@ -100,32 +105,21 @@ func (h *ParticipantHandler) Join(c echo.Context) error {
 		})
 	}
 
-	// Check player limit per team (2 players max)
-	hasPlayerRole := false
+	settings := service.LeagueSettingsFor(league)
 	for _, role := range req.Roles {
-		if role == string(model.RolePlayer) {
-			hasPlayerRole = true
-			break
-		}
-	}
-
-	if hasPlayerRole && req.TeamName != nil && *req.TeamName != "" {
-		playerCount, err := h.participantRepo.CountPlayersByTeam(ctx, leagueID, *req.TeamName)
-		if err != nil {
-			slog.Error("Participant.Join: failed to count players by team", "error", err, "league_id", leagueID, "team_name", *req.TeamName)
-			return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
-				Error:   "server_error",
-				Message: "팀 정보를 확인하는데 실패했습니다",
-			})
-		}
-		if playerCount >= 2 {
+		if !service.RoleAllowed(settings, role) {
 			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
-				Error:   "team_full",
-				Message: "해당 팀의 선수 정원(2명)이 이미 찼습니다",
+				Error:   "role_not_allowed",
+				Message: "이 리그에서 신청할 수 없는 역할입니다: " + role,
 			})
 		}
 	}
 
+	// Check reserve limit and player limit per team
+	if ok, err := h.checkRosterLimits(c, settings, leagueID, req.Roles, req.TeamName); !ok {
+		return err
+	}
+
 	participant := &model.LeagueParticipant{
 		LeagueID: leagueID,
 		UserID:   userID,
@@ -188,6 +182,10 @@ func (h *ParticipantHandler) GetMyStatus(c echo.Context) error {
 		})
 	}
 
+	if participant.Status == model.ParticipantStatusApproved {
+		h.attachLicences(c, leagueID, []*model.LeagueParticipant{participant})
+	}
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"is_participating": true,
 		"participant":      participant,
@@ -278,6 +276,8 @@ func (h *ParticipantHandler) ListByLeague(c echo.Context) error {
 		participants = []*model.LeagueParticipant{}
 	}
 
+	h.attachLicences(c, leagueID, participants)
+
 	return c.JSON(http.StatusOK, model.ListParticipantsResponse{
 		Participants: participants,
 		Total:        len(participants),
@@ -312,6 +312,8 @@ func (h *ParticipantHandler) ListApprovedByLeague(c echo.Context) error {
 		participants = []*model.LeagueParticipant{}
 	}
 
+	h.attachLicences(c, leagueID, participants)
+
 	return c.JSON(http.StatusOK, model.ListParticipantsResponse{
 		Participants: participants,
 		Total:        len(participants),
@@ -393,6 +395,21 @@ func (h *ParticipantHandler) UpdateStatus(c echo.Context) error {
 		})
 	}
 
+	// Re-check roster limits before approving, as pending participants are not counted against them
+	if req.Status == model.ParticipantStatusApproved && participant.Status != model.ParticipantStatusApproved {
+		league, err := h.leagueRepo.GetByID(ctx, participant.LeagueID)
+		if err != nil {
+			slog.Error("Participant.UpdateStatus: failed to get league", "error", err, "league_id", participant.LeagueID)
+			return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "리그 정보를 불러오는데 실패했습니다",
+			})
+		}
+		if ok, err := h.checkRosterLimits(c, service.LeagueSettingsFor(league), participant.LeagueID, participant.Roles, participant.TeamName); !ok {
+			return err
+		}
+	}
+
 	if err := h.participantRepo.UpdateStatus(ctx, id, req.Status); err != nil {
 		if errors.Is(err, repository.ErrParticipantNotFound) {
 			return c.JSON(http.StatusNotFound, model.ErrorResponse{
@@ -476,3 +493,70 @@ func (h *ParticipantHandler) UpdateTeam(c echo.Context) error {
 		"message": "팀이 배정되었습니다",
 	})
 }
+
+// checkRosterLimits checks the league's reserve limit and the team's player limit for a participant
+// with roles, writing the error response when one is full
+func (h *ParticipantHandler) checkRosterLimits(c echo.Context, settings *model.LeagueSettings, leagueID uuid.UUID, roles []string, teamName *string) (bool, error) {
+	ctx := c.Request().Context()
+
+	var reserves, teamPlayers int
+	if settings.MaxReserves > 0 && slices.Contains(roles, string(model.RoleReserve)) {
+		count, err := h.participantRepo.CountByRole(ctx, leagueID, model.RoleReserve)
+		if err != nil {
+			slog.Error("Participant.checkRosterLimits: failed to count reserves", "error", err, "league_id", leagueID)
+			return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "리저브 정보를 확인하는데 실패했습니다",
+			})
+		}
+		reserves = count
+	}
+	if slices.Contains(roles, string(model.RolePlayer)) && teamName != nil && *teamName != "" {
+		count, err := h.participantRepo.CountPlayersByTeam(ctx, leagueID, *teamName)
+		if err != nil {
+			slog.Error("Participant.checkRosterLimits: failed to count players by team", "error", err, "league_id", leagueID, "team_name", *teamName)
+			return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "팀 정보를 확인하는데 실패했습니다",
+			})
+		}
+		teamPlayers = count
+	}
+
+	switch err := service.CheckRosterLimits(settings, roles, reserves, teamPlayers); {
+	case errors.Is(err, service.ErrReservesFull):
+		return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "reserves_full",
+			Message: fmt.Sprintf("리저브 선수 정원(%d명)이 이미 찼습니다", settings.MaxReserves),
+		})
+	case errors.Is(err, service.ErrTeamFull):
+		return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "team_full",
+			Message: fmt.Sprintf("해당 팀의 선수 정원(%d명)이 이미 찼습니다", settings.SeatsPerTeam),
+		})
+	}
+	return true, nil
+}
+
+// attachLicences fills in the licence points and upcoming race ban of each participant.
+// Failures are logged and leave the licence field empty.
+func (h *ParticipantHandler) attachLicences(c echo.Context, leagueID uuid.UUID, participants []*model.LeagueParticipant) {
+	if len(participants) == 0 {
+		return
+	}
+
+	ids := make([]uuid.UUID, len(participants))
+	for i, p := range participants {
+		ids[i] = p.ID
+	}
+
+	licences, err := h.licenceService.StatusByLeague(c.Request().Context(), leagueID, ids)
+	if err != nil {
+		slog.Error("Participant: failed to get licence status", "error", err, "league_id", leagueID)
+		return
+	}
+
+	for _, p := range participants {
+		p.Licence = licences[p.ID]
+	}
+}
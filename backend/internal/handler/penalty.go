@@ -0,0 +1,319 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type PenaltyHandler struct {
+	penaltyRepo   *repository.PenaltyRepository
+	matchRepo     *repository.MatchRepository
+	sessionRepo   *repository.SessionRepository
+	resultService *service.ResultService
+}
+
+func NewPenaltyHandler(penaltyRepo *repository.PenaltyRepository, matchRepo *repository.MatchRepository, sessionRepo *repository.SessionRepository, resultService *service.ResultService) *PenaltyHandler {
+	return &PenaltyHandler{
+		penaltyRepo:   penaltyRepo,
+		matchRepo:     matchRepo,
+		sessionRepo:   sessionRepo,
+		resultService: resultService,
+	}
+}
+
+// List handles GET /api/v1/matches/:id/penalties
+func (h *PenaltyHandler) List(c echo.Context) error {
+	matchIDStr := c.Param("id")
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.matchRepo.GetByID(ctx, matchID); err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Penalty.List: failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	penalties, err := h.penaltyRepo.ListByMatch(ctx, matchID)
+	if err != nil {
+		slog.Error("Penalty.List: failed to list penalties", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "페널티 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	if penalties == nil {
+		penalties = []*model.Penalty{}
+	}
+
+	return c.JSON(http.StatusOK, model.ListPenaltiesResponse{
+		Penalties: penalties,
+		Total:     len(penalties),
+	})
+}
+
+// Create handles POST /api/v1/admin/matches/:id/penalties
+func (h *PenaltyHandler) Create(c echo.Context) error {
+	matchIDStr := c.Param("id")
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	var req model.CreatePenaltyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if err := validatePenaltyRequest(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	match, err := h.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Penalty.Create: failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	session, ok, err := penaltySession(c, h.sessionRepo, matchID, req.Session, "Penalty.Create")
+	if !ok {
+		return err
+	}
+
+	// The penalised driver must have a result in this session
+	results, err := h.sessionRepo.ListResults(ctx, session.ID)
+	if err != nil {
+		slog.Error("Penalty.Create: failed to list results", "error", err, "session_id", session.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 결과를 불러오는데 실패했습니다",
+		})
+	}
+	if !hasResultFor(results, req.ParticipantID) {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "해당 참가자의 경기 결과가 없습니다",
+		})
+	}
+
+	penalty := &model.Penalty{
+		MatchID:       matchID,
+		ParticipantID: req.ParticipantID,
+		Session:       req.Session,
+		Type:          req.Type,
+		Value:         req.Value,
+		Reason:        strings.TrimSpace(req.Reason),
+		IssuedBy:      userID,
+	}
+
+	reclassified, err := h.resultService.IssuePenalty(ctx, match, penalty)
+	if err != nil {
+		if errors.Is(err, service.ErrPenaltyRequiresTime) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "missing_times",
+				Message: "시간 페널티를 적용하려면 완주자 전원의 기록 시간이 필요합니다",
+			})
+		}
+		slog.Error("Penalty.Create: failed to issue penalty", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "페널티 적용에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, model.PenaltyResponse{
+		Penalty: penalty,
+		Results: reclassified,
+	})
+}
+
+// Revoke handles DELETE /api/v1/admin/penalties/:id
+func (h *PenaltyHandler) Revoke(c echo.Context) error {
+	penaltyIDStr := c.Param("id")
+	penaltyID, err := uuid.Parse(penaltyIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 페널티 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	penalty, err := h.penaltyRepo.GetByID(ctx, penaltyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPenaltyNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "페널티를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Penalty.Revoke: failed to get penalty", "error", err, "penalty_id", penaltyID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "페널티 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	match, err := h.matchRepo.GetByID(ctx, penalty.MatchID)
+	if err != nil {
+		slog.Error("Penalty.Revoke: failed to get match", "error", err, "match_id", penalty.MatchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	reclassified, err := h.resultService.RevokePenalty(ctx, match, penaltyID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPenaltyAlreadyRevoked) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "already_revoked",
+				Message: "이미 취소된 페널티입니다",
+			})
+		}
+		if errors.Is(err, service.ErrPenaltyRequiresTime) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "missing_times",
+				Message: "시간 페널티가 적용된 경기는 완주자 전원의 기록 시간이 필요합니다",
+			})
+		}
+		slog.Error("Penalty.Revoke: failed to revoke penalty", "error", err, "penalty_id", penaltyID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "페널티 취소에 실패했습니다",
+		})
+	}
+
+	penalty, err = h.penaltyRepo.GetByID(ctx, penaltyID)
+	if err != nil {
+		slog.Error("Penalty.Revoke: failed to reload penalty", "error", err, "penalty_id", penaltyID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "페널티 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.PenaltyResponse{
+		Penalty: penalty,
+		Results: reclassified,
+	})
+}
+
+func validatePenaltyRequest(req *model.CreatePenaltyRequest) error {
+	if req.ParticipantID == uuid.Nil {
+		return errors.New("페널티 대상 참가자를 선택해주세요")
+	}
+	if !slices.Contains(model.PenaltySessions, req.Session) {
+		return errors.New("세션은 sprint, race, feature_race, reverse_grid_race, endurance_stint 중 하나여야 합니다")
+	}
+	switch req.Type {
+	case model.PenaltyTypeTime:
+		if req.Value <= 0 {
+			return errors.New("시간 페널티는 1초 이상이어야 합니다")
+		}
+	case model.PenaltyTypePositionDrop:
+		if req.Value <= 0 {
+			return errors.New("순위 강등은 1계단 이상이어야 합니다")
+		}
+	case model.PenaltyTypeDisqualification:
+		req.Value = 0
+	default:
+		return errors.New("페널티 유형은 time, position_drop, disqualification 중 하나여야 합니다")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return errors.New("페널티 사유를 입력해주세요")
+	}
+	return nil
+}
+
+// penaltySession loads the session of a match that penalties and incident reports for a session type refer to,
+// which is the first session of that type. It returns false along with the response already written when the request must stop.
+func penaltySession(c echo.Context, sessionRepo *repository.SessionRepository, matchID uuid.UUID, session model.PenaltySession, op string) (*model.MatchSession, bool, error) {
+	s, err := sessionRepo.GetByMatchAndType(c.Request().Context(), matchID, model.SessionType(session))
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_request",
+				Message: fmt.Sprintf("%s 세션이 없는 경기입니다", session),
+			})
+		}
+		slog.Error(op+": failed to get session", "error", err, "match_id", matchID)
+		return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return s, true, nil
+}
+
+func hasResultFor(results []*model.SessionResult, participantID uuid.UUID) bool {
+	for _, r := range results {
+		if r.ParticipantID == participantID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// maxPointsTableSize limits the number of scoring positions in a points table
+const maxPointsTableSize = 30
+
+type PointsSystemHandler struct {
+	leagueRepo    *repository.LeagueRepository
+	resultService *service.ResultService
+}
+
+func NewPointsSystemHandler(leagueRepo *repository.LeagueRepository, resultService *service.ResultService) *PointsSystemHandler {
+	return &PointsSystemHandler{
+		leagueRepo:    leagueRepo,
+		resultService: resultService,
+	}
+}
+
+// Get handles GET /api/v1/leagues/:id/points-system
+func (h *PointsSystemHandler) Get(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.leagueRepo.GetByID(ctx, leagueID); err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("PointsSystem.Get: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	ps, err := h.resultService.PointsSystemFor(ctx, leagueID)
+	if err != nil {
+		slog.Error("PointsSystem.Get: failed to get points system", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "포인트 시스템을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, ps)
+}
+
+// Update handles PUT /api/v1/admin/leagues/:id/points-system
+func (h *PointsSystemHandler) Update(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	var req model.UpdatePointsSystemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if err := validatePointsSystemRequest(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	league, err := h.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("PointsSystem.Update: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	ps := &model.PointsSystem{
+		LeagueID:              leagueID,
+		RacePoints:            req.RacePoints,
+		SprintPoints:          req.SprintPoints,
+		FastestLapPoints:      req.FastestLapPoints,
+		FastestLapMaxPosition: req.FastestLapMaxPosition,
+		DNFNoPoints:           req.DNFNoPoints,
+	}
+	if ps.SprintPoints == nil {
+		ps.SprintPoints = []float64{}
+	}
+
+	// The table, the switch to it and the recalculated points of every stored result are saved together
+	recalculated, err := h.resultService.SavePointsSystem(ctx, league, ps)
+	if err != nil {
+		slog.Error("PointsSystem.Update: failed to save points system", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "포인트 시스템 저장에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.UpdatePointsSystemResponse{
+		PointsSystem:        ps,
+		RecalculatedResults: recalculated,
+	})
+}
+
+func validatePointsSystemRequest(req *model.UpdatePointsSystemRequest) error {
+	if len(req.RacePoints) == 0 {
+		return errors.New("레이스 포인트 테이블을 입력해주세요")
+	}
+	if len(req.RacePoints) > maxPointsTableSize || len(req.SprintPoints) > maxPointsTableSize {
+		return errors.New("포인트 테이블은 최대 30위까지 설정할 수 있습니다")
+	}
+	for _, p := range append(append([]float64{}, req.RacePoints...), req.SprintPoints...) {
+		if p < 0 {
+			return errors.New("포인트는 0 이상이어야 합니다")
+		}
+	}
+	if req.FastestLapPoints < 0 {
+		return errors.New("패스티스트 랩 포인트는 0 이상이어야 합니다")
+	}
+	if req.FastestLapMaxPosition != nil && *req.FastestLapMaxPosition < 1 {
+		return errors.New("패스티스트 랩 보너스 순위 제한은 1 이상이어야 합니다")
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type QualifyingHandler struct {
+	qualifyingRepo  *repository.QualifyingRepository
+	matchRepo       *repository.MatchRepository
+	participantRepo *repository.ParticipantRepository
+	licenceService  *service.LicenceService
+}
+
+func NewQualifyingHandler(qualifyingRepo *repository.QualifyingRepository, matchRepo *repository.MatchRepository, participantRepo *repository.ParticipantRepository, licenceService *service.LicenceService) *QualifyingHandler {
+	return &QualifyingHandler{
+		qualifyingRepo:  qualifyingRepo,
+		matchRepo:       matchRepo,
+		participantRepo: participantRepo,
+		licenceService:  licenceService,
+	}
+}
+
+// ListQualifying handles GET /api/v1/matches/:id/qualifying
+func (h *QualifyingHandler) ListQualifying(c echo.Context) error {
+	return h.list(c, model.QualifyingSessionQualifying, "Qualifying.ListQualifying")
+}
+
+// ListSprintShootout handles GET /api/v1/matches/:id/sprint-shootout
+func (h *QualifyingHandler) ListSprintShootout(c echo.Context) error {
+	return h.list(c, model.QualifyingSessionSprintShootout, "Qualifying.ListSprintShootout")
+}
+
+// UpdateQualifying handles PUT /api/v1/admin/matches/:id/results/qualifying
+func (h *QualifyingHandler) UpdateQualifying(c echo.Context) error {
+	return h.update(c, model.QualifyingSessionQualifying, "Qualifying.UpdateQualifying")
+}
+
+// UpdateSprintShootout handles PUT /api/v1/admin/matches/:id/results/sprint-shootout
+func (h *QualifyingHandler) UpdateSprintShootout(c echo.Context) error {
+	return h.update(c, model.QualifyingSessionSprintShootout, "Qualifying.UpdateSprintShootout")
+}
+
+func (h *QualifyingHandler) list(c echo.Context, session model.QualifyingSession, op string) error {
+	matchIDStr := c.Param("id")
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.matchRepo.GetByID(ctx, matchID); err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	results, err := h.qualifyingRepo.ListByMatch(ctx, matchID, session)
+	if err != nil {
+		slog.Error(op+": failed to list qualifying results", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "예선 결과를 불러오는데 실패했습니다",
+		})
+	}
+
+	if results == nil {
+		results = []*model.QualifyingResult{}
+	}
+
+	return c.JSON(http.StatusOK, model.QualifyingResultListResponse{
+		MatchID: matchID,
+		Session: session,
+		Results: results,
+		Total:   len(results),
+	})
+}
+
+func (h *QualifyingHandler) update(c echo.Context, session model.QualifyingSession, op string) error {
+	matchIDStr := c.Param("id")
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	var req model.BulkUpdateQualifyingRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if err := validateQualifyingRequest(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	match, err := h.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	if session == model.QualifyingSessionSprintShootout && !match.HasSprint {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "스프린트 레이스가 없는 경기입니다",
+		})
+	}
+
+	ids := make([]uuid.UUID, len(req.Results))
+	for i, r := range req.Results {
+		ids[i] = r.ParticipantID
+	}
+	if ok, err := checkRaceBans(c, h.licenceService, matchID, ids, op); !ok {
+		return err
+	}
+
+	results := make([]*model.QualifyingResult, len(req.Results))
+	for i, r := range req.Results {
+		teamName := r.TeamName
+		// Populate team_name from participant's current team
+		if teamName == nil {
+			participant, err := h.participantRepo.GetByID(ctx, r.ParticipantID)
+			if err != nil {
+				slog.Error(op+": failed to get participant", "error", err, "participant_id", r.ParticipantID)
+			} else {
+				teamName = participant.TeamName
+			}
+		}
+		results[i] = &model.QualifyingResult{
+			MatchID:       matchID,
+			ParticipantID: r.ParticipantID,
+			Session:       session,
+			TeamName:      teamName,
+			Position:      r.Position,
+			BestLapMs:     r.BestLapMs,
+			GridPenalty:   r.GridPenalty,
+			PitLaneStart:  r.PitLaneStart,
+		}
+	}
+	service.BuildGrid(results)
+
+	if err := h.qualifyingRepo.ReplaceSession(ctx, matchID, session, results); err != nil {
+		slog.Error(op+": failed to save qualifying results", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "예선 결과 저장에 실패했습니다",
+		})
+	}
+
+	return h.list(c, session, op)
+}
+
+func validateQualifyingRequest(req *model.BulkUpdateQualifyingRequest) error {
+	positions := make(map[int]bool, len(req.Results))
+	participants := make(map[uuid.UUID]bool, len(req.Results))
+	for _, r := range req.Results {
+		if r.ParticipantID == uuid.Nil {
+			return errors.New("참가자를 선택해주세요")
+		}
+		if participants[r.ParticipantID] {
+			return errors.New("같은 참가자가 중복으로 입력되었습니다")
+		}
+		participants[r.ParticipantID] = true
+
+		if r.Position < 1 {
+			return errors.New("예선 순위는 1 이상이어야 합니다")
+		}
+		if positions[r.Position] {
+			return errors.New("예선 순위가 중복되었습니다")
+		}
+		positions[r.Position] = true
+
+		if r.BestLapMs != nil && *r.BestLapMs <= 0 {
+			return errors.New("랩 타임은 0보다 커야 합니다")
+		}
+		if r.GridPenalty < 0 {
+			return errors.New("그리드 페널티는 0 이상이어야 합니다")
+		}
+	}
+	return nil
+}
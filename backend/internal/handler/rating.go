@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type RatingHandler struct {
+	ratingRepo    *repository.RatingRepository
+	userRepo      *repository.UserRepository
+	ratingService *service.RatingService
+}
+
+func NewRatingHandler(ratingRepo *repository.RatingRepository, userRepo *repository.UserRepository, ratingService *service.RatingService) *RatingHandler {
+	return &RatingHandler{
+		ratingRepo:    ratingRepo,
+		userRepo:      userRepo,
+		ratingService: ratingService,
+	}
+}
+
+// Leaderboard handles GET /api/v1/ratings?page=&limit=&min_matches=
+func (h *RatingHandler) Leaderboard(c echo.Context) error {
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	minMatches, _ := strconv.Atoi(c.QueryParam("min_matches"))
+	if minMatches < 0 {
+		minMatches = 0
+	}
+
+	ratings, total, err := h.ratingRepo.Leaderboard(c.Request().Context(), page, limit, minMatches)
+	if err != nil {
+		slog.Error("Rating.Leaderboard: failed to list ratings", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "레이팅 순위를 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.RatingLeaderboardResponse{
+		Ratings:    ratings,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: (total + limit - 1) / limit,
+	})
+}
+
+// History handles GET /api/v1/users/:id/ratings
+func (h *RatingHandler) History(c echo.Context) error {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 사용자 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.userRepo.GetByID(ctx, userID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "사용자를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Rating.History: failed to get user", "error", err, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "사용자 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	// Users without rated matches simply have no rating yet
+	rating, err := h.ratingRepo.GetByUser(ctx, userID)
+	if err != nil && !errors.Is(err, repository.ErrDriverRatingNotFound) {
+		slog.Error("Rating.History: failed to get rating", "error", err, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "레이팅 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	history, err := h.ratingRepo.ListHistory(ctx, userID)
+	if err != nil {
+		slog.Error("Rating.History: failed to list history", "error", err, "user_id", userID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "레이팅 기록을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.RatingHistoryResponse{
+		Rating:  rating,
+		History: history,
+	})
+}
+
+// Recompute handles POST /api/v1/admin/ratings/recompute
+func (h *RatingHandler) Recompute(c echo.Context) error {
+	result, err := h.ratingService.Recompute(c.Request().Context())
+	if err != nil {
+		slog.Error("Rating.Recompute: failed to recompute ratings", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "레이팅 재계산에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// maxImportFileSize limits uploaded classification files
+const maxImportFileSize = 1 << 20
+
+// PreviewImport handles POST /api/v1/admin/matches/:id/results/import/preview
+func (h *MatchResultHandler) PreviewImport(c echo.Context) error {
+	match, ok, err := h.getMatch(c, "MatchResult.PreviewImport")
+	if !ok {
+		return err
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "가져올 파일을 선택해주세요",
+		})
+	}
+	if file.Size > maxImportFileSize {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "file_too_large",
+			Message: "파일 크기는 1MB를 넘을 수 없습니다",
+		})
+	}
+
+	format := strings.ToLower(c.FormValue("format"))
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file.Filename)), ".")
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		slog.Error("MatchResult.PreviewImport: failed to open file", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "파일을 읽는데 실패했습니다",
+		})
+	}
+	defer src.Close()
+
+	rows, err := service.ParseResultImport(format, src)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_file",
+			Message: err.Error(),
+		})
+	}
+
+	participants, err := h.participantRepo.ListByLeague(c.Request().Context(), match.LeagueID, string(model.ParticipantStatusApproved))
+	if err != nil {
+		slog.Error("MatchResult.PreviewImport: failed to list participants", "error", err, "league_id", match.LeagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "참가자 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	service.MatchImportRows(rows, participants)
+
+	preview := model.ResultImportPreview{
+		MatchID: match.ID,
+		Format:  format,
+		Rows:    rows,
+	}
+	for _, row := range rows {
+		switch row.Status {
+		case model.ImportMatchMatched:
+			preview.Matched++
+		case model.ImportMatchAmbiguous:
+			preview.Ambiguous++
+		default:
+			preview.Unmatched++
+		}
+	}
+
+	return c.JSON(http.StatusOK, preview)
+}
+
+// Import handles POST /api/v1/admin/matches/:id/results/import
+func (h *MatchResultHandler) Import(c echo.Context) error {
+	var req model.ConfirmResultImportRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if err := validateResultImportRequest(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	match, ok, err := h.getMatch(c, "MatchResult.Import")
+	if !ok {
+		return err
+	}
+
+	if ok, err := h.saveResults(c, match, service.ImportResultRequests(req.Rows), "MatchResult.Import"); !ok {
+		return err
+	}
+
+	results, err := h.resultRepo.ListByMatch(c.Request().Context(), match.ID)
+	if err != nil {
+		slog.Error("MatchResult.Import: failed to list results", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 결과를 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.ListMatchResultsResponse{
+		Results: results,
+		Total:   len(results),
+	})
+}
+
+// Export handles GET /api/v1/matches/:id/results/export
+func (h *MatchResultHandler) Export(c echo.Context) error {
+	match, ok, err := h.getMatch(c, "MatchResult.Export")
+	if !ok {
+		return err
+	}
+
+	results, err := h.resultRepo.ListByMatch(c.Request().Context(), match.ID)
+	if err != nil {
+		slog.Error("MatchResult.Export: failed to list results", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 결과를 불러오는데 실패했습니다",
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := service.WriteMatchResultsCSV(&buf, results); err != nil {
+		slog.Error("MatchResult.Export: failed to write csv", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 결과 내보내기에 실패했습니다",
+		})
+	}
+
+	return sendCSV(c, fmt.Sprintf("round-%d-results.csv", match.Round), &buf)
+}
+
+// ExportStandings handles GET /api/v1/leagues/:id/standings/export?type=drivers|teams
+func (h *MatchResultHandler) ExportStandings(c echo.Context) error {
+	leagueID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	kind := c.QueryParam("type")
+	if kind == "" {
+		kind = "drivers"
+	}
+	if kind != "drivers" && kind != "teams" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "순위 종류는 drivers 또는 teams여야 합니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.leagueRepo.GetByID(ctx, leagueID); err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("MatchResult.ExportStandings: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	standings, err := h.standingsService.Compute(ctx, leagueID, c.QueryParam("official") == "true")
+	if err != nil {
+		slog.Error("MatchResult.ExportStandings: failed to compute standings", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "순위 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	var buf bytes.Buffer
+	if kind == "teams" {
+		err = service.WriteTeamStandingsCSV(&buf, standings.Teams)
+	} else {
+		err = service.WriteDriverStandingsCSV(&buf, standings.Drivers)
+	}
+	if err != nil {
+		slog.Error("MatchResult.ExportStandings: failed to write csv", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "순위 내보내기에 실패했습니다",
+		})
+	}
+
+	return sendCSV(c, kind+"-standings.csv", &buf)
+}
+
+// getMatch loads the match named by the :id path parameter.
+// It returns false along with the response already written when the request must stop.
+func (h *MatchResultHandler) getMatch(c echo.Context, op string) (*model.Match, bool, error) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	match, err := h.matchRepo.GetByID(c.Request().Context(), matchID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return nil, false, c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get match", "error", err, "match_id", matchID)
+		return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return match, true, nil
+}
+
+func sendCSV(c echo.Context, filename string, body io.Reader) error {
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return c.Stream(http.StatusOK, "text/csv; charset=utf-8", body)
+}
+
+func validateResultImportRequest(req *model.ConfirmResultImportRequest) error {
+	if len(req.Rows) == 0 {
+		return errors.New("가져올 결과가 없습니다")
+	}
+
+	positions := make(map[int]bool, len(req.Rows))
+	sprintPositions := make(map[int]bool, len(req.Rows))
+	participants := make(map[uuid.UUID]bool, len(req.Rows))
+	for _, row := range req.Rows {
+		if row.ParticipantID == uuid.Nil {
+			return errors.New("모든 행에 참가자를 지정해주세요")
+		}
+		if participants[row.ParticipantID] {
+			return errors.New("같은 참가자가 중복으로 입력되었습니다")
+		}
+		participants[row.ParticipantID] = true
+
+		if row.PenaltySec < 0 {
+			return errors.New("페널티 시간은 0 이상이어야 합니다")
+		}
+		if row.Position != nil {
+			if *row.Position < 1 || positions[*row.Position] {
+				return errors.New("순위가 올바르지 않거나 중복되었습니다")
+			}
+			positions[*row.Position] = true
+		}
+		if row.SprintPosition != nil {
+			if *row.SprintPosition < 1 || sprintPositions[*row.SprintPosition] {
+				return errors.New("스프린트 순위가 올바르지 않거나 중복되었습니다")
+			}
+			sprintPositions[*row.SprintPosition] = true
+		}
+	}
+	return nil
+}
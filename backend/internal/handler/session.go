@@ -0,0 +1,692 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var sessionTypes = []model.SessionType{
+	model.SessionTypePractice,
+	model.SessionTypeQualifying,
+	model.SessionTypeSprintShootout,
+	model.SessionTypeSprint,
+	model.SessionTypeRace,
+	model.SessionTypeFeatureRace,
+	model.SessionTypeReverseGridRace,
+	model.SessionTypeEnduranceStint,
+}
+
+var sessionStatuses = []model.MatchStatus{
+	model.MatchStatusUpcoming,
+	model.MatchStatusInProgress,
+	model.MatchStatusCompleted,
+	model.MatchStatusCancelled,
+}
+
+type SessionHandler struct {
+	sessionRepo     *repository.SessionRepository
+	matchRepo       *repository.MatchRepository
+	participantRepo *repository.ParticipantRepository
+	resultService   *service.ResultService
+	licenceService  *service.LicenceService
+	lifecycle       *service.MatchLifecycleService
+}
+
+func NewSessionHandler(sessionRepo *repository.SessionRepository, matchRepo *repository.MatchRepository, participantRepo *repository.ParticipantRepository, resultService *service.ResultService, licenceService *service.LicenceService, lifecycle *service.MatchLifecycleService) *SessionHandler {
+	return &SessionHandler{
+		sessionRepo:     sessionRepo,
+		matchRepo:       matchRepo,
+		participantRepo: participantRepo,
+		resultService:   resultService,
+		licenceService:  licenceService,
+		lifecycle:       lifecycle,
+	}
+}
+
+// List handles GET /api/v1/matches/:id/sessions
+func (h *SessionHandler) List(c echo.Context) error {
+	matchIDStr := c.Param("id")
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.matchRepo.GetByID(ctx, matchID); err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Session.List: failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	sessions, err := h.sessionRepo.ListByMatch(ctx, matchID)
+	if err != nil {
+		slog.Error("Session.List: failed to list sessions", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	if sessions == nil {
+		sessions = []*model.MatchSession{}
+	}
+
+	return c.JSON(http.StatusOK, model.ListSessionsResponse{
+		MatchID:  matchID,
+		Sessions: sessions,
+		Total:    len(sessions),
+	})
+}
+
+// Create handles POST /api/v1/admin/matches/:id/sessions
+func (h *SessionHandler) Create(c echo.Context) error {
+	matchIDStr := c.Param("id")
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	var req model.CreateSessionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if !slices.Contains(sessionTypes, req.Type) {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "올바르지 않은 세션 유형입니다",
+		})
+	}
+
+	session := &model.MatchSession{
+		MatchID:         matchID,
+		Type:            req.Type,
+		Name:            req.Name,
+		SessionDate:     req.SessionDate,
+		SessionTime:     req.SessionTime,
+		Status:          model.MatchStatusUpcoming,
+		ReverseGridSize: req.ReverseGridSize,
+		DurationMinutes: req.DurationMinutes,
+	}
+	if req.Order != nil {
+		session.Order = *req.Order
+	}
+
+	if err := validateSession(session); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.matchRepo.GetByID(ctx, matchID); err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Session.Create: failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	if err := h.sessionRepo.Create(ctx, session); err != nil {
+		if errors.Is(err, repository.ErrDuplicateSessionOrder) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "duplicate_order",
+				Message: "이미 해당 순서의 세션이 존재합니다",
+			})
+		}
+		slog.Error("Session.Create: failed to create session", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 생성에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, session)
+}
+
+// Update handles PUT /api/v1/admin/sessions/:id
+func (h *SessionHandler) Update(c echo.Context) error {
+	var req model.UpdateSessionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	session, ok, err := h.getSession(c, "Session.Update")
+	if !ok {
+		return err
+	}
+
+	if req.Name != nil {
+		session.Name = req.Name
+	}
+	if req.Order != nil {
+		session.Order = *req.Order
+	}
+	if req.SessionDate != nil {
+		session.SessionDate = req.SessionDate
+	}
+	if req.SessionTime != nil {
+		session.SessionTime = req.SessionTime
+	}
+	prevStatus := session.Status
+	if req.Status != nil {
+		if slices.Contains(sessionStatuses, *req.Status) && !service.CanTransitionMatchStatus(session.Status, *req.Status) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_transition",
+				Message: fmt.Sprintf("세션 상태를 %s에서 %s(으)로 변경할 수 없습니다", session.Status, *req.Status),
+			})
+		}
+		session.Status = *req.Status
+	}
+	if req.ReverseGridSize != nil {
+		session.ReverseGridSize = req.ReverseGridSize
+	}
+	if req.DurationMinutes != nil {
+		session.DurationMinutes = req.DurationMinutes
+	}
+
+	if err := validateSession(session); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if err := h.sessionRepo.Update(ctx, session); err != nil {
+		if errors.Is(err, repository.ErrDuplicateSessionOrder) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "duplicate_order",
+				Message: "이미 해당 순서의 세션이 존재합니다",
+			})
+		}
+		slog.Error("Session.Update: failed to update session", "error", err, "session_id", session.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 수정에 실패했습니다",
+		})
+	}
+
+	h.lifecycle.Record(ctx, session.MatchID, &session.ID, string(session.Type), prevStatus, session.Status, model.MatchStatusReasonAdmin, contextUserID(c))
+
+	return c.JSON(http.StatusOK, session)
+}
+
+// Delete handles DELETE /api/v1/admin/sessions/:id
+func (h *SessionHandler) Delete(c echo.Context) error {
+	session, ok, err := h.getSession(c, "Session.Delete")
+	if !ok {
+		return err
+	}
+
+	if err := h.sessionRepo.Delete(c.Request().Context(), session.ID); err != nil {
+		slog.Error("Session.Delete: failed to delete session", "error", err, "session_id", session.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 삭제에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "세션이 삭제되었습니다",
+	})
+}
+
+// ListResults handles GET /api/v1/matches/:id/sessions/:sessionId/results
+func (h *SessionHandler) ListResults(c echo.Context) error {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+	sessionID, err := uuid.Parse(c.Param("sessionId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 세션 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	session, err := h.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil && !errors.Is(err, repository.ErrSessionNotFound) {
+		slog.Error("Session.ListResults: failed to get session", "error", err, "session_id", sessionID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 정보를 불러오는데 실패했습니다",
+		})
+	}
+	if session == nil || session.MatchID != matchID {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			Error:   "not_found",
+			Message: "세션을 찾을 수 없습니다",
+		})
+	}
+
+	return h.respondResults(c, session, "Session.ListResults")
+}
+
+// UpdateResults handles PUT /api/v1/admin/sessions/:id/results
+func (h *SessionHandler) UpdateResults(c echo.Context) error {
+	var req model.BulkUpdateSessionResultsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if err := validateSessionResultsRequest(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	session, ok, err := h.getSession(c, "Session.UpdateResults")
+	if !ok {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	match, err := h.matchRepo.GetByID(ctx, session.MatchID)
+	if err != nil {
+		slog.Error("Session.UpdateResults: failed to get match", "error", err, "match_id", session.MatchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	if ok, err := h.saveResults(c, match, session, req.Results, false, "Session.UpdateResults"); !ok {
+		return err
+	}
+
+	return h.respondResults(c, session, "Session.UpdateResults")
+}
+
+// DeleteResults handles DELETE /api/v1/admin/sessions/:id/results
+func (h *SessionHandler) DeleteResults(c echo.Context) error {
+	session, ok, err := h.getSession(c, "Session.DeleteResults")
+	if !ok {
+		return err
+	}
+
+	if err := h.sessionRepo.DeleteResults(c.Request().Context(), session.ID); err != nil {
+		slog.Error("Session.DeleteResults: failed to delete results", "error", err, "session_id", session.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 결과 삭제에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "세션 결과가 삭제되었습니다",
+	})
+}
+
+// FinaliseResults handles PUT /api/v1/admin/sessions/:id/results/finalise
+func (h *SessionHandler) FinaliseResults(c echo.Context) error {
+	session, ok, err := h.getSession(c, "Session.FinaliseResults")
+	if !ok {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	if err := h.sessionRepo.FinaliseResults(ctx, session.ID); err != nil {
+		if errors.Is(err, repository.ErrResultsNotProvisional) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "not_provisional",
+				Message: "잠정 결과가 아닙니다",
+			})
+		}
+		slog.Error("Session.FinaliseResults: failed to finalise results", "error", err, "session_id", session.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "결과 확정에 실패했습니다",
+		})
+	}
+
+	session, err = h.sessionRepo.GetByID(ctx, session.ID)
+	if err != nil {
+		slog.Error("Session.FinaliseResults: failed to get session", "error", err, "session_id", session.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return h.respondResults(c, session, "Session.FinaliseResults")
+}
+
+// FinaliseMatchResults handles PUT /api/v1/admin/matches/:id/results/finalise
+func (h *SessionHandler) FinaliseMatchResults(c echo.Context) error {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.matchRepo.GetByID(ctx, matchID); err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Session.FinaliseMatchResults: failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	count, err := h.sessionRepo.FinaliseMatchResults(ctx, matchID)
+	if err != nil {
+		slog.Error("Session.FinaliseMatchResults: failed to finalise results", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "결과 확정에 실패했습니다",
+		})
+	}
+	if count == 0 {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "not_provisional",
+			Message: "확정할 잠정 결과가 없습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":   "경기 결과가 확정되었습니다",
+		"finalised": count,
+	})
+}
+
+// saveResults replaces a session's results, calculating points, applying penalties and completing the session,
+// along with the match when completeMatch is set.
+// It returns false along with the response already written when the request must stop.
+func (h *SessionHandler) saveResults(c echo.Context, match *model.Match, session *model.MatchSession, results []model.CreateSessionResultRequest, completeMatch bool, op string) (bool, error) {
+	ctx := c.Request().Context()
+
+	// Results cannot complete a session the state machine keeps out of completed
+	if ok, err := checkResultsCompletable(c, match, []*model.MatchSession{session}, completeMatch); !ok {
+		return false, err
+	}
+
+	ids := make([]uuid.UUID, len(results))
+	for i, r := range results {
+		ids[i] = r.ParticipantID
+	}
+	if ok, err := checkRaceBans(c, h.licenceService, match.ID, ids, op); !ok {
+		return false, err
+	}
+
+	// Populate team_name for each result from participant's current team
+	for i := range results {
+		if results[i].TeamName == nil {
+			participant, err := h.participantRepo.GetByID(ctx, results[i].ParticipantID)
+			if err != nil {
+				slog.Error(op+": failed to get participant", "error", err, "participant_id", results[i].ParticipantID)
+				continue
+			}
+			results[i].TeamName = participant.TeamName
+		}
+	}
+
+	// Only points-scoring sessions carry championship points
+	if slices.Contains(model.PointsSessionTypes, session.Type) {
+		ps, err := h.resultService.PointsSystemFor(ctx, match.LeagueID)
+		if err != nil {
+			slog.Error(op+": failed to get points system", "error", err, "league_id", match.LeagueID)
+			return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "포인트 시스템을 불러오는데 실패했습니다",
+			})
+		}
+		service.ApplySessionPoints(ps, session.Type, results)
+	} else {
+		for i := range results {
+			results[i].Points = 0
+			results[i].PointsManual = false
+		}
+	}
+
+	// Nothing is stored when the active penalties cannot be applied to the entered classification
+	if err := h.resultService.CheckPenalties(ctx, match, session, results); err != nil {
+		if errors.Is(err, service.ErrPenaltyRequiresTime) {
+			return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "missing_times",
+				Message: "시간 페널티가 적용된 경기는 완주자 전원의 기록 시간이 필요합니다",
+			})
+		}
+		slog.Error(op+": failed to check penalties", "error", err, "session_id", session.ID)
+		return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "페널티 적용에 실패했습니다",
+		})
+	}
+
+	if err := h.sessionRepo.UpsertResults(ctx, session.ID, results); err != nil {
+		slog.Error(op+": failed to upsert results", "error", err, "session_id", session.ID)
+		return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 결과 저장에 실패했습니다",
+		})
+	}
+
+	// Penalties are issued against every points-scoring classification
+	if slices.Contains(model.PenaltySessions, model.PenaltySession(session.Type)) {
+		if _, err := h.resultService.ReclassifyMatch(ctx, match); err != nil {
+			if errors.Is(err, service.ErrPenaltyRequiresTime) {
+				return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+					Error:   "missing_times",
+					Message: "시간 페널티가 적용된 경기는 완주자 전원의 기록 시간이 필요합니다",
+				})
+			}
+			slog.Error(op+": failed to apply penalties", "error", err, "match_id", match.ID)
+			return false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "페널티 적용에 실패했습니다",
+			})
+		}
+	}
+
+	return completeResults(c, h.lifecycle, match, []*model.MatchSession{session}, completeMatch, op)
+}
+
+// checkResultsCompletable makes sure entering results may complete the given sessions, and the match when
+// completeMatch is set, under the status state machine. A cancelled or postponed weekend keeps its status.
+// It returns false along with the response already written when the request must stop.
+func checkResultsCompletable(c echo.Context, match *model.Match, sessions []*model.MatchSession, completeMatch bool) (bool, error) {
+	if completeMatch && !service.CanTransitionMatchStatus(match.Status, model.MatchStatusCompleted) {
+		return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_transition",
+			Message: fmt.Sprintf("%s 상태의 경기에는 결과를 입력할 수 없습니다", match.Status),
+		})
+	}
+	for _, session := range sessions {
+		if !service.CanTransitionMatchStatus(session.Status, model.MatchStatusCompleted) {
+			return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_transition",
+				Message: fmt.Sprintf("%s 상태의 세션에는 결과를 입력할 수 없습니다", session.Status),
+			})
+		}
+	}
+	return true, nil
+}
+
+// completeResults completes the sessions whose results were entered, and the match when completeMatch is set.
+// It returns false along with the response already written when the request must stop.
+func completeResults(c echo.Context, lifecycle *service.MatchLifecycleService, match *model.Match, sessions []*model.MatchSession, completeMatch bool, op string) (bool, error) {
+	ctx := c.Request().Context()
+
+	for _, session := range sessions {
+		err := lifecycle.SetSessionStatus(ctx, session, model.MatchStatusCompleted, model.MatchStatusReasonResults, contextUserID(c))
+		if errors.Is(err, service.ErrInvalidStatusTransition) {
+			return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_transition",
+				Message: fmt.Sprintf("%s 상태의 세션에는 결과를 입력할 수 없습니다", session.Status),
+			})
+		}
+		if err != nil {
+			slog.Error(op+": failed to update session status", "error", err, "session_id", session.ID)
+		}
+	}
+
+	if completeMatch {
+		err := lifecycle.SetMatchStatus(ctx, match, model.MatchStatusCompleted, model.MatchStatusReasonResults, contextUserID(c))
+		if errors.Is(err, service.ErrInvalidStatusTransition) {
+			return false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_transition",
+				Message: fmt.Sprintf("%s 상태의 경기에는 결과를 입력할 수 없습니다", match.Status),
+			})
+		}
+		if err != nil {
+			slog.Error(op+": failed to update match status", "error", err, "match_id", match.ID)
+		}
+	}
+
+	return true, nil
+}
+
+// getSession loads the session named by the :id path parameter.
+// It returns false along with the response already written when the request must stop.
+func (h *SessionHandler) getSession(c echo.Context, op string) (*model.MatchSession, bool, error) {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 세션 ID입니다",
+		})
+	}
+
+	session, err := h.sessionRepo.GetByID(c.Request().Context(), sessionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, false, c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "세션을 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get session", "error", err, "session_id", sessionID)
+		return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return session, true, nil
+}
+
+func (h *SessionHandler) respondResults(c echo.Context, session *model.MatchSession, op string) error {
+	results, err := h.sessionRepo.ListResults(c.Request().Context(), session.ID)
+	if err != nil {
+		slog.Error(op+": failed to list results", "error", err, "session_id", session.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 결과를 불러오는데 실패했습니다",
+		})
+	}
+
+	if results == nil {
+		results = []*model.SessionResult{}
+	}
+
+	return c.JSON(http.StatusOK, model.SessionResultsResponse{
+		Session: session,
+		Results: results,
+		Total:   len(results),
+	})
+}
+
+func validateSession(session *model.MatchSession) error {
+	if !slices.Contains(sessionStatuses, session.Status) {
+		return errors.New("올바르지 않은 세션 상태입니다")
+	}
+	if session.ReverseGridSize != nil {
+		if session.Type != model.SessionTypeReverseGridRace {
+			return errors.New("리버스 그리드 인원은 리버스 그리드 레이스에만 설정할 수 있습니다")
+		}
+		if *session.ReverseGridSize < 1 {
+			return errors.New("리버스 그리드 인원은 1 이상이어야 합니다")
+		}
+	}
+	if session.DurationMinutes != nil && *session.DurationMinutes < 1 {
+		return errors.New("세션 시간은 1분 이상이어야 합니다")
+	}
+	return nil
+}
+
+func validateSessionResultsRequest(req *model.BulkUpdateSessionResultsRequest) error {
+	positions := make(map[int]bool, len(req.Results))
+	participants := make(map[uuid.UUID]bool, len(req.Results))
+	for _, r := range req.Results {
+		if r.ParticipantID == uuid.Nil {
+			return errors.New("참가자를 선택해주세요")
+		}
+		if participants[r.ParticipantID] {
+			return errors.New("같은 참가자가 중복으로 입력되었습니다")
+		}
+		participants[r.ParticipantID] = true
+
+		if r.Position == nil {
+			continue
+		}
+		if *r.Position < 1 {
+			return errors.New("순위는 1 이상이어야 합니다")
+		}
+		if positions[*r.Position] {
+			return errors.New("순위가 중복되었습니다")
+		}
+		positions[*r.Position] = true
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type StandingsRulesHandler struct {
+	rulesRepo        *repository.StandingsRulesRepository
+	leagueRepo       *repository.LeagueRepository
+	standingsService *service.StandingsService
+}
+
+func NewStandingsRulesHandler(rulesRepo *repository.StandingsRulesRepository, leagueRepo *repository.LeagueRepository, standingsService *service.StandingsService) *StandingsRulesHandler {
+	return &StandingsRulesHandler{
+		rulesRepo:        rulesRepo,
+		leagueRepo:       leagueRepo,
+		standingsService: standingsService,
+	}
+}
+
+// Get handles GET /api/v1/leagues/:id/standings-rules
+func (h *StandingsRulesHandler) Get(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.leagueRepo.GetByID(ctx, leagueID); err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("StandingsRules.Get: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	rules, err := h.standingsService.RulesFor(ctx, leagueID)
+	if err != nil {
+		slog.Error("StandingsRules.Get: failed to get standings rules", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "순위 규칙을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, rules)
+}
+
+// Update handles PUT /api/v1/admin/leagues/:id/standings-rules
+func (h *StandingsRulesHandler) Update(c echo.Context) error {
+	leagueIDStr := c.Param("id")
+	leagueID, err := uuid.Parse(leagueIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 리그 ID입니다",
+		})
+	}
+
+	var req model.UpdateStandingsRulesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if req.CountedRounds != nil && *req.CountedRounds < 1 {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "반영 라운드 수는 1 이상이어야 합니다",
+		})
+	}
+	if req.DropWorstRounds < 0 {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "제외 라운드 수는 0 이상이어야 합니다",
+		})
+	}
+	if req.AppealWindowHours != nil && *req.AppealWindowHours < 0 {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "이의 제기 기간은 0시간 이상이어야 합니다",
+		})
+	}
+	if req.ReservePointsMode == "" {
+		req.ReservePointsMode = model.ReservePointsCount
+	}
+	switch req.ReservePointsMode {
+	case model.ReservePointsCount, model.ReservePointsExclude:
+		req.ReserveRoundCap = nil
+	case model.ReservePointsCapped:
+		if req.ReserveRoundCap == nil || *req.ReserveRoundCap < 0 {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "validation_error",
+				Message: "리저브 포인트 반영 라운드 수는 0 이상이어야 합니다",
+			})
+		}
+	default:
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "잘못된 리저브 포인트 반영 방식입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.leagueRepo.GetByID(ctx, leagueID); err != nil {
+		if errors.Is(err, repository.ErrLeagueNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "리그를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("StandingsRules.Update: failed to get league", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "리그 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	rules := standingsRulesFromRequest(leagueID, &req)
+	if err := h.rulesRepo.Upsert(ctx, rules); err != nil {
+		slog.Error("StandingsRules.Update: failed to upsert standings rules", "error", err, "league_id", leagueID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "순위 규칙 저장에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, rules)
+}
+
+// standingsRulesFromRequest builds the rules to store from a request, filling in the defaults of omitted fields
+func standingsRulesFromRequest(leagueID uuid.UUID, req *model.UpdateStandingsRulesRequest) *model.StandingsRules {
+	rules := &model.StandingsRules{
+		LeagueID:          leagueID,
+		CountedRounds:     req.CountedRounds,
+		DropWorstRounds:   req.DropWorstRounds,
+		ApplyToTeams:      true,
+		AppealWindowHours: service.DefaultAppealWindowHours,
+		ReservePointsMode: req.ReservePointsMode,
+		ReserveRoundCap:   req.ReserveRoundCap,
+	}
+	if req.ApplyToTeams != nil {
+		rules.ApplyToTeams = *req.ApplyToTeams
+	}
+	if req.AppealWindowHours != nil {
+		rules.AppealWindowHours = *req.AppealWindowHours
+	}
+	return rules
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestStandingsRulesFromRequest(t *testing.T) {
+	tests := []struct {
+		body string
+		want bool
+	}{
+		{`{"drop_worst_rounds": 2}`, true},
+		{`{"drop_worst_rounds": 2, "apply_to_teams": false}`, false},
+		{`{"apply_to_teams": true}`, true},
+	}
+
+	for _, tt := range tests {
+		var req model.UpdateStandingsRulesRequest
+		if err := json.Unmarshal([]byte(tt.body), &req); err != nil {
+			t.Fatal(err)
+		}
+		if got := standingsRulesFromRequest(uuid.New(), &req).ApplyToTeams; got != tt.want {
+			t.Errorf("%s: ApplyToTeams = %v, want %v", tt.body, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type SubstitutionHandler struct {
+	substitutionRepo *repository.SubstitutionRepository
+	matchRepo        *repository.MatchRepository
+	participantRepo  *repository.ParticipantRepository
+}
+
+func NewSubstitutionHandler(substitutionRepo *repository.SubstitutionRepository, matchRepo *repository.MatchRepository, participantRepo *repository.ParticipantRepository) *SubstitutionHandler {
+	return &SubstitutionHandler{
+		substitutionRepo: substitutionRepo,
+		matchRepo:        matchRepo,
+		participantRepo:  participantRepo,
+	}
+}
+
+// List handles GET /api/v1/matches/:id/substitutions
+func (h *SubstitutionHandler) List(c echo.Context) error {
+	matchIDStr := c.Param("id")
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, err := h.matchRepo.GetByID(ctx, matchID); err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Substitution.List: failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	subs, err := h.substitutionRepo.ListByMatch(ctx, matchID)
+	if err != nil {
+		slog.Error("Substitution.List: failed to list substitutions", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "대체 출전 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	if subs == nil {
+		subs = []*model.Substitution{}
+	}
+
+	return c.JSON(http.StatusOK, model.ListSubstitutionsResponse{
+		Substitutions: subs,
+		Total:         len(subs),
+	})
+}
+
+// Create handles POST /api/v1/admin/matches/:id/substitutions
+func (h *SubstitutionHandler) Create(c echo.Context) error {
+	matchIDStr := c.Param("id")
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "로그인이 필요합니다",
+		})
+	}
+
+	var req model.CreateSubstitutionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	if req.ReserveParticipantID == uuid.Nil || req.ReplacedParticipantID == uuid.Nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "리저브 선수와 대체될 선수를 선택해주세요",
+		})
+	}
+	if req.ReserveParticipantID == req.ReplacedParticipantID {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "같은 선수로 대체할 수 없습니다",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	match, err := h.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Substitution.Create: failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	reserve, ok, err := h.leagueDriver(c, match.LeagueID, req.ReserveParticipantID, model.RoleReserve, "리저브 선수만 대체 출전할 수 있습니다")
+	if !ok {
+		return err
+	}
+	replaced, ok, err := h.leagueDriver(c, match.LeagueID, req.ReplacedParticipantID, model.RolePlayer, "대체될 선수는 리그의 정규 선수여야 합니다")
+	if !ok {
+		return err
+	}
+
+	teamName := ""
+	if req.TeamName != nil {
+		teamName = strings.TrimSpace(*req.TeamName)
+	} else if replaced.TeamName != nil {
+		teamName = *replaced.TeamName
+	}
+	if teamName == "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "대체 출전할 팀을 지정해주세요",
+		})
+	}
+
+	sub := &model.Substitution{
+		MatchID:               matchID,
+		ReserveParticipantID:  reserve.ID,
+		ReplacedParticipantID: replaced.ID,
+		TeamName:              teamName,
+		CreatedBy:             &userID,
+	}
+
+	if err := h.substitutionRepo.Create(ctx, sub); err != nil {
+		if errors.Is(err, repository.ErrReserveAlreadyUsed) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "already_substituting",
+				Message: "이 경기에 이미 대체 출전하는 리저브 선수입니다",
+			})
+		}
+		if errors.Is(err, repository.ErrDriverAlreadyReplaced) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "already_replaced",
+				Message: "이 경기에서 이미 대체된 선수입니다",
+			})
+		}
+		slog.Error("Substitution.Create: failed to create substitution", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "대체 출전 등록에 실패했습니다",
+		})
+	}
+
+	created, err := h.substitutionRepo.GetByID(ctx, sub.ID)
+	if err != nil {
+		slog.Error("Substitution.Create: failed to reload substitution", "error", err, "substitution_id", sub.ID)
+		return c.JSON(http.StatusCreated, sub)
+	}
+
+	return c.JSON(http.StatusCreated, created)
+}
+
+// Delete handles DELETE /api/v1/admin/substitutions/:id
+func (h *SubstitutionHandler) Delete(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 대체 출전 ID입니다",
+		})
+	}
+
+	if err := h.substitutionRepo.Delete(c.Request().Context(), id); err != nil {
+		if errors.Is(err, repository.ErrSubstitutionNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "대체 출전 기록을 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Substitution.Delete: failed to delete substitution", "error", err, "substitution_id", id)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "대체 출전 삭제에 실패했습니다",
+		})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// leagueDriver loads an approved participant of the league holding the given role.
+// It writes the error response itself and reports false when the participant cannot be used.
+func (h *SubstitutionHandler) leagueDriver(c echo.Context, leagueID, participantID uuid.UUID, role model.ParticipantRole, roleMessage string) (*model.LeagueParticipant, bool, error) {
+	p, err := h.participantRepo.GetByID(c.Request().Context(), participantID)
+	if err != nil {
+		if errors.Is(err, repository.ErrParticipantNotFound) {
+			return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_request",
+				Message: "참가자를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Substitution.Create: failed to get participant", "error", err, "participant_id", participantID)
+		return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "참가자 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	if p.LeagueID != leagueID || p.Status != model.ParticipantStatusApproved {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "리그에 승인된 참가자가 아닙니다",
+		})
+	}
+	if !slices.Contains(p.Roles, string(role)) {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: roleMessage,
+		})
+	}
+
+	return p, true, nil
+}
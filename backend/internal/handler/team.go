@@ -2,11 +2,13 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 
 	"github.com/f1-rivals-cup/backend/internal/model"
 	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
@@ -102,7 +104,7 @@ func (h *TeamHandler) Create(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	// Check if league exists
-	_, err = h.leagueRepo.GetByID(ctx, leagueID)
+	league, err := h.leagueRepo.GetByID(ctx, leagueID)
 	if err != nil {
 		if errors.Is(err, repository.ErrLeagueNotFound) {
 			return c.JSON(http.StatusNotFound, model.ErrorResponse{
@@ -117,6 +119,24 @@ func (h *TeamHandler) Create(c echo.Context) error {
 		})
 	}
 
+	settings := service.LeagueSettingsFor(league)
+	if settings.MaxTeams > 0 {
+		teams, err := h.teamRepo.ListByLeague(ctx, leagueID)
+		if err != nil {
+			slog.Error("Team.Create: failed to list teams", "error", err, "league_id", leagueID)
+			return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "팀 목록을 불러오는데 실패했습니다",
+			})
+		}
+		if len(teams) >= settings.MaxTeams {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "teams_full",
+				Message: fmt.Sprintf("리그의 최대 팀 수(%d팀)에 도달했습니다", settings.MaxTeams),
+			})
+		}
+	}
+
 	team := &model.Team{
 		LeagueID:   leagueID,
 		Name:       req.Name,
@@ -144,7 +164,7 @@ func (h *TeamHandler) Create(c echo.Context) error {
 			LeagueID:  leagueID,
 			OwnerID:   team.ID,
 			OwnerType: model.OwnerTypeTeam,
-			Balance:   0,
+			Balance:   settings.StartingBudget,
 		}
 		if err := h.accountRepo.Create(ctx, account); err != nil {
 			slog.Error("Team.Create: failed to create team account", "error", err, "team_id", team.ID)
@@ -2,12 +2,16 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/f1-rivals-cup/backend/internal/model"
 	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
@@ -73,7 +77,7 @@ func (h *TeamChangeHandler) CreateRequest(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	// Check if league exists
-	_, err = h.leagueRepo.GetByID(ctx, leagueID)
+	league, err := h.leagueRepo.GetByID(ctx, leagueID)
 	if err != nil {
 		if errors.Is(err, repository.ErrLeagueNotFound) {
 			return c.JSON(http.StatusNotFound, model.ErrorResponse{
@@ -88,6 +92,19 @@ func (h *TeamChangeHandler) CreateRequest(c echo.Context) error {
 		})
 	}
 
+	// Check transfer window in the league's timezone
+	settings := service.LeagueSettingsFor(league)
+	loc, err := time.LoadLocation(league.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	if !service.TransferWindowOpen(settings, time.Now().In(loc).Format("2006-01-02")) {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "transfer_window_closed",
+			Message: "현재 이적 시장 기간이 아닙니다",
+		})
+	}
+
 	// Get participant
 	participant, err := h.participantRepo.GetByLeagueAndUser(ctx, leagueID, userID)
 	if err != nil {
@@ -159,6 +176,12 @@ func (h *TeamChangeHandler) CreateRequest(c echo.Context) error {
 				Message: "유효하지 않은 역할입니다: " + role,
 			})
 		}
+		if !service.RoleAllowed(settings, role) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "role_not_allowed",
+				Message: "이 리그에서 신청할 수 없는 역할입니다: " + role,
+			})
+		}
 	}
 
 	// Determine effective roles (use requested_roles if provided, otherwise keep current)
@@ -167,15 +190,27 @@ func (h *TeamChangeHandler) CreateRequest(c echo.Context) error {
 		effectiveRoles = []string(participant.Roles)
 	}
 
-	// Check player count in target team (max 2) if becoming/remaining a player
-	willBePlayer := false
-	for _, role := range effectiveRoles {
-		if role == string(model.RolePlayer) {
-			willBePlayer = true
-			break
+	// Check reserve limit if becoming a reserve
+	if settings.MaxReserves > 0 && slices.Contains(effectiveRoles, string(model.RoleReserve)) && !slices.Contains([]string(participant.Roles), string(model.RoleReserve)) {
+		reserveCount, err := h.participantRepo.CountByRole(ctx, leagueID, model.RoleReserve)
+		if err != nil {
+			slog.Error("TeamChange.CreateRequest: failed to count reserves", "error", err)
+			return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "리저브 정보를 확인하는데 실패했습니다",
+			})
+		}
+		if reserveCount >= settings.MaxReserves {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "reserves_full",
+				Message: fmt.Sprintf("리저브 선수 정원(%d명)이 이미 찼습니다", settings.MaxReserves),
+			})
 		}
 	}
 
+	// Check player count in target team if becoming/remaining a player
+	willBePlayer := slices.Contains(effectiveRoles, string(model.RolePlayer))
+
 	if willBePlayer {
 		playerCount, err := h.participantRepo.CountPlayersByTeam(ctx, leagueID, req.RequestedTeamName)
 		if err != nil {
@@ -185,10 +220,10 @@ func (h *TeamChangeHandler) CreateRequest(c echo.Context) error {
 				Message: "팀 정보를 확인하는데 실패했습니다",
 			})
 		}
-		if playerCount >= 2 {
+		if playerCount >= settings.SeatsPerTeam {
 			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
 				Error:   "team_full",
-				Message: "해당 팀의 선수 정원(2명)이 이미 찼습니다",
+				Message: fmt.Sprintf("해당 팀의 선수 정원(%d명)이 이미 찼습니다", settings.SeatsPerTeam),
 			})
 		}
 	}
@@ -448,16 +483,36 @@ func (h *TeamChangeHandler) ReviewRequest(c echo.Context) error {
 			effectiveRoles = changeRequest.CurrentRoles
 		}
 
-		// Re-check player count before approving (in case it changed)
-		willBePlayer := false
-		for _, role := range effectiveRoles {
-			if role == string(model.RolePlayer) {
-				willBePlayer = true
-				break
+		league, err := h.leagueRepo.GetByID(ctx, leagueID)
+		if err != nil {
+			slog.Error("TeamChange.ReviewRequest: failed to get league", "error", err)
+			return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+				Error:   "server_error",
+				Message: "리그 정보를 불러오는데 실패했습니다",
+			})
+		}
+		settings := service.LeagueSettingsFor(league)
+
+		// Re-check reserve limit before approving (in case it changed)
+		if settings.MaxReserves > 0 && slices.Contains(effectiveRoles, string(model.RoleReserve)) && !slices.Contains(changeRequest.CurrentRoles, string(model.RoleReserve)) {
+			reserveCount, err := h.participantRepo.CountByRole(ctx, leagueID, model.RoleReserve)
+			if err != nil {
+				slog.Error("TeamChange.ReviewRequest: failed to count reserves", "error", err)
+				return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+					Error:   "server_error",
+					Message: "리저브 정보를 확인하는데 실패했습니다",
+				})
+			}
+			if reserveCount >= settings.MaxReserves {
+				return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+					Error:   "reserves_full",
+					Message: fmt.Sprintf("리저브 선수 정원(%d명)이 이미 찼습니다", settings.MaxReserves),
+				})
 			}
 		}
 
-		if willBePlayer {
+		// Re-check player count before approving (in case it changed)
+		if slices.Contains(effectiveRoles, string(model.RolePlayer)) {
 			playerCount, err := h.participantRepo.CountPlayersByTeam(ctx, leagueID, changeRequest.RequestedTeamName)
 			if err != nil {
 				slog.Error("TeamChange.ReviewRequest: failed to count players", "error", err)
@@ -466,10 +521,10 @@ func (h *TeamChangeHandler) ReviewRequest(c echo.Context) error {
 					Message: "팀 정보를 확인하는데 실패했습니다",
 				})
 			}
-			if playerCount >= 2 {
+			if playerCount >= settings.SeatsPerTeam {
 				return c.JSON(http.StatusBadRequest, model.ErrorResponse{
 					Error:   "team_full",
-					Message: "해당 팀의 선수 정원(2명)이 이미 찼습니다",
+					Message: fmt.Sprintf("해당 팀의 선수 정원(%d명)이 이미 찼습니다", settings.SeatsPerTeam),
 				})
 			}
 		}
@@ -0,0 +1,371 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// liveTimingKeepAlive keeps idle live timing streams open through proxies
+const liveTimingKeepAlive = 15 * time.Second
+
+type TelemetryHandler struct {
+	telemetryService *service.TelemetryService
+	telemetryRepo    *repository.TelemetryRepository
+	matchRepo        *repository.MatchRepository
+	sessionRepo      *repository.SessionRepository
+	participantRepo  *repository.ParticipantRepository
+	sessionHandler   *SessionHandler
+}
+
+func NewTelemetryHandler(telemetryService *service.TelemetryService, telemetryRepo *repository.TelemetryRepository, matchRepo *repository.MatchRepository, sessionRepo *repository.SessionRepository, participantRepo *repository.ParticipantRepository, sessionHandler *SessionHandler) *TelemetryHandler {
+	return &TelemetryHandler{
+		telemetryService: telemetryService,
+		telemetryRepo:    telemetryRepo,
+		matchRepo:        matchRepo,
+		sessionRepo:      sessionRepo,
+		participantRepo:  participantRepo,
+		sessionHandler:   sessionHandler,
+	}
+}
+
+// Status handles GET /api/v1/admin/telemetry/status
+func (h *TelemetryHandler) Status(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.telemetryService.Status())
+}
+
+// Attach handles POST /api/v1/admin/matches/:id/telemetry/attach
+func (h *TelemetryHandler) Attach(c echo.Context) error {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	if _, err := h.telemetryService.Attach(c.Request().Context(), matchID); err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		if errors.Is(err, service.ErrTelemetryMatchNotLive) {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "match_not_live",
+				Message: "진행 중인 경기에만 텔레메트리를 연결할 수 있습니다",
+			})
+		}
+		slog.Error("Telemetry.Attach: failed to attach match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "텔레메트리 연결에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.telemetryService.Status())
+}
+
+// Detach handles DELETE /api/v1/admin/telemetry/attach
+func (h *TelemetryHandler) Detach(c echo.Context) error {
+	h.telemetryService.Detach()
+	return c.JSON(http.StatusOK, h.telemetryService.Status())
+}
+
+// ListClassification handles GET /api/v1/admin/matches/:id/telemetry/classification
+func (h *TelemetryHandler) ListClassification(c echo.Context) error {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	results, err := h.telemetryRepo.ListByMatch(c.Request().Context(), matchID)
+	if err != nil {
+		slog.Error("Telemetry.ListClassification: failed to list classification", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "임시 결과를 불러오는데 실패했습니다",
+		})
+	}
+
+	if results == nil {
+		results = []*model.TelemetryClassification{}
+	}
+
+	return c.JSON(http.StatusOK, model.ListTelemetryClassificationResponse{
+		MatchID: matchID,
+		Results: results,
+		Total:   len(results),
+	})
+}
+
+// AssignDriver handles PUT /api/v1/admin/telemetry-classifications/:id
+func (h *TelemetryHandler) AssignDriver(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 결과 ID입니다",
+		})
+	}
+
+	var req model.AssignTelemetryDriverRequest
+	if err := c.Bind(&req); err != nil || req.ParticipantID == uuid.Nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "참가자를 선택해주세요",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	row, err := h.telemetryRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrTelemetryClassificationNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "임시 결과를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Telemetry.AssignDriver: failed to get classification", "error", err, "id", id)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "임시 결과를 불러오는데 실패했습니다",
+		})
+	}
+
+	match, err := h.matchRepo.GetByID(ctx, row.MatchID)
+	if err != nil {
+		slog.Error("Telemetry.AssignDriver: failed to get match", "error", err, "match_id", row.MatchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	participant, err := h.participantRepo.GetByID(ctx, req.ParticipantID)
+	if err != nil || participant.LeagueID != match.LeagueID || participant.Status != model.ParticipantStatusApproved {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_participant",
+			Message: "리그에 승인된 참가자가 아닙니다",
+		})
+	}
+
+	if err := h.telemetryRepo.AssignParticipant(ctx, id, participant.ID); err != nil {
+		slog.Error("Telemetry.AssignDriver: failed to assign participant", "error", err, "id", id)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "드라이버 지정에 실패했습니다",
+		})
+	}
+
+	row, err = h.telemetryRepo.GetByID(ctx, id)
+	if err != nil {
+		slog.Error("Telemetry.AssignDriver: failed to get classification", "error", err, "id", id)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "임시 결과를 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, row)
+}
+
+// ConfirmClassification handles POST /api/v1/admin/matches/:id/telemetry/classification/confirm
+func (h *TelemetryHandler) ConfirmClassification(c echo.Context) error {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	var req model.ConfirmTelemetryRequest
+	if err := c.Bind(&req); err != nil || req.SessionType == "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "세션 종류를 선택해주세요",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	match, err := h.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMatchNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "경기를 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Telemetry.ConfirmClassification: failed to get match", "error", err, "match_id", matchID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "경기 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	var session *model.MatchSession
+	if req.SessionID != nil {
+		session, err = h.sessionRepo.GetByID(ctx, *req.SessionID)
+		if err == nil && session.MatchID != match.ID {
+			err = repository.ErrSessionNotFound
+		}
+	} else {
+		session, err = h.sessionRepo.GetByMatchAndType(ctx, match.ID, req.SessionType)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "결과를 저장할 세션을 찾을 수 없습니다",
+			})
+		}
+		slog.Error("Telemetry.ConfirmClassification: failed to get session", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "세션 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	rows, err := h.telemetryRepo.ListByMatch(ctx, match.ID)
+	if err != nil {
+		slog.Error("Telemetry.ConfirmClassification: failed to list classification", "error", err, "match_id", match.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "임시 결과를 불러오는데 실패했습니다",
+		})
+	}
+
+	var sessionRows []*model.TelemetryClassification
+	unmatched := 0
+	for _, row := range rows {
+		if row.SessionType != req.SessionType {
+			continue
+		}
+		if row.ParticipantID == nil {
+			unmatched++
+		}
+		sessionRows = append(sessionRows, row)
+	}
+	if len(sessionRows) == 0 {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			Error:   "not_found",
+			Message: "확정할 임시 결과가 없습니다",
+		})
+	}
+	if unmatched > 0 && !req.SkipUnmatched {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "unmatched_drivers",
+			Message: fmt.Sprintf("참가자와 연결되지 않은 드라이버가 %d명 있습니다", unmatched),
+		})
+	}
+
+	results := service.TelemetryResultRequests(sessionRows)
+	if ok, err := h.sessionHandler.saveResults(c, match, session, results, false, "Telemetry.ConfirmClassification"); !ok {
+		return err
+	}
+
+	if err := h.telemetryRepo.DeleteSession(ctx, match.ID, req.SessionType); err != nil {
+		slog.Error("Telemetry.ConfirmClassification: failed to clear classification", "error", err, "match_id", match.ID)
+	}
+
+	return h.sessionHandler.respondResults(c, session, "Telemetry.ConfirmClassification")
+}
+
+// LiveTiming handles GET /api/v1/matches/:id/live-timing
+func (h *TelemetryHandler) LiveTiming(c echo.Context) error {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	live := h.telemetryService.Snapshot(matchID)
+	if live == nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			Error:   "not_live",
+			Message: "실시간 타이밍이 제공되지 않는 경기입니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, live)
+}
+
+// LiveTimingStream handles GET /api/v1/matches/:id/live-timing/stream as server-sent events
+func (h *TelemetryHandler) LiveTimingStream(c echo.Context) error {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 경기 ID입니다",
+		})
+	}
+
+	updates, unsubscribe := h.telemetryService.Subscribe(matchID)
+	defer unsubscribe()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+
+	if live := h.telemetryService.Snapshot(matchID); live != nil {
+		if err := writeLiveTimingEvent(res, live); err != nil {
+			return nil
+		}
+	}
+	res.Flush()
+
+	keepAlive := time.NewTicker(liveTimingKeepAlive)
+	defer keepAlive.Stop()
+
+	ctx := c.Request().Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case live, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			if err := writeLiveTimingEvent(res, live); err != nil {
+				return nil
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
+
+func writeLiveTimingEvent(res *echo.Response, live *model.LiveTiming) error {
+	data, err := json.Marshal(live)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(res, "event: timing\ndata: %s\n\n", data); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
@@ -0,0 +1,321 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+type TrackHandler struct {
+	trackRepo *repository.TrackRepository
+}
+
+func NewTrackHandler(trackRepo *repository.TrackRepository) *TrackHandler {
+	return &TrackHandler{trackRepo: trackRepo}
+}
+
+// List handles GET /api/v1/tracks?q=
+func (h *TrackHandler) List(c echo.Context) error {
+	tracks, err := h.trackRepo.List(c.Request().Context(), c.QueryParam("q"))
+	if err != nil {
+		slog.Error("Track.List: failed to list tracks", "error", err)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "트랙 목록을 불러오는데 실패했습니다",
+		})
+	}
+
+	if tracks == nil {
+		tracks = []*model.Track{}
+	}
+
+	return c.JSON(http.StatusOK, model.ListTracksResponse{
+		Tracks: tracks,
+		Total:  len(tracks),
+	})
+}
+
+// Get handles GET /api/v1/tracks/:id
+func (h *TrackHandler) Get(c echo.Context) error {
+	track, ok, err := h.loadTrack(c, "Track.Get")
+	if !ok {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, track)
+}
+
+// Stats handles GET /api/v1/tracks/:id/stats?league_id=
+func (h *TrackHandler) Stats(c echo.Context) error {
+	var leagueID *uuid.UUID
+	if v := c.QueryParam("league_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Error:   "invalid_request",
+				Message: "잘못된 리그 ID입니다",
+			})
+		}
+		leagueID = &id
+	}
+
+	track, ok, err := h.loadTrack(c, "Track.Stats")
+	if !ok {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	racesHeld, err := h.trackRepo.CountRaces(ctx, track.ID, leagueID)
+	if err != nil {
+		slog.Error("Track.Stats: failed to count races", "error", err, "track_id", track.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "트랙 기록을 불러오는데 실패했습니다",
+		})
+	}
+
+	winners, err := h.trackRepo.ListWinners(ctx, track.ID, leagueID)
+	if err != nil {
+		slog.Error("Track.Stats: failed to list winners", "error", err, "track_id", track.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "트랙 기록을 불러오는데 실패했습니다",
+		})
+	}
+
+	records, err := h.trackRepo.ListLapRecords(ctx, track.ID, leagueID)
+	if err != nil {
+		slog.Error("Track.Stats: failed to list lap records", "error", err, "track_id", track.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "트랙 기록을 불러오는데 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.TrackStatsResponse{
+		Track:      track,
+		RacesHeld:  racesHeld,
+		Winners:    winners,
+		LapRecords: records,
+	})
+}
+
+// Create handles POST /api/v1/admin/tracks
+func (h *TrackHandler) Create(c echo.Context) error {
+	var req model.CreateTrackRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	layout := strings.TrimSpace(req.Layout)
+	if layout == "" {
+		layout = "Grand Prix"
+	}
+
+	track := &model.Track{
+		Slug:            strings.ToLower(strings.TrimSpace(req.Slug)),
+		Name:            strings.TrimSpace(req.Name),
+		Country:         strings.TrimSpace(req.Country),
+		CountryCode:     strings.ToUpper(strings.TrimSpace(req.CountryCode)),
+		Layout:          layout,
+		FullRaceLaps:    req.FullRaceLaps,
+		LengthKm:        req.LengthKm,
+		DefaultRaceLaps: req.DefaultRaceLaps,
+		Aliases:         normalizeTrackAliases(req.Aliases),
+	}
+
+	if track.Slug == "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: "트랙 식별자는 필수입니다",
+		})
+	}
+	if msg := validateTrack(track); msg != "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: msg,
+		})
+	}
+
+	if err := h.trackRepo.Create(c.Request().Context(), track); err != nil {
+		if errors.Is(err, repository.ErrTrackSlugExists) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "duplicate_slug",
+				Message: "이미 사용 중인 트랙 식별자입니다",
+			})
+		}
+		slog.Error("Track.Create: failed to create track", "error", err, "slug", track.Slug)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "트랙 생성에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, track)
+}
+
+// Update handles PUT /api/v1/admin/tracks/:id
+func (h *TrackHandler) Update(c echo.Context) error {
+	var req model.UpdateTrackRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 요청입니다",
+		})
+	}
+
+	track, ok, err := h.loadTrack(c, "Track.Update")
+	if !ok {
+		return err
+	}
+
+	if req.Name != nil {
+		track.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Country != nil {
+		track.Country = strings.TrimSpace(*req.Country)
+	}
+	if req.CountryCode != nil {
+		track.CountryCode = strings.ToUpper(strings.TrimSpace(*req.CountryCode))
+	}
+	if req.Layout != nil {
+		track.Layout = strings.TrimSpace(*req.Layout)
+	}
+	if req.FullRaceLaps != nil {
+		track.FullRaceLaps = *req.FullRaceLaps
+	}
+	if req.LengthKm != nil {
+		track.LengthKm = *req.LengthKm
+	}
+	if req.DefaultRaceLaps != nil {
+		track.DefaultRaceLaps = *req.DefaultRaceLaps
+	}
+	if req.Aliases != nil {
+		track.Aliases = normalizeTrackAliases(*req.Aliases)
+	}
+
+	if msg := validateTrack(track); msg != "" {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "validation_error",
+			Message: msg,
+		})
+	}
+
+	if err := h.trackRepo.Update(c.Request().Context(), track); err != nil {
+		slog.Error("Track.Update: failed to update track", "error", err, "track_id", track.ID)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "트랙 정보 수정에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, track)
+}
+
+// Delete handles DELETE /api/v1/admin/tracks/:id
+func (h *TrackHandler) Delete(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 트랙 ID입니다",
+		})
+	}
+
+	if err := h.trackRepo.Delete(c.Request().Context(), id); err != nil {
+		if errors.Is(err, repository.ErrTrackNotFound) {
+			return c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "트랙을 찾을 수 없습니다",
+			})
+		}
+		if errors.Is(err, repository.ErrTrackInUse) {
+			return c.JSON(http.StatusConflict, model.ErrorResponse{
+				Error:   "track_in_use",
+				Message: "경기에 사용된 트랙은 삭제할 수 없습니다",
+			})
+		}
+		slog.Error("Track.Delete: failed to delete track", "error", err, "track_id", id)
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "트랙 삭제에 실패했습니다",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "트랙이 삭제되었습니다",
+	})
+}
+
+// loadTrack parses the :id parameter and loads the track.
+// It writes the error response itself and reports false when the track cannot be loaded.
+func (h *TrackHandler) loadTrack(c echo.Context, op string) (*model.Track, bool, error) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, false, c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "잘못된 트랙 ID입니다",
+		})
+	}
+
+	track, err := h.trackRepo.GetByID(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrTrackNotFound) {
+			return nil, false, c.JSON(http.StatusNotFound, model.ErrorResponse{
+				Error:   "not_found",
+				Message: "트랙을 찾을 수 없습니다",
+			})
+		}
+		slog.Error(op+": failed to get track", "error", err, "track_id", id)
+		return nil, false, c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			Error:   "server_error",
+			Message: "트랙 정보를 불러오는데 실패했습니다",
+		})
+	}
+
+	return track, true, nil
+}
+
+// validateTrack returns a validation message for an invalid track, or an empty string
+func validateTrack(t *model.Track) string {
+	switch {
+	case t.Name == "" || t.Country == "":
+		return "트랙 이름과 국가는 필수입니다"
+	case len(t.CountryCode) != 2:
+		return "국가 코드는 2자리여야 합니다"
+	case t.Layout == "":
+		return "레이아웃은 비워둘 수 없습니다"
+	case t.FullRaceLaps < 1 || t.DefaultRaceLaps < 1:
+		return "랩 수는 1 이상이어야 합니다"
+	case t.LengthKm <= 0:
+		return "트랙 길이는 0보다 커야 합니다"
+	}
+	return ""
+}
+
+// normalizeTrackAliases lower-cases, trims and de-duplicates alias names
+func normalizeTrackAliases(aliases []string) pq.StringArray {
+	normalized := pq.StringArray{}
+	seen := make(map[string]bool)
+	for _, a := range aliases {
+		a = strings.ToLower(strings.TrimSpace(a))
+		if a == "" || seen[a] {
+			continue
+		}
+		seen[a] = true
+		normalized = append(normalized, a)
+	}
+	return normalized
+}
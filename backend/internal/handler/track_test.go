@@ -0,0 +1,15 @@
+package handler
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalizeTrackAliases(t *testing.T) {
+	got := normalizeTrackAliases([]string{" Monza ", "monza", "", "몬자", "Italian GP"})
+	want := []string{"monza", "몬자", "italian gp"}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("normalizeTrackAliases() = %v, want %v", got, want)
+	}
+}
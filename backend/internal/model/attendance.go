@@ -0,0 +1,138 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RSVPStatus is a driver's answer to whether they will race in a match
+type RSVPStatus string
+
+const (
+	RSVPAttending    RSVPStatus = "attending"
+	RSVPNotAttending RSVPStatus = "not_attending"
+	RSVPUnsure       RSVPStatus = "unsure"
+)
+
+// ReserveOfferStatus represents where a seat offer to a reserve driver stands
+type ReserveOfferStatus string
+
+const (
+	ReserveOfferPending   ReserveOfferStatus = "pending"
+	ReserveOfferAccepted  ReserveOfferStatus = "accepted"
+	ReserveOfferDeclined  ReserveOfferStatus = "declined"
+	ReserveOfferExpired   ReserveOfferStatus = "expired"
+	ReserveOfferWithdrawn ReserveOfferStatus = "withdrawn" // The driver confirmed after all
+)
+
+// AttendanceSettings represents league-level RSVP rules
+type AttendanceSettings struct {
+	ID                  uuid.UUID `json:"id"`
+	LeagueID            uuid.UUID `json:"league_id"`
+	RSVPDeadlineHours   int       `json:"rsvp_deadline_hours"`   // RSVPs close this many hours before the race
+	OfferTimeoutMinutes int       `json:"offer_timeout_minutes"` // How long a reserve has to answer a seat offer
+	MissedRSVPStrikes   int       `json:"missed_rsvp_strikes"`   // Strikes for not answering by the deadline, 0 = none
+	IsDefault           bool      `json:"is_default"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// UpdateAttendanceSettingsRequest represents a request to replace a league's RSVP rules
+type UpdateAttendanceSettingsRequest struct {
+	RSVPDeadlineHours   int `json:"rsvp_deadline_hours" validate:"min=0"`
+	OfferTimeoutMinutes int `json:"offer_timeout_minutes" validate:"required,min=1"`
+	MissedRSVPStrikes   int `json:"missed_rsvp_strikes" validate:"min=0"`
+}
+
+// MatchRSVP represents a driver's attendance answer for a match
+type MatchRSVP struct {
+	ID            uuid.UUID  `json:"id"`
+	MatchID       uuid.UUID  `json:"match_id"`
+	ParticipantID uuid.UUID  `json:"participant_id"`
+	Status        RSVPStatus `json:"status"`
+	Note          *string    `json:"note,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// UpdateRSVPRequest represents a driver's request to answer for a match
+type UpdateRSVPRequest struct {
+	Status RSVPStatus `json:"status" validate:"required"`
+	Note   *string    `json:"note,omitempty"`
+}
+
+// RSVPResponse represents a saved RSVP along with the seat offer it triggered, if any
+type RSVPResponse struct {
+	RSVP  *MatchRSVP    `json:"rsvp"`
+	Offer *ReserveOffer `json:"offer,omitempty"`
+}
+
+// DriverAvailability is one driver's attendance for a match
+type DriverAvailability struct {
+	ParticipantID uuid.UUID   `json:"participant_id"`
+	DriverName    string      `json:"driver_name"`
+	TeamName      *string     `json:"team_name,omitempty"`
+	Status        *RSVPStatus `json:"status,omitempty"` // nil when the driver has not answered
+	Note          *string     `json:"note,omitempty"`
+	RespondedAt   *time.Time  `json:"responded_at,omitempty"`
+	ReserveName   *string     `json:"reserve_name,omitempty"` // Reserve standing in for the driver
+}
+
+// MatchAvailabilityResponse represents the attendance of a match's drivers and the seat offers made
+type MatchAvailabilityResponse struct {
+	MatchID      uuid.UUID            `json:"match_id"`
+	RSVPDeadline *time.Time           `json:"rsvp_deadline,omitempty"`
+	Drivers      []DriverAvailability `json:"drivers"`
+	Offers       []*ReserveOffer      `json:"offers"`
+}
+
+// ReserveOffer represents an empty seat offered to a reserve driver
+type ReserveOffer struct {
+	ID                    uuid.UUID          `json:"id"`
+	MatchID               uuid.UUID          `json:"match_id"`
+	ReserveParticipantID  uuid.UUID          `json:"reserve_participant_id"`
+	ReplacedParticipantID uuid.UUID          `json:"replaced_participant_id"`
+	TeamName              string             `json:"team_name"`
+	Status                ReserveOfferStatus `json:"status"`
+	ExpiresAt             time.Time          `json:"expires_at"`
+	RespondedAt           *time.Time         `json:"responded_at,omitempty"`
+	CreatedAt             time.Time          `json:"created_at"`
+
+	// Joined fields
+	Round        int     `json:"round"`
+	Track        string  `json:"track"`
+	ReserveName  *string `json:"reserve_name,omitempty"`
+	ReplacedName *string `json:"replaced_name,omitempty"`
+}
+
+// ListReserveOffersResponse represents the response for listing seat offers
+type ListReserveOffersResponse struct {
+	Offers []*ReserveOffer `json:"offers"`
+	Total  int             `json:"total"`
+}
+
+// UpdateReservePriorityRequest sets the order reserves are offered empty seats in, first to last
+type UpdateReservePriorityRequest struct {
+	ParticipantIDs []uuid.UUID `json:"participant_ids" validate:"required"`
+}
+
+// AttendanceStrike records a driver missing the RSVP deadline of a match
+type AttendanceStrike struct {
+	ID            uuid.UUID `json:"id"`
+	ParticipantID uuid.UUID `json:"participant_id"`
+	MatchID       uuid.UUID `json:"match_id"`
+	Strikes       int       `json:"strikes"`
+	Reason        string    `json:"reason"`
+	CreatedAt     time.Time `json:"created_at"`
+
+	// Joined fields
+	Round      int    `json:"round"`
+	DriverName string `json:"driver_name"`
+}
+
+// ListAttendanceStrikesResponse represents the response for listing a league's attendance strikes
+type ListAttendanceStrikesResponse struct {
+	Strikes []*AttendanceStrike `json:"strikes"`
+	Total   int                 `json:"total"`
+}
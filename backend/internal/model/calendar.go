@@ -0,0 +1,53 @@
+package model
+
+import "github.com/google/uuid"
+
+type CalendarRecurrence string
+
+const (
+	CalendarRecurrenceWeekly   CalendarRecurrence = "weekly"
+	CalendarRecurrenceBiweekly CalendarRecurrence = "biweekly"
+)
+
+// CalendarTrack is one entry of a generated calendar's ordered track list
+type CalendarTrack struct {
+	Track   string     `json:"track"`              // Free-text name, resolved against the catalogue when TrackID is not set
+	TrackID *uuid.UUID `json:"track_id,omitempty"` // Catalogue track, takes precedence over Track
+}
+
+// GenerateCalendarRequest represents a request to lay out a season's rounds on a recurring weekday.
+// Rounds are numbered on from the league's last existing round, one per track in order.
+type GenerateCalendarRequest struct {
+	Recurrence    CalendarRecurrence `json:"recurrence"`           // weekly or biweekly
+	Weekday       *int               `json:"weekday,omitempty"`    // 0 = Sunday ... 6 = Saturday, defaults to the start date's weekday
+	StartDate     *string            `json:"start_date,omitempty"` // Defaults to the league start date
+	EndDate       *string            `json:"end_date,omitempty"`   // Defaults to the league end date
+	MatchTime     *string            `json:"match_time,omitempty"` // Defaults to the league match time
+	ExcludedDates []string           `json:"excluded_dates"`
+	Tracks        []CalendarTrack    `json:"tracks"`
+	SprintRounds  []int              `json:"sprint_rounds"`
+}
+
+// CalendarResponse represents the matches of a previewed or generated calendar
+type CalendarResponse struct {
+	Matches []*Match `json:"matches"`
+	Total   int      `json:"total"`
+}
+
+// InsertRoundRequest represents a request to insert a round mid-season, renumbering every later round
+type InsertRoundRequest struct {
+	Round       int        `json:"round"`
+	Track       string     `json:"track"`
+	TrackID     *uuid.UUID `json:"track_id,omitempty"`
+	MatchDate   *string    `json:"match_date,omitempty"` // Defaults to the date of the round being displaced
+	MatchTime   *string    `json:"match_time,omitempty"` // Defaults to the time of the round being displaced
+	HasSprint   bool       `json:"has_sprint"`
+	ShiftDays   int        `json:"shift_days"` // Days every later round is pushed back by, 0 keeps their dates
+	Description *string    `json:"description,omitempty"`
+}
+
+// CalendarFeedResponse represents a user's personal iCalendar feed
+type CalendarFeedResponse struct {
+	Token string `json:"token"`
+	Path  string `json:"path"` // Feed URL path to subscribe to
+}
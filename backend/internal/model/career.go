@@ -0,0 +1,47 @@
+package model
+
+import "github.com/google/uuid"
+
+// CareerStats represents a driver's aggregated race record
+type CareerStats struct {
+	Starts        int      `json:"starts"` // Classified entries in race sessions, sprints excluded
+	Wins          int      `json:"wins"`
+	Podiums       int      `json:"podiums"`
+	Poles         int      `json:"poles"`
+	FastestLaps   int      `json:"fastest_laps"`
+	DNFs          int      `json:"dnfs"`
+	DNFRate       float64  `json:"dnf_rate"`                 // DNFs per start, 0 to 1
+	AverageFinish *float64 `json:"average_finish,omitempty"` // Mean position over finished races
+	BestFinish    *int     `json:"best_finish,omitempty"`
+	SprintStarts  int      `json:"sprint_starts"`
+	SprintWins    int      `json:"sprint_wins"`
+	Points        float64  `json:"points"` // Race and sprint points combined
+	Finishes      int      `json:"finishes"`
+	FinishSum     int      `json:"-"` // Sum of finishing positions, used to average across leagues
+}
+
+// CareerLeague represents a driver's record in a single league
+type CareerLeague struct {
+	LeagueID      uuid.UUID    `json:"league_id"`
+	LeagueName    string       `json:"league_name"`
+	Season        int          `json:"season"`
+	Status        LeagueStatus `json:"status"`
+	ParticipantID uuid.UUID    `json:"participant_id"`
+	TeamName      *string      `json:"team_name,omitempty"`
+	CareerStats
+}
+
+// CareerSeason represents a driver's record across every league of a season
+type CareerSeason struct {
+	Season  int            `json:"season"`
+	Leagues []CareerLeague `json:"leagues"`
+	CareerStats
+}
+
+// CareerProfileResponse represents the response for a driver's cross-league career profile
+type CareerProfileResponse struct {
+	UserID   uuid.UUID      `json:"user_id"`
+	Nickname string         `json:"nickname"`
+	Career   CareerStats    `json:"career"`
+	Seasons  []CareerSeason `json:"seasons"`
+}
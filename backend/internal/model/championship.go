@@ -0,0 +1,59 @@
+package model
+
+import "github.com/google/uuid"
+
+// ClinchStatus describes whether an entry can still win the championship
+type ClinchStatus string
+
+const (
+	ClinchStatusClinched   ClinchStatus = "clinched"   // Cannot be caught in the remaining rounds
+	ClinchStatusContender  ClinchStatus = "contender"  // Can still win the championship
+	ClinchStatusEliminated ClinchStatus = "eliminated" // Cannot reach the leader in the remaining rounds
+)
+
+// ClinchGoal identifies what an entry is playing for in the next round
+type ClinchGoal string
+
+const (
+	ClinchGoalClinch    ClinchGoal = "clinch"
+	ClinchGoalStayAlive ClinchGoal = "stay_alive"
+)
+
+// RemainingRound represents an upcoming, in-progress or postponed round that still awards points
+type RemainingRound struct {
+	Round           int           `json:"round"`
+	Track           string        `json:"track"`
+	Sprint          bool          `json:"sprint"`   // Sprint still to be run this round
+	Sessions        []SessionType `json:"sessions"` // Points-scoring sessions still to be run, in running order
+	MaxDriverPoints float64       `json:"max_driver_points"`
+}
+
+// ClinchScenario describes what an entry needs from the next round
+type ClinchScenario struct {
+	Round     int        `json:"round"`
+	Goal      ClinchGoal `json:"goal"`
+	Rival     string     `json:"rival"`      // Entry the margin is measured against
+	Margin    float64    `json:"margin"`     // Points to outscore the rival by; negative means it can be outscored by that much
+	MaxPoints float64    `json:"max_points"` // Most points the entry can score in the round
+}
+
+// ClinchEntry represents a driver's or team's championship outlook
+type ClinchEntry struct {
+	Rank          int             `json:"rank"`
+	ParticipantID *uuid.UUID      `json:"participant_id,omitempty"` // Driver entries only
+	Name          string          `json:"name"`
+	Points        float64         `json:"points"`
+	MaxPoints     float64         `json:"max_points"` // Points reached by winning everything that remains
+	Status        ClinchStatus    `json:"status"`
+	NextRound     *ClinchScenario `json:"next_round,omitempty"`
+}
+
+// ChampionshipOutlookResponse represents the response for the clinch and elimination calculator
+type ChampionshipOutlookResponse struct {
+	LeagueID        uuid.UUID        `json:"league_id"`
+	LeagueName      string           `json:"league_name"`
+	Season          int              `json:"season"`
+	RemainingRounds []RemainingRound `json:"remaining_rounds"`
+	Drivers         []ClinchEntry    `json:"drivers"`
+	Teams           []ClinchEntry    `json:"teams"`
+}
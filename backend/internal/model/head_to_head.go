@@ -0,0 +1,64 @@
+package model
+
+import "github.com/google/uuid"
+
+// HeadToHeadSide identifies which side of a comparison finished ahead
+type HeadToHeadSide string
+
+const (
+	HeadToHeadA    HeadToHeadSide = "a"
+	HeadToHeadB    HeadToHeadSide = "b"
+	HeadToHeadNone HeadToHeadSide = "" // Not comparable, e.g. both retired or one did not take part
+)
+
+// HeadToHeadEntry describes one side of a driver or team comparison
+type HeadToHeadEntry struct {
+	ParticipantID *uuid.UUID `json:"participant_id,omitempty"` // Driver comparisons only
+	Name          string     `json:"name"`
+	TeamName      *string    `json:"team_name,omitempty"`
+	Points        float64    `json:"points"`
+}
+
+// HeadToHeadCount tallies who finished ahead across a set of sessions
+type HeadToHeadCount struct {
+	A          int `json:"a"`
+	B          int `json:"b"`
+	SharedDNFs int `json:"shared_dnfs"` // Both retired, counted for neither side
+}
+
+// HeadToHeadFinish is one side's result in a session; teams use their best-placed car and summed points
+type HeadToHeadFinish struct {
+	Position *int    `json:"position,omitempty"`
+	Points   float64 `json:"points"`
+	DNF      bool    `json:"dnf"`
+}
+
+// HeadToHeadSession compares both sides in a single session of a round
+type HeadToHeadSession struct {
+	Session SessionType       `json:"session"`
+	A       *HeadToHeadFinish `json:"a,omitempty"`
+	B       *HeadToHeadFinish `json:"b,omitempty"`
+	Ahead   HeadToHeadSide    `json:"ahead"`
+}
+
+// HeadToHeadRound compares both sides across a round
+type HeadToHeadRound struct {
+	Round       int                 `json:"round"`
+	MatchID     uuid.UUID           `json:"match_id"`
+	QualifyingA *int                `json:"qualifying_a,omitempty"`
+	QualifyingB *int                `json:"qualifying_b,omitempty"`
+	Sessions    []HeadToHeadSession `json:"sessions"`
+	PointsDelta float64             `json:"points_delta"` // Cumulative points of a minus b after this round
+}
+
+// HeadToHeadResponse represents the response for a head-to-head comparison
+type HeadToHeadResponse struct {
+	LeagueID   uuid.UUID         `json:"league_id"`
+	Type       string            `json:"type"` // drivers or teams
+	A          HeadToHeadEntry   `json:"a"`
+	B          HeadToHeadEntry   `json:"b"`
+	Race       HeadToHeadCount   `json:"race"`
+	Sprint     HeadToHeadCount   `json:"sprint"`
+	Qualifying HeadToHeadCount   `json:"qualifying"`
+	Rounds     []HeadToHeadRound `json:"rounds"`
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// IncidentStatus represents the status of an incident report
+type IncidentStatus string
+
+const (
+	IncidentStatusSubmitted   IncidentStatus = "submitted"
+	IncidentStatusUnderReview IncidentStatus = "under_review"
+	IncidentStatusDecided     IncidentStatus = "decided"
+	IncidentStatusPublished   IncidentStatus = "published"
+	IncidentStatusWithdrawn   IncidentStatus = "withdrawn"
+)
+
+// IncidentDecision represents the stewards' decision on an incident report
+type IncidentDecision string
+
+const (
+	IncidentDecisionNoFurtherAction IncidentDecision = "no_further_action"
+	IncidentDecisionWarning         IncidentDecision = "warning"
+	IncidentDecisionPenalty         IncidentDecision = "penalty"
+)
+
+// IncidentReport represents an incident reported by a participant against another participant
+type IncidentReport struct {
+	ID             uuid.UUID         `json:"id"`
+	MatchID        uuid.UUID         `json:"match_id"`
+	Session        PenaltySession    `json:"session"`
+	ReporterID     uuid.UUID         `json:"reporter_id"`
+	AccusedID      uuid.UUID         `json:"accused_id"`
+	Lap            *int              `json:"lap,omitempty"`
+	Description    string            `json:"description"`
+	EvidenceLinks  pq.StringArray    `json:"evidence_links"` // Video links, optionally with timestamps
+	Status         IncidentStatus    `json:"status"`
+	Decision       *IncidentDecision `json:"decision,omitempty"`
+	DecisionReason *string           `json:"decision_reason,omitempty"`
+	PenaltyID      *uuid.UUID        `json:"penalty_id,omitempty"`
+	ReviewedBy     *uuid.UUID        `json:"reviewed_by,omitempty"`
+	DecidedAt      *time.Time        `json:"decided_at,omitempty"`
+	PublishedAt    *time.Time        `json:"published_at,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+
+	// Joined fields
+	LeagueID     *uuid.UUID `json:"league_id,omitempty"`
+	Round        *int       `json:"round,omitempty"`
+	ReporterName *string    `json:"reporter_name,omitempty"`
+	AccusedName  *string    `json:"accused_name,omitempty"`
+	ReviewerName *string    `json:"reviewer_name,omitempty"`
+}
+
+// CreateIncidentReportRequest represents a request to file an incident report
+type CreateIncidentReportRequest struct {
+	MatchID       uuid.UUID      `json:"match_id" validate:"required"`
+	Session       PenaltySession `json:"session" validate:"required"`
+	AccusedID     uuid.UUID      `json:"accused_id" validate:"required"`
+	Lap           *int           `json:"lap,omitempty"`
+	Description   string         `json:"description" validate:"required"`
+	EvidenceLinks []string       `json:"evidence_links,omitempty"`
+}
+
+// AddIncidentNoteRequest represents a steward note on an incident report
+type AddIncidentNoteRequest struct {
+	Note string `json:"note" validate:"required"`
+}
+
+// DecideIncidentRequest represents the stewards' decision on an incident report
+type DecideIncidentRequest struct {
+	Decision      IncidentDecision `json:"decision" validate:"required"`
+	Reason        string           `json:"reason" validate:"required"`
+	Penalty       *IncidentPenalty `json:"penalty,omitempty"`                                   // Required when Decision is penalty
+	LicencePoints int              `json:"licence_points,omitempty" validate:"omitempty,min=0"` // Licence points added to the accused
+}
+
+// IncidentPenalty describes the penalty issued to the accused participant
+type IncidentPenalty struct {
+	Type  PenaltyType `json:"type" validate:"required"`
+	Value int         `json:"value"`
+}
+
+// IncidentReportListResponse represents the response for listing incident reports
+type IncidentReportListResponse struct {
+	Reports []*IncidentReport `json:"reports"`
+	Total   int               `json:"total"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IncidentActionType represents the type of action performed on an incident report
+type IncidentActionType string
+
+const (
+	IncidentActionCreate   IncidentActionType = "CREATE"
+	IncidentActionReview   IncidentActionType = "REVIEW"
+	IncidentActionNote     IncidentActionType = "NOTE"
+	IncidentActionDecide   IncidentActionType = "DECIDE"
+	IncidentActionPublish  IncidentActionType = "PUBLISH"
+	IncidentActionWithdraw IncidentActionType = "WITHDRAW"
+)
+
+// IncidentActivityLog represents an audit log entry for incident report actions
+type IncidentActivityLog struct {
+	ID         uuid.UUID          `json:"id"`
+	ActorID    uuid.UUID          `json:"actor_id"`
+	ReportID   uuid.UUID          `json:"report_id"`
+	ActionType IncidentActionType `json:"action_type"`
+	Details    map[string]any     `json:"details"`
+	CreatedAt  time.Time          `json:"created_at"`
+
+	// Joined fields
+	ActorNickname *string `json:"actor_nickname,omitempty"`
+}
+
+// IncidentActivityListResponse represents the response for listing incident activity logs
+type IncidentActivityListResponse struct {
+	Activities []*IncidentActivityLog `json:"activities"`
+	Total      int                    `json:"total"`
+}
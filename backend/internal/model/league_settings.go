@@ -0,0 +1,63 @@
+package model
+
+// PointsTableSource says which points table a league scores with
+type PointsTableSource string
+
+const (
+	PointsTableStandard PointsTableSource = "standard" // The standard F1 table
+	PointsTableCustom   PointsTableSource = "custom"   // The league's own points system
+)
+
+// AssistsPolicy says which driving assists drivers may use
+type AssistsPolicy string
+
+const (
+	AssistsAny     AssistsPolicy = "any"
+	AssistsLimited AssistsPolicy = "limited" // No full traction control, ABS or racing line
+	AssistsNone    AssistsPolicy = "none"
+)
+
+// DamageLevel is the in-game car damage setting
+type DamageLevel string
+
+const (
+	DamageOff        DamageLevel = "off"
+	DamageReduced    DamageLevel = "reduced"
+	DamageStandard   DamageLevel = "standard"
+	DamageSimulation DamageLevel = "simulation"
+)
+
+// WeatherMode is the in-game weather setting
+type WeatherMode string
+
+const (
+	WeatherDynamic WeatherMode = "dynamic"
+	WeatherFixed   WeatherMode = "fixed"
+	WeatherClear   WeatherMode = "clear"
+)
+
+// LeagueSettings is the versioned settings document of a league
+type LeagueSettings struct {
+	Version         int               `json:"version"`
+	MaxTeams        int               `json:"max_teams"`        // 0 = no limit
+	SeatsPerTeam    int               `json:"seats_per_team"`   // Race seats (player role) per team
+	MaxReserves     int               `json:"max_reserves"`     // Approved reserves in the league, 0 = no limit
+	AllowedRoles    []ParticipantRole `json:"allowed_roles"`    // Roles drivers may apply for
+	PointsTable     PointsTableSource `json:"points_table"`     // Which points table scores the league
+	TransferWindows []TransferWindow  `json:"transfer_windows"` // Empty = team changes allowed at any time
+	StartingBudget  int64             `json:"starting_budget"`  // Opening balance of each new team account
+	Game            GameSettings      `json:"game"`
+}
+
+// TransferWindow is a period, inclusive of both dates in the league's timezone, when team changes may be requested
+type TransferWindow struct {
+	Opens  string `json:"opens"`
+	Closes string `json:"closes"`
+}
+
+// GameSettings are the in-game lobby settings of a league
+type GameSettings struct {
+	Assists AssistsPolicy `json:"assists"`
+	Damage  DamageLevel   `json:"damage"`
+	Weather WeatherMode   `json:"weather"`
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LicenceSettings represents league-level super licence rules
+type LicenceSettings struct {
+	ID           uuid.UUID `json:"id"`
+	LeagueID     uuid.UUID `json:"league_id"`
+	BanThreshold int       `json:"ban_threshold"`
+	ExpiryRounds *int      `json:"expiry_rounds,omitempty"` // Points expire after N completed rounds (nil = never)
+	ExpiryDays   *int      `json:"expiry_days,omitempty"`   // Points expire after N days (nil = never)
+	IsDefault    bool      `json:"is_default"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// UpdateLicenceSettingsRequest represents a request to replace a league's licence settings
+type UpdateLicenceSettingsRequest struct {
+	BanThreshold int  `json:"ban_threshold" validate:"required,min=1"`
+	ExpiryRounds *int `json:"expiry_rounds,omitempty" validate:"omitempty,min=1"`
+	ExpiryDays   *int `json:"expiry_days,omitempty" validate:"omitempty,min=1"`
+}
+
+// LicencePoint represents penalty points added to a participant's super licence
+type LicencePoint struct {
+	ID               uuid.UUID  `json:"id"`
+	ParticipantID    uuid.UUID  `json:"participant_id"`
+	MatchID          uuid.UUID  `json:"match_id"`
+	Points           int        `json:"points"`
+	Reason           string     `json:"reason"`
+	IncidentReportID *uuid.UUID `json:"incident_report_id,omitempty"`
+	IssuedBy         uuid.UUID  `json:"issued_by"`
+	BanID            *uuid.UUID `json:"ban_id,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+
+	// Joined fields
+	Round           int     `json:"round"`
+	ParticipantName *string `json:"participant_name,omitempty"`
+	IssuerName      *string `json:"issuer_name,omitempty"`
+
+	// Computed fields
+	Expired bool `json:"expired"`
+}
+
+// RaceBan represents a participant being banned from a match after reaching the points threshold
+type RaceBan struct {
+	ID            uuid.UUID `json:"id"`
+	ParticipantID uuid.UUID `json:"participant_id"`
+	MatchID       uuid.UUID `json:"match_id"`
+	PointsAtBan   int       `json:"points_at_ban"`
+	Reason        string    `json:"reason"`
+	CreatedAt     time.Time `json:"created_at"`
+
+	// Joined fields
+	Round           int     `json:"round"`
+	Track           string  `json:"track"`
+	ParticipantName *string `json:"participant_name,omitempty"`
+}
+
+// ParticipantLicence summarises a participant's current licence state
+type ParticipantLicence struct {
+	ActivePoints int      `json:"active_points"`
+	BanThreshold int      `json:"ban_threshold"`
+	UpcomingBan  *RaceBan `json:"upcoming_ban,omitempty"`
+}
+
+// AwardLicencePointsRequest represents a request to add licence points to a participant
+type AwardLicencePointsRequest struct {
+	ParticipantID uuid.UUID `json:"participant_id" validate:"required"`
+	MatchID       uuid.UUID `json:"match_id" validate:"required"`
+	Points        int       `json:"points" validate:"required,min=1"`
+	Reason        string    `json:"reason" validate:"required"`
+}
+
+// AwardLicencePointsResponse represents the awarded points and any ban they triggered
+type AwardLicencePointsResponse struct {
+	Points  *LicencePoint       `json:"points"`
+	Licence *ParticipantLicence `json:"licence"`
+	Ban     *RaceBan            `json:"ban,omitempty"`
+}
+
+// LicenceHistoryResponse represents the licence points and bans issued in a league
+type LicenceHistoryResponse struct {
+	Settings *LicenceSettings `json:"settings"`
+	Points   []*LicencePoint  `json:"points"`
+	Bans     []*RaceBan       `json:"bans"`
+}
@@ -13,42 +13,54 @@ const (
 	MatchStatusInProgress MatchStatus = "in_progress"
 	MatchStatusCompleted  MatchStatus = "completed"
 	MatchStatusCancelled  MatchStatus = "cancelled"
+	MatchStatusPostponed  MatchStatus = "postponed" // Called off until a new date is confirmed
 )
 
 // Match represents a league match/race schedule
 type Match struct {
-	ID           uuid.UUID   `json:"id"`
-	LeagueID     uuid.UUID   `json:"league_id"`
-	Round        int         `json:"round"`
-	Track        string      `json:"track"`
-	MatchDate    string      `json:"match_date"`
-	MatchTime    *string     `json:"match_time,omitempty"`
-	HasSprint    bool        `json:"has_sprint"`
-	SprintDate   *string     `json:"sprint_date,omitempty"`
-	SprintTime   *string     `json:"sprint_time,omitempty"`
-	SprintStatus MatchStatus `json:"sprint_status"`
-	Status       MatchStatus `json:"status"`
-	Description  *string     `json:"description,omitempty"`
-	CreatedAt    time.Time   `json:"created_at"`
-	UpdatedAt    time.Time   `json:"updated_at"`
+	ID             uuid.UUID   `json:"id"`
+	LeagueID       uuid.UUID   `json:"league_id"`
+	Round          int         `json:"round"`
+	Track          string      `json:"track"`
+	TrackID        *uuid.UUID  `json:"track_id,omitempty"`   // Catalogue track, nil for unmatched free-text names
+	TrackFlag      string      `json:"track_flag,omitempty"` // Emoji flag of the catalogue track's country
+	MatchDate      string      `json:"match_date"`
+	MatchTime      *string     `json:"match_time,omitempty"`
+	StartsAt       *time.Time  `json:"starts_at,omitempty"` // Race start, in the league's timezone
+	Timezone       string      `json:"timezone"`            // League timezone MatchDate and MatchTime are given in
+	HasSprint      bool        `json:"has_sprint"`
+	SprintDate     *string     `json:"sprint_date,omitempty"`
+	SprintTime     *string     `json:"sprint_time,omitempty"`
+	SprintStartsAt *time.Time  `json:"sprint_starts_at,omitempty"`
+	SprintStatus   MatchStatus `json:"sprint_status"`
+	Status         MatchStatus `json:"status"`
+	Description    *string     `json:"description,omitempty"`
+	Revision       int         `json:"schedule_revision"` // Bumped whenever the date moves, used as the calendar SEQUENCE
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+
+	// Sessions of the race weekend, loaded on detail requests
+	Sessions []*MatchSession `json:"sessions,omitempty"`
 }
 
 // CreateMatchRequest represents a request to create a match
 type CreateMatchRequest struct {
-	Round       int     `json:"round" validate:"required,min=1"`
-	Track       string  `json:"track" validate:"required"`
-	MatchDate   string  `json:"match_date" validate:"required"`
-	MatchTime   *string `json:"match_time,omitempty"`
-	HasSprint   bool    `json:"has_sprint"`
-	SprintDate  *string `json:"sprint_date,omitempty"`
-	SprintTime  *string `json:"sprint_time,omitempty"`
-	Description *string `json:"description,omitempty"`
+	Round       int        `json:"round" validate:"required,min=1"`
+	Track       string     `json:"track"`              // Free-text name, resolved against the catalogue when TrackID is not set
+	TrackID     *uuid.UUID `json:"track_id,omitempty"` // Catalogue track, takes precedence over Track
+	MatchDate   string     `json:"match_date" validate:"required"`
+	MatchTime   *string    `json:"match_time,omitempty"`
+	HasSprint   bool       `json:"has_sprint"`
+	SprintDate  *string    `json:"sprint_date,omitempty"`
+	SprintTime  *string    `json:"sprint_time,omitempty"`
+	Description *string    `json:"description,omitempty"`
 }
 
 // UpdateMatchRequest represents a request to update a match
 type UpdateMatchRequest struct {
 	Round        *int         `json:"round,omitempty"`
 	Track        *string      `json:"track,omitempty"`
+	TrackID      *uuid.UUID   `json:"track_id,omitempty"`
 	MatchDate    *string      `json:"match_date,omitempty"`
 	MatchTime    *string      `json:"match_time,omitempty"`
 	HasSprint    *bool        `json:"has_sprint,omitempty"`
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MatchRescheduleKind says how a match's date changed
+type MatchRescheduleKind string
+
+const (
+	MatchReschedulePostponed   MatchRescheduleKind = "postponed"   // Called off, optionally with a proposed new date
+	MatchRescheduleRescheduled MatchRescheduleKind = "rescheduled" // Moved to a confirmed new date
+	MatchRescheduleShifted     MatchRescheduleKind = "shifted"     // Pushed back because an earlier round moved
+)
+
+// MatchReschedule is one entry of a match's schedule history
+type MatchReschedule struct {
+	ID           uuid.UUID           `json:"id"`
+	MatchID      uuid.UUID           `json:"match_id"`
+	Kind         MatchRescheduleKind `json:"kind"`
+	FromDate     string              `json:"from_date"`
+	FromTime     *string             `json:"from_time,omitempty"`
+	ToDate       *string             `json:"to_date,omitempty"` // Proposed date for postponements, nil while undecided
+	ToTime       *string             `json:"to_time,omitempty"`
+	Reason       *string             `json:"reason,omitempty"`
+	CauseMatchID *uuid.UUID          `json:"cause_match_id,omitempty"` // Match whose move shifted this one
+	ActorID      *uuid.UUID          `json:"actor_id,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
+
+	// Joined fields
+	ActorName *string `json:"actor_name,omitempty"`
+}
+
+// PostponeMatchRequest represents a request to postpone a match
+type PostponeMatchRequest struct {
+	Reason       string  `json:"reason" validate:"required"`
+	ProposedDate *string `json:"proposed_date,omitempty"`
+	ProposedTime *string `json:"proposed_time,omitempty"`
+}
+
+// RescheduleMatchRequest represents a request to move a match to a confirmed new date
+type RescheduleMatchRequest struct {
+	MatchDate *string `json:"match_date,omitempty"` // Defaults to the date proposed when the match was postponed
+	MatchTime *string `json:"match_time,omitempty"` // Defaults to the current time
+	Reason    *string `json:"reason,omitempty"`
+	Cascade   bool    `json:"cascade"` // Push every later upcoming round back by the same number of days
+}
+
+// RescheduleMatchResponse represents a rescheduled match and the later rounds it pushed back
+type RescheduleMatchResponse struct {
+	Match   *Match   `json:"match"`
+	Shifted []*Match `json:"shifted"`
+}
+
+// ListMatchReschedulesResponse represents the schedule history of a match
+type ListMatchReschedulesResponse struct {
+	Reschedules []*MatchReschedule `json:"reschedules"`
+	Total       int                `json:"total"`
+}
@@ -8,36 +8,49 @@ import (
 
 // MatchResult represents a participant's result in a match
 type MatchResult struct {
-	ID             uuid.UUID `json:"id"`
-	MatchID        uuid.UUID `json:"match_id"`
-	ParticipantID  uuid.UUID `json:"participant_id"`
-	StoredTeamName *string   `json:"stored_team_name,omitempty"` // Team at the time of result recording
-	Position       *int      `json:"position,omitempty"`
-	Points         float64   `json:"points"`
-	FastestLap     bool      `json:"fastest_lap"`
-	DNF            bool      `json:"dnf"`
-	DNFReason      *string   `json:"dnf_reason,omitempty"`
-	SprintPosition *int      `json:"sprint_position,omitempty"`
-	SprintPoints   float64   `json:"sprint_points"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	ID                     uuid.UUID `json:"id"`
+	MatchID                uuid.UUID `json:"match_id"`
+	ParticipantID          uuid.UUID `json:"participant_id"`
+	StoredTeamName         *string   `json:"stored_team_name,omitempty"` // Team at the time of result recording
+	Position               *int      `json:"position,omitempty"`
+	Points                 float64   `json:"points"`
+	PointsManual           bool      `json:"points_manual"` // Race points entered manually (not recalculated)
+	FastestLap             bool      `json:"fastest_lap"`
+	DNF                    bool      `json:"dnf"`
+	DNFReason              *string   `json:"dnf_reason,omitempty"`
+	SprintPosition         *int      `json:"sprint_position,omitempty"`
+	SprintPoints           float64   `json:"sprint_points"`
+	SprintPointsManual     bool      `json:"sprint_points_manual"` // Sprint points entered manually (not recalculated)
+	RaceTimeMs             *int64    `json:"race_time_ms,omitempty"`
+	SprintTimeMs           *int64    `json:"sprint_time_ms,omitempty"`
+	OriginalPosition       *int      `json:"original_position,omitempty"`        // Race position before penalties
+	OriginalSprintPosition *int      `json:"original_sprint_position,omitempty"` // Sprint position before penalties
+	Disqualified           bool      `json:"disqualified"`
+	SprintDisqualified     bool      `json:"sprint_disqualified"`
+	CreatedAt              time.Time `json:"created_at"`
+	UpdatedAt              time.Time `json:"updated_at"`
 
 	// Joined fields for display
 	ParticipantName *string `json:"participant_name,omitempty"`
-	TeamName        *string `json:"team_name,omitempty"` // Current team from league_participants
+	TeamName        *string `json:"team_name,omitempty"`      // Current team from league_participants
+	SubstituteFor   *string `json:"substitute_for,omitempty"` // Driver a reserve stood in for
 }
 
 // CreateMatchResultRequest represents a request to create/update a match result
 type CreateMatchResultRequest struct {
-	ParticipantID  uuid.UUID `json:"participant_id" validate:"required"`
-	TeamName       *string   `json:"team_name,omitempty"`
-	Position       *int      `json:"position,omitempty"`
-	Points         float64   `json:"points"`
-	FastestLap     bool      `json:"fastest_lap"`
-	DNF            bool      `json:"dnf"`
-	DNFReason      *string   `json:"dnf_reason,omitempty"`
-	SprintPosition *int      `json:"sprint_position,omitempty"`
-	SprintPoints   float64   `json:"sprint_points"`
+	ParticipantID      uuid.UUID `json:"participant_id" validate:"required"`
+	TeamName           *string   `json:"team_name,omitempty"`
+	Position           *int      `json:"position,omitempty"`
+	Points             float64   `json:"points"`        // Only used when PointsManual is true
+	PointsManual       bool      `json:"points_manual"` // Keep Points as entered instead of calculating from Position
+	FastestLap         bool      `json:"fastest_lap"`
+	DNF                bool      `json:"dnf"`
+	DNFReason          *string   `json:"dnf_reason,omitempty"`
+	SprintPosition     *int      `json:"sprint_position,omitempty"`
+	SprintPoints       float64   `json:"sprint_points"`            // Only used when SprintPointsManual is true
+	SprintPointsManual bool      `json:"sprint_points_manual"`     // Keep SprintPoints as entered instead of calculating from SprintPosition
+	RaceTimeMs         *int64    `json:"race_time_ms,omitempty"`   // Total race time, required for time penalties
+	SprintTimeMs       *int64    `json:"sprint_time_ms,omitempty"` // Total sprint time, required for time penalties
 }
 
 // BulkUpdateResultsRequest represents a request to update multiple results at once
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MatchStatusReason explains why a match or session changed status
+type MatchStatusReason string
+
+const (
+	MatchStatusReasonSchedule MatchStatusReason = "schedule" // Scheduled start time reached
+	MatchStatusReasonResults  MatchStatusReason = "results"  // Results were submitted
+	MatchStatusReasonDuration MatchStatusReason = "duration" // Scheduled running time elapsed
+	MatchStatusReasonSessions MatchStatusReason = "sessions" // Every session of the weekend finished
+	MatchStatusReasonAdmin    MatchStatusReason = "admin"    // Changed by an administrator
+)
+
+// MatchStatusSubjectMatch is the subject of events about the match itself rather than one of its sessions
+const MatchStatusSubjectMatch = "match"
+
+// MatchStatusEvent is an audit entry for a match or session status change
+type MatchStatusEvent struct {
+	ID         uuid.UUID         `json:"id"`
+	MatchID    uuid.UUID         `json:"match_id"`
+	SessionID  *uuid.UUID        `json:"session_id,omitempty"`
+	Subject    string            `json:"subject"` // "match" or the session type
+	FromStatus MatchStatus       `json:"from_status"`
+	ToStatus   MatchStatus       `json:"to_status"`
+	Reason     MatchStatusReason `json:"reason"`
+	ActorID    *uuid.UUID        `json:"actor_id,omitempty"` // Nil for scheduler transitions
+	CreatedAt  time.Time         `json:"created_at"`
+
+	// Joined fields
+	ActorNickname *string `json:"actor_nickname,omitempty"`
+}
+
+// ListMatchStatusEventsResponse represents the response for listing a match's status history
+type ListMatchStatusEventsResponse struct {
+	Events []*MatchStatusEvent `json:"events"`
+	Total  int                 `json:"total"`
+}
@@ -40,6 +40,9 @@ type LeagueParticipant struct {
 	UserNickname *string `json:"user_nickname,omitempty"`
 	UserEmail    *string `json:"user_email,omitempty"`
 	LeagueName   *string `json:"league_name,omitempty"`
+
+	// Computed fields
+	Licence *ParticipantLicence `json:"licence,omitempty"`
 }
 
 // JoinLeagueRequest represents a request to join a league
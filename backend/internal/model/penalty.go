@@ -0,0 +1,78 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PenaltySession represents the points-scoring session type a penalty applies to.
+// A penalty applies to the first session of its type in the match.
+type PenaltySession string
+
+const (
+	PenaltySessionSprint          PenaltySession = "sprint"
+	PenaltySessionRace            PenaltySession = "race"
+	PenaltySessionFeatureRace     PenaltySession = "feature_race"
+	PenaltySessionReverseGridRace PenaltySession = "reverse_grid_race"
+	PenaltySessionEnduranceStint  PenaltySession = "endurance_stint"
+)
+
+// PenaltySessions lists the sessions penalties can be issued against, one for each of PointsSessionTypes
+var PenaltySessions = []PenaltySession{
+	PenaltySessionSprint,
+	PenaltySessionRace,
+	PenaltySessionFeatureRace,
+	PenaltySessionReverseGridRace,
+	PenaltySessionEnduranceStint,
+}
+
+// PenaltyType represents the kind of penalty issued by the stewards
+type PenaltyType string
+
+const (
+	PenaltyTypeTime             PenaltyType = "time"
+	PenaltyTypePositionDrop     PenaltyType = "position_drop"
+	PenaltyTypeDisqualification PenaltyType = "disqualification"
+)
+
+// Penalty represents a post-session penalty issued to a participant
+type Penalty struct {
+	ID            uuid.UUID      `json:"id"`
+	MatchID       uuid.UUID      `json:"match_id"`
+	ParticipantID uuid.UUID      `json:"participant_id"`
+	Session       PenaltySession `json:"session"`
+	Type          PenaltyType    `json:"type"`
+	Value         int            `json:"value"` // Seconds for time penalties, places for position drops
+	Reason        string         `json:"reason"`
+	IssuedBy      uuid.UUID      `json:"issued_by"`
+	RevokedBy     *uuid.UUID     `json:"revoked_by,omitempty"`
+	RevokedAt     *time.Time     `json:"revoked_at,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+
+	// Joined fields
+	ParticipantName *string `json:"participant_name,omitempty"`
+	IssuerName      *string `json:"issuer_name,omitempty"`
+}
+
+// CreatePenaltyRequest represents a request to issue a penalty
+type CreatePenaltyRequest struct {
+	ParticipantID uuid.UUID      `json:"participant_id" validate:"required"`
+	Session       PenaltySession `json:"session" validate:"required"`
+	Type          PenaltyType    `json:"type" validate:"required"`
+	Value         int            `json:"value"`
+	Reason        string         `json:"reason" validate:"required"`
+}
+
+// ListPenaltiesResponse represents the response for listing penalties
+type ListPenaltiesResponse struct {
+	Penalties []*Penalty `json:"penalties"`
+	Total     int        `json:"total"`
+}
+
+// PenaltyResponse represents the response after issuing or revoking a penalty
+type PenaltyResponse struct {
+	Penalty *Penalty       `json:"penalty"`
+	Results []*MatchResult `json:"results"` // Reclassified match results
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// PointsSystem represents a league's points table used to calculate race and sprint points
+type PointsSystem struct {
+	ID                    uuid.UUID       `json:"id"`
+	LeagueID              uuid.UUID       `json:"league_id"`
+	RacePoints            pq.Float64Array `json:"race_points"`
+	SprintPoints          pq.Float64Array `json:"sprint_points"`
+	FastestLapPoints      float64         `json:"fastest_lap_points"`
+	FastestLapMaxPosition *int            `json:"fastest_lap_max_position,omitempty"` // Bonus only within top N (nil = no restriction)
+	DNFNoPoints           bool            `json:"dnf_no_points"`
+	IsDefault             bool            `json:"is_default"` // True when the league has not configured its own table
+	CreatedAt             time.Time       `json:"created_at"`
+	UpdatedAt             time.Time       `json:"updated_at"`
+}
+
+// UpdatePointsSystemRequest represents a request to replace a league's points table
+type UpdatePointsSystemRequest struct {
+	RacePoints            []float64 `json:"race_points" validate:"required,min=1"`
+	SprintPoints          []float64 `json:"sprint_points"`
+	FastestLapPoints      float64   `json:"fastest_lap_points"`
+	FastestLapMaxPosition *int      `json:"fastest_lap_max_position,omitempty"`
+	DNFNoPoints           bool      `json:"dnf_no_points"`
+}
+
+// UpdatePointsSystemResponse represents the response after updating a points table
+type UpdatePointsSystemResponse struct {
+	PointsSystem        *PointsSystem `json:"points_system"`
+	RecalculatedResults int           `json:"recalculated_results"`
+}
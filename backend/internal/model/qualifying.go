@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QualifyingSession identifies which grid a qualifying result sets
+type QualifyingSession string
+
+const (
+	QualifyingSessionQualifying     QualifyingSession = "qualifying"      // Sets the race grid
+	QualifyingSessionSprintShootout QualifyingSession = "sprint_shootout" // Sets the sprint grid
+)
+
+// QualifyingResult represents a participant's qualifying result and starting grid slot
+type QualifyingResult struct {
+	ID            uuid.UUID         `json:"id"`
+	MatchID       uuid.UUID         `json:"match_id"`
+	ParticipantID uuid.UUID         `json:"participant_id"`
+	Session       QualifyingSession `json:"session"`
+	TeamName      *string           `json:"team_name,omitempty"`
+	Position      int               `json:"position"`
+	BestLapMs     *int64            `json:"best_lap_ms,omitempty"`
+	GapMs         *int64            `json:"gap_ms,omitempty"` // Gap to the fastest qualifier
+	GridPenalty   int               `json:"grid_penalty"`     // Grid places dropped
+	PitLaneStart  bool              `json:"pit_lane_start"`
+	GridPosition  int               `json:"grid_position"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+
+	// Joined fields
+	ParticipantName *string `json:"participant_name,omitempty"`
+}
+
+// CreateQualifyingResultRequest represents a single qualifying result entry
+type CreateQualifyingResultRequest struct {
+	ParticipantID uuid.UUID `json:"participant_id" validate:"required"`
+	TeamName      *string   `json:"team_name,omitempty"`
+	Position      int       `json:"position" validate:"required,min=1"`
+	BestLapMs     *int64    `json:"best_lap_ms,omitempty"`
+	GridPenalty   int       `json:"grid_penalty" validate:"min=0"`
+	PitLaneStart  bool      `json:"pit_lane_start"`
+}
+
+// BulkUpdateQualifyingRequest represents a request to replace a session's qualifying results
+type BulkUpdateQualifyingRequest struct {
+	Results []CreateQualifyingResultRequest `json:"results" validate:"required"`
+}
+
+// QualifyingResultListResponse represents the response for listing qualifying results
+type QualifyingResultListResponse struct {
+	MatchID uuid.UUID           `json:"match_id"`
+	Session QualifyingSession   `json:"session"`
+	Results []*QualifyingResult `json:"results"`
+	Total   int                 `json:"total"`
+}
+
+// QualifyingStats holds per-driver qualifying statistics used in the standings
+type QualifyingStats struct {
+	ParticipantID   uuid.UUID
+	Poles           int
+	PositionsGained int
+}
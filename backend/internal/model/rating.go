@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RatingDNFMode controls how non-finishers take part in a rating update
+type RatingDNFMode string
+
+const (
+	RatingDNFLast    RatingDNFMode = "last"    // DNFs lose to every finisher and draw with each other
+	RatingDNFExclude RatingDNFMode = "exclude" // DNFs are left out of the match entirely
+)
+
+// DriverRating represents a user's current skill rating across every league
+type DriverRating struct {
+	Rank         int        `json:"rank,omitempty"`
+	UserID       uuid.UUID  `json:"user_id"`
+	Nickname     string     `json:"nickname,omitempty"`
+	Rating       float64    `json:"rating"`
+	PeakRating   float64    `json:"peak_rating"`
+	MatchesRated int        `json:"matches_rated"`
+	LastMatchID  *uuid.UUID `json:"last_match_id,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// RatingHistoryEntry represents the rating change of a user in one match
+type RatingHistoryEntry struct {
+	ID           uuid.UUID `json:"id"`
+	UserID       uuid.UUID `json:"user_id"`
+	MatchID      uuid.UUID `json:"match_id"`
+	RatingBefore float64   `json:"rating_before"`
+	RatingAfter  float64   `json:"rating_after"`
+	Delta        float64   `json:"delta"`
+	Position     int       `json:"position"`  // Effective finishing position used for the comparison
+	Opponents    int       `json:"opponents"` // Drivers compared against
+	CreatedAt    time.Time `json:"created_at"`
+
+	// Joined fields
+	LeagueID   uuid.UUID `json:"league_id"`
+	LeagueName string    `json:"league_name,omitempty"`
+	Round      int       `json:"round,omitempty"`
+	Track      string    `json:"track,omitempty"`
+	MatchDate  string    `json:"match_date,omitempty"`
+}
+
+// RatingResult is a single classified entry of a completed match, as fed to the rating engine
+type RatingResult struct {
+	MatchID  uuid.UUID
+	UserID   uuid.UUID
+	Position *int
+	DNF      bool // Retired, disqualified or unclassified
+}
+
+// RatingLeaderboardResponse represents the response for the rating leaderboard
+type RatingLeaderboardResponse struct {
+	Ratings    []DriverRating `json:"ratings"`
+	Total      int            `json:"total"`
+	Page       int            `json:"page"`
+	Limit      int            `json:"limit"`
+	TotalPages int            `json:"total_pages"`
+}
+
+// RatingHistoryResponse represents the response for a user's rating history
+type RatingHistoryResponse struct {
+	Rating  *DriverRating        `json:"rating,omitempty"`
+	History []RatingHistoryEntry `json:"history"`
+}
+
+// RecomputeRatingsResponse represents the result of replaying every completed match
+type RecomputeRatingsResponse struct {
+	Matches int `json:"matches"`
+	Drivers int `json:"drivers"`
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// ImportMatchStatus describes how an imported row was matched to a league participant
+type ImportMatchStatus string
+
+const (
+	ImportMatchMatched   ImportMatchStatus = "matched"
+	ImportMatchAmbiguous ImportMatchStatus = "ambiguous"
+	ImportMatchUnmatched ImportMatchStatus = "unmatched"
+)
+
+// ImportCandidate is a participant that an imported driver name may refer to
+type ImportCandidate struct {
+	ParticipantID uuid.UUID `json:"participant_id"`
+	Name          string    `json:"name"`
+	Score         float64   `json:"score"` // Name similarity from 0 to 1
+}
+
+// ResultImportRow is a single classification row read from an import file
+type ResultImportRow struct {
+	Line            int               `json:"line"`
+	DriverName      string            `json:"driver_name"`
+	Position        *int              `json:"position,omitempty"`
+	FastestLap      bool              `json:"fastest_lap"`
+	DNF             bool              `json:"dnf"`
+	PenaltySec      int               `json:"penalty_sec"`
+	RaceTimeMs      *int64            `json:"race_time_ms,omitempty"` // Without the penalty time
+	SprintPosition  *int              `json:"sprint_position,omitempty"`
+	SprintTimeMs    *int64            `json:"sprint_time_ms,omitempty"`
+	Status          ImportMatchStatus `json:"status"`
+	ParticipantID   *uuid.UUID        `json:"participant_id,omitempty"`
+	ParticipantName *string           `json:"participant_name,omitempty"`
+	Candidates      []ImportCandidate `json:"candidates,omitempty"`
+}
+
+// ResultImportPreview is the dry-run result of an import file, shown to the admin before committing
+type ResultImportPreview struct {
+	MatchID   uuid.UUID         `json:"match_id"`
+	Format    string            `json:"format"`
+	Rows      []ResultImportRow `json:"rows"`
+	Matched   int               `json:"matched"`
+	Ambiguous int               `json:"ambiguous"`
+	Unmatched int               `json:"unmatched"`
+}
+
+// ResultImportEntry is a confirmed import row with its participant resolved
+type ResultImportEntry struct {
+	ParticipantID  uuid.UUID `json:"participant_id" validate:"required"`
+	Position       *int      `json:"position,omitempty"`
+	FastestLap     bool      `json:"fastest_lap"`
+	DNF            bool      `json:"dnf"`
+	PenaltySec     int       `json:"penalty_sec"`
+	RaceTimeMs     *int64    `json:"race_time_ms,omitempty"`
+	SprintPosition *int      `json:"sprint_position,omitempty"`
+	SprintTimeMs   *int64    `json:"sprint_time_ms,omitempty"`
+}
+
+// ConfirmResultImportRequest represents a request to commit a previewed import
+type ConfirmResultImportRequest struct {
+	Rows []ResultImportEntry `json:"rows" validate:"required"`
+}
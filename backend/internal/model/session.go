@@ -0,0 +1,141 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionType identifies the kind of session within a race weekend
+type SessionType string
+
+const (
+	SessionTypePractice        SessionType = "practice"
+	SessionTypeQualifying      SessionType = "qualifying"
+	SessionTypeSprintShootout  SessionType = "sprint_shootout"
+	SessionTypeSprint          SessionType = "sprint"
+	SessionTypeRace            SessionType = "race"
+	SessionTypeFeatureRace     SessionType = "feature_race"
+	SessionTypeReverseGridRace SessionType = "reverse_grid_race"
+	SessionTypeEnduranceStint  SessionType = "endurance_stint"
+)
+
+// PointsSessionTypes lists the session types whose results score championship points
+var PointsSessionTypes = []SessionType{
+	SessionTypeSprint,
+	SessionTypeRace,
+	SessionTypeFeatureRace,
+	SessionTypeReverseGridRace,
+	SessionTypeEnduranceStint,
+}
+
+// ResultsStatus tracks whether a session's results may still change on appeal
+type ResultsStatus string
+
+const (
+	ResultsStatusProvisional ResultsStatus = "provisional"
+	ResultsStatusOfficial    ResultsStatus = "official"
+)
+
+// MatchSession represents a single scheduled session of a race weekend
+type MatchSession struct {
+	ID              uuid.UUID   `json:"id"`
+	MatchID         uuid.UUID   `json:"match_id"`
+	Type            SessionType `json:"type"`
+	Name            *string     `json:"name,omitempty"`
+	Order           int         `json:"order"`
+	SessionDate     *string     `json:"session_date,omitempty"`
+	SessionTime     *string     `json:"session_time,omitempty"`
+	StartsAt        *time.Time  `json:"starts_at,omitempty"` // Session start, in the league's timezone
+	Status          MatchStatus `json:"status"`
+	ReverseGridSize *int        `json:"reverse_grid_size,omitempty"` // Top finishers of the previous session reversed on the grid
+	DurationMinutes *int        `json:"duration_minutes,omitempty"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+
+	// Results state, nil until results are entered
+	ResultsStatus      *ResultsStatus `json:"results_status,omitempty"`
+	ResultsSubmittedAt *time.Time     `json:"results_submitted_at,omitempty"`
+	ResultsOfficialAt  *time.Time     `json:"results_official_at,omitempty"`
+}
+
+// CreateSessionRequest represents a request to add a session to a match
+type CreateSessionRequest struct {
+	Type            SessionType `json:"type" validate:"required"`
+	Name            *string     `json:"name,omitempty"`
+	Order           *int        `json:"order,omitempty"` // Appended after the last session when omitted
+	SessionDate     *string     `json:"session_date,omitempty"`
+	SessionTime     *string     `json:"session_time,omitempty"`
+	ReverseGridSize *int        `json:"reverse_grid_size,omitempty"`
+	DurationMinutes *int        `json:"duration_minutes,omitempty"`
+}
+
+// UpdateSessionRequest represents a request to update a session
+type UpdateSessionRequest struct {
+	Name            *string      `json:"name,omitempty"`
+	Order           *int         `json:"order,omitempty"`
+	SessionDate     *string      `json:"session_date,omitempty"`
+	SessionTime     *string      `json:"session_time,omitempty"`
+	Status          *MatchStatus `json:"status,omitempty"`
+	ReverseGridSize *int         `json:"reverse_grid_size,omitempty"`
+	DurationMinutes *int         `json:"duration_minutes,omitempty"`
+}
+
+// ListSessionsResponse represents the response for listing a match's sessions
+type ListSessionsResponse struct {
+	MatchID  uuid.UUID       `json:"match_id"`
+	Sessions []*MatchSession `json:"sessions"`
+	Total    int             `json:"total"`
+}
+
+// SessionResult represents a participant's result in a single session
+type SessionResult struct {
+	ID               uuid.UUID `json:"id"`
+	SessionID        uuid.UUID `json:"session_id"`
+	ParticipantID    uuid.UUID `json:"participant_id"`
+	TeamName         *string   `json:"team_name,omitempty"` // Team at the time of result recording
+	Position         *int      `json:"position,omitempty"`
+	Points           float64   `json:"points"`
+	PointsManual     bool      `json:"points_manual"`
+	FastestLap       bool      `json:"fastest_lap"`
+	DNF              bool      `json:"dnf"`
+	DNFReason        *string   `json:"dnf_reason,omitempty"`
+	TimeMs           *int64    `json:"time_ms,omitempty"`
+	Laps             *int      `json:"laps,omitempty"`
+	OriginalPosition *int      `json:"original_position,omitempty"` // Position before penalties
+	Disqualified     bool      `json:"disqualified"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+
+	// Joined fields
+	ParticipantName *string     `json:"participant_name,omitempty"`
+	SessionType     SessionType `json:"session_type,omitempty"`
+	MatchID         uuid.UUID   `json:"match_id"`
+	SubstituteFor   *string     `json:"substitute_for,omitempty"` // Driver a reserve stood in for
+}
+
+// CreateSessionResultRequest represents a single session result entry
+type CreateSessionResultRequest struct {
+	ParticipantID uuid.UUID `json:"participant_id" validate:"required"`
+	TeamName      *string   `json:"team_name,omitempty"`
+	Position      *int      `json:"position,omitempty"`
+	Points        float64   `json:"points"`        // Only used when PointsManual is true
+	PointsManual  bool      `json:"points_manual"` // Keep Points as entered instead of calculating from Position
+	FastestLap    bool      `json:"fastest_lap"`
+	DNF           bool      `json:"dnf"`
+	DNFReason     *string   `json:"dnf_reason,omitempty"`
+	TimeMs        *int64    `json:"time_ms,omitempty"`
+	Laps          *int      `json:"laps,omitempty"`
+}
+
+// BulkUpdateSessionResultsRequest represents a request to replace a session's results
+type BulkUpdateSessionResultsRequest struct {
+	Results []CreateSessionResultRequest `json:"results" validate:"required"`
+}
+
+// SessionResultsResponse represents the response for listing a session's results
+type SessionResultsResponse struct {
+	Session *MatchSession    `json:"session"`
+	Results []*SessionResult `json:"results"`
+	Total   int              `json:"total"`
+}
@@ -17,6 +17,12 @@ type StandingsEntry struct {
 	FastestLaps     int       `json:"fastest_laps"`
 	DNFs            int       `json:"dnfs"`
 	RacesCompleted  int       `json:"races_completed"`
+	Poles           int       `json:"poles"`
+	PositionsGained int       `json:"positions_gained"` // Grid slot minus finishing position, summed over classified finishes
+	DroppedPoints   float64   `json:"dropped_points"`
+	DroppedRounds   []int     `json:"dropped_rounds,omitempty"`
+	ReservePoints   float64   `json:"reserve_points,omitempty"` // Substitute points left out of the driver championship
+	TieBreak        *TieBreak `json:"tie_break,omitempty"`
 }
 
 // TeamStandingsEntry represents a single team entry in the standings
@@ -31,6 +37,24 @@ type TeamStandingsEntry struct {
 	FastestLaps    int     `json:"fastest_laps"`
 	DNFs           int     `json:"dnfs"`
 	DriverCount    int     `json:"driver_count"`
+	DroppedPoints  float64 `json:"dropped_points"`
+	DroppedRounds  []int   `json:"dropped_rounds,omitempty"`
+	TieBreak       *TieBreak `json:"tie_break,omitempty"`
+}
+
+// TieBreakRule identifies the rule used to separate entries on equal points
+type TieBreakRule string
+
+const (
+	TieBreakCountback      TieBreakRule = "countback"       // More finishes in the given position
+	TieBreakEarliestResult TieBreakRule = "earliest_result" // Achieved the given position in an earlier round
+	TieBreakShared         TieBreakRule = "shared"          // Could not be separated, rank is shared
+)
+
+// TieBreak describes how an entry was separated from the entry ranked directly above it on equal points
+type TieBreak struct {
+	Rule     TieBreakRule `json:"rule"`
+	Position int          `json:"position,omitempty"` // Finishing position that decided the tie
 }
 
 // LeagueStandingsResponse represents the response for league standings
@@ -41,4 +65,28 @@ type LeagueStandingsResponse struct {
 	TotalRaces    int                  `json:"total_races"`
 	Standings     []StandingsEntry     `json:"standings"`
 	TeamStandings []TeamStandingsEntry `json:"team_standings"`
+	Rules         *StandingsRules      `json:"rules,omitempty"`
+
+	OfficialOnly      bool  `json:"official_only"`                // Provisional results were left out
+	ProvisionalRounds []int `json:"provisional_rounds,omitempty"` // Rounds whose counted results are still provisional
+}
+
+// RoundResult represents a single participant's result in one points-scoring session of a round.
+// Sprint sessions fill the sprint fields, every other session fills the race fields.
+type RoundResult struct {
+	MatchID        uuid.UUID   `json:"match_id"`
+	Round          int         `json:"round"`
+	Session        SessionType `json:"session"`
+	ParticipantID  uuid.UUID   `json:"participant_id"`
+	TeamName       *string     `json:"team_name,omitempty"` // Team at the time of result recording
+	Position       *int        `json:"position,omitempty"`
+	Points         float64     `json:"points"`
+	SprintPosition *int        `json:"sprint_position,omitempty"`
+	SprintPoints   float64     `json:"sprint_points"`
+	FastestLap     bool        `json:"fastest_lap"`
+	DNF            bool        `json:"dnf"`
+	Provisional    bool        `json:"provisional"` // Session results are still within the appeal window
+	Substitute     bool        `json:"substitute"`  // Driven by a reserve standing in for TeamName
+	DriverExcluded bool        `json:"-"`           // Points do not count for the driver under the league's reserve rule
 }
+
@@ -0,0 +1,42 @@
+package model
+
+import "github.com/google/uuid"
+
+// ProgressionRound represents a completed round included in the championship progression
+type ProgressionRound struct {
+	Round   int       `json:"round"`
+	MatchID uuid.UUID `json:"match_id"`
+	Track   string    `json:"track"`
+}
+
+// ProgressionPoint represents a driver's or team's championship state after a round
+type ProgressionPoint struct {
+	Round       int     `json:"round"`
+	RoundPoints float64 `json:"round_points"` // Race and sprint points scored in this round
+	TotalPoints float64 `json:"total_points"` // Cumulative championship points after this round
+	Position    int     `json:"position"`     // Championship position after this round
+}
+
+// DriverProgression represents a driver's championship progression across rounds
+type DriverProgression struct {
+	ParticipantID uuid.UUID          `json:"participant_id"`
+	DriverName    string             `json:"driver_name"`
+	TeamName      *string            `json:"team_name,omitempty"`
+	Progression   []ProgressionPoint `json:"progression"`
+}
+
+// TeamProgression represents a team's championship progression across rounds
+type TeamProgression struct {
+	TeamName    string             `json:"team_name"`
+	Progression []ProgressionPoint `json:"progression"`
+}
+
+// StandingsProgressionResponse represents the response for round-by-round championship progression
+type StandingsProgressionResponse struct {
+	LeagueID   uuid.UUID           `json:"league_id"`
+	LeagueName string              `json:"league_name"`
+	Season     int                 `json:"season"`
+	Rounds     []ProgressionRound  `json:"rounds"`
+	Drivers    []DriverProgression `json:"drivers"`
+	Teams      []TeamProgression   `json:"teams"`
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StandingsRules represents league-level rules applied when calculating championship standings
+type StandingsRules struct {
+	ID                uuid.UUID         `json:"id"`
+	LeagueID          uuid.UUID         `json:"league_id"`
+	CountedRounds     *int              `json:"counted_rounds,omitempty"` // Best N rounds count (nil = all rounds)
+	DropWorstRounds   int               `json:"drop_worst_rounds"`
+	ApplyToTeams      bool              `json:"apply_to_teams"`
+	AppealWindowHours int               `json:"appeal_window_hours"` // Provisional results become official after this many hours
+	ReservePointsMode ReservePointsMode `json:"reserve_points_mode"`
+	ReserveRoundCap   *int              `json:"reserve_round_cap,omitempty"` // Substitute rounds counted in capped mode
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+}
+
+// UpdateStandingsRulesRequest represents a request to replace a league's standings rules
+type UpdateStandingsRulesRequest struct {
+	CountedRounds     *int              `json:"counted_rounds,omitempty" validate:"omitempty,min=1"`
+	DropWorstRounds   int               `json:"drop_worst_rounds" validate:"min=0"`
+	ApplyToTeams      *bool             `json:"apply_to_teams,omitempty"`                                 // Defaults to true
+	AppealWindowHours *int              `json:"appeal_window_hours,omitempty" validate:"omitempty,min=0"` // Defaults to 24 hours
+	ReservePointsMode ReservePointsMode `json:"reserve_points_mode,omitempty"`                            // Defaults to count
+	ReserveRoundCap   *int              `json:"reserve_round_cap,omitempty" validate:"omitempty,min=0"`
+}
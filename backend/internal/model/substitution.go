@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Substitution records a reserve driver standing in for a team's driver in one match
+type Substitution struct {
+	ID                    uuid.UUID  `json:"id"`
+	MatchID               uuid.UUID  `json:"match_id"`
+	ReserveParticipantID  uuid.UUID  `json:"reserve_participant_id"`
+	ReplacedParticipantID uuid.UUID  `json:"replaced_participant_id"`
+	TeamName              string     `json:"team_name"` // Team credited with the reserve's results
+	CreatedBy             *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt             time.Time  `json:"created_at"`
+
+	// Joined fields
+	ReserveName  *string `json:"reserve_name,omitempty"`
+	ReplacedName *string `json:"replaced_name,omitempty"`
+}
+
+// CreateSubstitutionRequest represents a request to record a substitution
+type CreateSubstitutionRequest struct {
+	ReserveParticipantID  uuid.UUID `json:"reserve_participant_id" validate:"required"`
+	ReplacedParticipantID uuid.UUID `json:"replaced_participant_id" validate:"required"`
+	TeamName              *string   `json:"team_name,omitempty"` // Defaults to the replaced driver's team
+}
+
+// ListSubstitutionsResponse represents the response for listing a match's substitutions
+type ListSubstitutionsResponse struct {
+	Substitutions []*Substitution `json:"substitutions"`
+	Total         int             `json:"total"`
+}
+
+// ReservePointsMode decides how points scored as a substitute count in the driver championship
+type ReservePointsMode string
+
+const (
+	ReservePointsCount   ReservePointsMode = "count"   // Substitute points count in full
+	ReservePointsExclude ReservePointsMode = "exclude" // Substitute points only count for the team
+	ReservePointsCapped  ReservePointsMode = "capped"  // Only the first ReserveRoundCap substitute rounds count
+)
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LiveTiming is a snapshot of the live timing feed of a match fed by game telemetry
+type LiveTiming struct {
+	MatchID         uuid.UUID       `json:"match_id"`
+	SessionType     SessionType     `json:"session_type,omitempty"`
+	TotalLaps       int             `json:"total_laps"`
+	CurrentLap      int             `json:"current_lap"`       // Lap of the race leader
+	SessionTimeLeft int             `json:"session_time_left"` // Seconds
+	Cars            []LiveTimingCar `json:"cars"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// LiveTimingCar is a single car's row in the live timing feed
+type LiveTimingCar struct {
+	CarIndex      int    `json:"car_index"`
+	DriverName    string `json:"driver_name"`
+	TeamID        int    `json:"team_id"`
+	RaceNumber    int    `json:"race_number"`
+	Position      int    `json:"position"`
+	CurrentLap    int    `json:"current_lap"`
+	LastLapMs     *int64 `json:"last_lap_ms,omitempty"`
+	BestLapMs     *int64 `json:"best_lap_ms,omitempty"`
+	GapToLeaderMs int64  `json:"gap_to_leader_ms"`
+	IntervalMs    int64  `json:"interval_ms"` // Gap to the car directly ahead
+	PitStops      int    `json:"pit_stops"`
+	InPit         bool   `json:"in_pit"`
+	PenaltiesSec  int    `json:"penalties_sec"`
+	Status        string `json:"status"`
+}
+
+// TelemetryClassification is a provisional result taken from the game's final classification,
+// kept until an admin confirms it into a session's results
+type TelemetryClassification struct {
+	ID            uuid.UUID   `json:"id"`
+	MatchID       uuid.UUID   `json:"match_id"`
+	SessionType   SessionType `json:"session_type"`
+	CarIndex      int         `json:"car_index"`
+	ParticipantID *uuid.UUID  `json:"participant_id,omitempty"` // Matched league participant, nil when unmatched
+	DriverName    string      `json:"driver_name"`              // Name reported by the game
+	RaceNumber    int         `json:"race_number"`
+	Position      int         `json:"position"`
+	GridPosition  int         `json:"grid_position"`
+	Laps          int         `json:"laps"`
+	BestLapMs     *int64      `json:"best_lap_ms,omitempty"`
+	TotalTimeMs   *int64      `json:"total_time_ms,omitempty"` // Including time penalties
+	PenaltiesSec  int         `json:"penalties_sec"`
+	PitStops      int         `json:"pit_stops"`
+	ResultStatus  string      `json:"result_status"`
+	CreatedAt     time.Time   `json:"created_at"`
+
+	// Joined fields
+	ParticipantName *string `json:"participant_name,omitempty"`
+}
+
+// TelemetryStatus describes which match the telemetry listener is feeding
+type TelemetryStatus struct {
+	Enabled      bool       `json:"enabled"`
+	MatchID      *uuid.UUID `json:"match_id,omitempty"`
+	LastPacketAt *time.Time `json:"last_packet_at,omitempty"`
+}
+
+// AssignTelemetryDriverRequest links a classification row to a league participant
+type AssignTelemetryDriverRequest struct {
+	ParticipantID uuid.UUID `json:"participant_id" validate:"required"`
+}
+
+// ConfirmTelemetryRequest represents a request to confirm a provisional classification into session results
+type ConfirmTelemetryRequest struct {
+	SessionType   SessionType `json:"session_type" validate:"required"`
+	SessionID     *uuid.UUID  `json:"session_id,omitempty"` // Defaults to the match's first session of the same type
+	SkipUnmatched bool        `json:"skip_unmatched"`       // Drop rows without a participant instead of rejecting
+}
+
+// ListTelemetryClassificationResponse represents the response for listing provisional classifications
+type ListTelemetryClassificationResponse struct {
+	MatchID uuid.UUID                  `json:"match_id"`
+	Results []*TelemetryClassification `json:"results"`
+	Total   int                        `json:"total"`
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Track represents a circuit in the managed track catalogue
+type Track struct {
+	ID              uuid.UUID      `json:"id"`
+	Slug            string         `json:"slug"`
+	Name            string         `json:"name"`
+	Country         string         `json:"country"`
+	CountryCode     string         `json:"country_code"` // ISO 3166-1 alpha-2
+	Flag            string         `json:"flag"`         // Emoji flag derived from CountryCode
+	Layout          string         `json:"layout"`
+	FullRaceLaps    int            `json:"full_race_laps"` // Laps in a full-distance grand prix
+	LengthKm        float64        `json:"length_km"`
+	DefaultRaceLaps int            `json:"default_race_laps"` // Laps league races run by default
+	Aliases         pq.StringArray `json:"aliases"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+}
+
+// CreateTrackRequest represents a request to add a track to the catalogue
+type CreateTrackRequest struct {
+	Slug            string   `json:"slug" validate:"required"`
+	Name            string   `json:"name" validate:"required"`
+	Country         string   `json:"country" validate:"required"`
+	CountryCode     string   `json:"country_code" validate:"required,len=2"`
+	Layout          string   `json:"layout"` // Defaults to Grand Prix
+	FullRaceLaps    int      `json:"full_race_laps" validate:"required,min=1"`
+	LengthKm        float64  `json:"length_km" validate:"required,gt=0"`
+	DefaultRaceLaps int      `json:"default_race_laps" validate:"required,min=1"`
+	Aliases         []string `json:"aliases"`
+}
+
+// UpdateTrackRequest represents a request to update a catalogue track
+type UpdateTrackRequest struct {
+	Name            *string   `json:"name,omitempty"`
+	Country         *string   `json:"country,omitempty"`
+	CountryCode     *string   `json:"country_code,omitempty"`
+	Layout          *string   `json:"layout,omitempty"`
+	FullRaceLaps    *int      `json:"full_race_laps,omitempty"`
+	LengthKm        *float64  `json:"length_km,omitempty"`
+	DefaultRaceLaps *int      `json:"default_race_laps,omitempty"`
+	Aliases         *[]string `json:"aliases,omitempty"`
+}
+
+// ListTracksResponse represents the response for listing tracks
+type ListTracksResponse struct {
+	Tracks []*Track `json:"tracks"`
+	Total  int      `json:"total"`
+}
+
+// TrackWinner is the winner of a completed race held at a track
+type TrackWinner struct {
+	MatchID    uuid.UUID `json:"match_id"`
+	LeagueID   uuid.UUID `json:"league_id"`
+	LeagueName string    `json:"league_name"`
+	Season     int       `json:"season"`
+	Round      int       `json:"round"`
+	MatchDate  string    `json:"match_date"`
+	UserID     uuid.UUID `json:"user_id"`
+	DriverName string    `json:"driver_name"`
+	TeamName   *string   `json:"team_name,omitempty"`
+}
+
+// TrackLapRecord is the fastest lap set at a track within one league
+type TrackLapRecord struct {
+	LeagueID   uuid.UUID `json:"league_id"`
+	LeagueName string    `json:"league_name"`
+	Season     int       `json:"season"`
+	MatchID    uuid.UUID `json:"match_id"`
+	Round      int       `json:"round"`
+	UserID     uuid.UUID `json:"user_id"`
+	DriverName string    `json:"driver_name"`
+	LapTimeMs  int64     `json:"lap_time_ms"`
+	Source     string    `json:"source"` // qualifying, sprint_shootout or telemetry
+}
+
+// TrackStatsResponse represents past winners and lap records at a track
+type TrackStatsResponse struct {
+	Track      *Track           `json:"track"`
+	RacesHeld  int              `json:"races_held"`
+	Winners    []TrackWinner    `json:"winners"`
+	LapRecords []TrackLapRecord `json:"lap_records"`
+}
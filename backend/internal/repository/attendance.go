@@ -0,0 +1,492 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAttendanceSettingsNotFound = errors.New("attendance settings not found")
+	ErrReserveOfferNotFound       = errors.New("reserve offer not found")
+	ErrReserveOfferNotPending     = errors.New("reserve offer is no longer pending")
+	ErrSeatAlreadyOffered         = errors.New("seat already has a pending offer")
+)
+
+const reserveOfferSelect = `
+	SELECT ro.id, ro.match_id, ro.reserve_participant_id, ro.replaced_participant_id, ro.team_name, ro.status,
+	       ro.expires_at, ro.responded_at, ro.created_at, m.round, m.track, ru.nickname, pu.nickname
+	FROM reserve_offers ro
+	JOIN matches m ON m.id = ro.match_id
+	JOIN league_participants rlp ON rlp.id = ro.reserve_participant_id
+	JOIN users ru ON ru.id = rlp.user_id
+	JOIN league_participants plp ON plp.id = ro.replaced_participant_id
+	JOIN users pu ON pu.id = plp.user_id
+`
+
+type AttendanceRepository struct {
+	db *database.DB
+}
+
+func NewAttendanceRepository(db *database.DB) *AttendanceRepository {
+	return &AttendanceRepository{db: db}
+}
+
+// GetSettings retrieves the RSVP rules configured for a league
+func (r *AttendanceRepository) GetSettings(ctx context.Context, leagueID uuid.UUID) (*model.AttendanceSettings, error) {
+	query := `
+		SELECT id, league_id, rsvp_deadline_hours, offer_timeout_minutes, missed_rsvp_strikes, created_at, updated_at
+		FROM attendance_settings
+		WHERE league_id = $1
+	`
+
+	s := &model.AttendanceSettings{}
+	err := r.db.Pool.QueryRowContext(ctx, query, leagueID).Scan(
+		&s.ID,
+		&s.LeagueID,
+		&s.RSVPDeadlineHours,
+		&s.OfferTimeoutMinutes,
+		&s.MissedRSVPStrikes,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAttendanceSettingsNotFound
+		}
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// UpsertSettings creates or replaces the RSVP rules for a league
+func (r *AttendanceRepository) UpsertSettings(ctx context.Context, s *model.AttendanceSettings) error {
+	query := `
+		INSERT INTO attendance_settings (league_id, rsvp_deadline_hours, offer_timeout_minutes, missed_rsvp_strikes)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (league_id)
+		DO UPDATE SET
+			rsvp_deadline_hours = EXCLUDED.rsvp_deadline_hours,
+			offer_timeout_minutes = EXCLUDED.offer_timeout_minutes,
+			missed_rsvp_strikes = EXCLUDED.missed_rsvp_strikes,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	return r.db.Pool.QueryRowContext(ctx, query,
+		s.LeagueID,
+		s.RSVPDeadlineHours,
+		s.OfferTimeoutMinutes,
+		s.MissedRSVPStrikes,
+	).Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt)
+}
+
+// UpsertRSVP saves a driver's answer for a match, replacing any earlier one
+func (r *AttendanceRepository) UpsertRSVP(ctx context.Context, rsvp *model.MatchRSVP) error {
+	query := `
+		INSERT INTO match_rsvps (match_id, participant_id, status, note)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (match_id, participant_id)
+		DO UPDATE SET status = EXCLUDED.status, note = EXCLUDED.note, updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	return r.db.Pool.QueryRowContext(ctx, query,
+		rsvp.MatchID,
+		rsvp.ParticipantID,
+		rsvp.Status,
+		rsvp.Note,
+	).Scan(&rsvp.ID, &rsvp.CreatedAt, &rsvp.UpdatedAt)
+}
+
+// ListAvailability retrieves every approved driver of a league with their answer for a match,
+// ordered by team
+func (r *AttendanceRepository) ListAvailability(ctx context.Context, leagueID, matchID uuid.UUID) ([]model.DriverAvailability, error) {
+	query := `
+		SELECT lp.id, u.nickname, lp.team_name, r.status, r.note, r.updated_at, su.nickname
+		FROM league_participants lp
+		JOIN users u ON u.id = lp.user_id
+		LEFT JOIN match_rsvps r ON r.participant_id = lp.id AND r.match_id = $2
+		LEFT JOIN match_substitutions ms ON ms.replaced_participant_id = lp.id AND ms.match_id = $2
+		LEFT JOIN league_participants slp ON slp.id = ms.reserve_participant_id
+		LEFT JOIN users su ON su.id = slp.user_id
+		WHERE lp.league_id = $1 AND lp.status = 'approved' AND 'player' = ANY(lp.roles)
+		ORDER BY lp.team_name ASC NULLS LAST, u.nickname ASC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	drivers := []model.DriverAvailability{}
+	for rows.Next() {
+		var d model.DriverAvailability
+		if err := rows.Scan(
+			&d.ParticipantID,
+			&d.DriverName,
+			&d.TeamName,
+			&d.Status,
+			&d.Note,
+			&d.RespondedAt,
+			&d.ReserveName,
+		); err != nil {
+			return nil, err
+		}
+		drivers = append(drivers, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return drivers, nil
+}
+
+// ListReserves retrieves a league's approved reserve drivers in call-up order:
+// by their set priority, then by when they joined
+func (r *AttendanceRepository) ListReserves(ctx context.Context, leagueID uuid.UUID) ([]*model.LeagueParticipant, error) {
+	query := `
+		SELECT lp.id, lp.league_id, lp.user_id, lp.status, lp.roles, lp.team_name, lp.created_at, lp.updated_at, u.nickname
+		FROM league_participants lp
+		JOIN users u ON u.id = lp.user_id
+		WHERE lp.league_id = $1 AND lp.status = 'approved' AND 'reserve' = ANY(lp.roles)
+		ORDER BY lp.reserve_priority ASC NULLS LAST, lp.created_at ASC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reserves []*model.LeagueParticipant
+	for rows.Next() {
+		p := &model.LeagueParticipant{}
+		if err := rows.Scan(
+			&p.ID,
+			&p.LeagueID,
+			&p.UserID,
+			&p.Status,
+			&p.Roles,
+			&p.TeamName,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+			&p.UserNickname,
+		); err != nil {
+			return nil, err
+		}
+		reserves = append(reserves, p)
+	}
+
+	return reserves, rows.Err()
+}
+
+// SetReservePriority sets the call-up order of a league's reserves. Reserves left out fall back to joining order.
+func (r *AttendanceRepository) SetReservePriority(ctx context.Context, leagueID uuid.UUID, participantIDs []uuid.UUID) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE league_participants SET reserve_priority = NULL WHERE league_id = $1`, leagueID); err != nil {
+		return err
+	}
+
+	for i, id := range participantIDs {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE league_participants SET reserve_priority = $1
+			WHERE id = $2 AND league_id = $3 AND 'reserve' = ANY(roles)
+		`, i+1, id, leagueID)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrParticipantNotFound
+		}
+	}
+
+	return tx.Commit()
+}
+
+// CreateOffer offers an empty seat to a reserve
+func (r *AttendanceRepository) CreateOffer(ctx context.Context, offer *model.ReserveOffer) error {
+	query := `
+		INSERT INTO reserve_offers (match_id, reserve_participant_id, replaced_participant_id, team_name, status, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	err := r.db.Pool.QueryRowContext(ctx, query,
+		offer.MatchID,
+		offer.ReserveParticipantID,
+		offer.ReplacedParticipantID,
+		offer.TeamName,
+		offer.Status,
+		offer.ExpiresAt,
+	).Scan(&offer.ID, &offer.CreatedAt)
+
+	if err != nil {
+		if err.Error() == `pq: duplicate key value violates unique constraint "uq_reserve_offers_pending_seat"` {
+			return ErrSeatAlreadyOffered
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetOffer retrieves a seat offer by ID
+func (r *AttendanceRepository) GetOffer(ctx context.Context, id uuid.UUID) (*model.ReserveOffer, error) {
+	offer, err := scanReserveOffer(r.db.Pool.QueryRowContext(ctx, reserveOfferSelect+` WHERE ro.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReserveOfferNotFound
+		}
+		return nil, err
+	}
+	return offer, nil
+}
+
+// ListOffersByMatch retrieves every seat offer made for a match, oldest first
+func (r *AttendanceRepository) ListOffersByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.ReserveOffer, error) {
+	return r.listOffers(ctx, reserveOfferSelect+` WHERE ro.match_id = $1 ORDER BY ro.created_at ASC`, matchID)
+}
+
+// ListPendingOffersByUser retrieves the seat offers awaiting a user's answer, soonest to expire first
+func (r *AttendanceRepository) ListPendingOffersByUser(ctx context.Context, userID uuid.UUID) ([]*model.ReserveOffer, error) {
+	return r.listOffers(ctx, reserveOfferSelect+`
+		WHERE rlp.user_id = $1 AND ro.status = 'pending' AND ro.expires_at > NOW()
+		ORDER BY ro.expires_at ASC
+	`, userID)
+}
+
+func (r *AttendanceRepository) listOffers(ctx context.Context, query string, args ...any) ([]*model.ReserveOffer, error) {
+	rows, err := r.db.Pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	offers := []*model.ReserveOffer{}
+	for rows.Next() {
+		offer, err := scanReserveOffer(rows)
+		if err != nil {
+			return nil, err
+		}
+		offers = append(offers, offer)
+	}
+
+	return offers, rows.Err()
+}
+
+// ResolveOffer moves a pending offer to its final status
+func (r *AttendanceRepository) ResolveOffer(ctx context.Context, id uuid.UUID, status model.ReserveOfferStatus) error {
+	result, err := r.db.Pool.ExecContext(ctx, `
+		UPDATE reserve_offers SET status = $1, responded_at = NOW()
+		WHERE id = $2 AND status = 'pending'
+	`, status, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrReserveOfferNotPending
+	}
+
+	return nil
+}
+
+// AcceptOffer accepts a pending offer and records the reserve's substitution in one step
+func (r *AttendanceRepository) AcceptOffer(ctx context.Context, offer *model.ReserveOffer, sub *model.Substitution) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE reserve_offers SET status = 'accepted', responded_at = NOW()
+		WHERE id = $1 AND status = 'pending' AND expires_at > NOW()
+	`, offer.ID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrReserveOfferNotPending
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO match_substitutions (match_id, reserve_participant_id, replaced_participant_id, team_name, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`, sub.MatchID, sub.ReserveParticipantID, sub.ReplacedParticipantID, sub.TeamName, sub.CreatedBy).Scan(&sub.ID, &sub.CreatedAt)
+	if err != nil {
+		switch err.Error() {
+		case `pq: duplicate key value violates unique constraint "uq_match_substitutions_reserve"`:
+			return ErrReserveAlreadyUsed
+		case `pq: duplicate key value violates unique constraint "uq_match_substitutions_replaced"`:
+			return ErrDriverAlreadyReplaced
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// WithdrawOffers withdraws the pending offer for a driver's seat once the driver can race after all
+func (r *AttendanceRepository) WithdrawOffers(ctx context.Context, matchID, replacedParticipantID uuid.UUID) error {
+	_, err := r.db.Pool.ExecContext(ctx, `
+		UPDATE reserve_offers SET status = 'withdrawn', responded_at = NOW()
+		WHERE match_id = $1 AND replaced_participant_id = $2 AND status = 'pending'
+	`, matchID, replacedParticipantID)
+	return err
+}
+
+// ExpireOffers marks every pending offer past its expiry as expired and returns them
+func (r *AttendanceRepository) ExpireOffers(ctx context.Context) ([]*model.ReserveOffer, error) {
+	rows, err := r.db.Pool.QueryContext(ctx, `
+		UPDATE reserve_offers SET status = 'expired', responded_at = NOW()
+		WHERE status = 'pending' AND expires_at <= NOW()
+		RETURNING id, match_id, reserve_participant_id, replaced_participant_id, team_name, status, expires_at, responded_at, created_at
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var offers []*model.ReserveOffer
+	for rows.Next() {
+		o := &model.ReserveOffer{}
+		if err := rows.Scan(
+			&o.ID,
+			&o.MatchID,
+			&o.ReserveParticipantID,
+			&o.ReplacedParticipantID,
+			&o.TeamName,
+			&o.Status,
+			&o.ExpiresAt,
+			&o.RespondedAt,
+			&o.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		offers = append(offers, o)
+	}
+
+	return offers, rows.Err()
+}
+
+// CloseDueRSVPs closes RSVPs for upcoming matches whose deadline has passed and gives every driver
+// who did not answer the league's missed-RSVP strikes. It returns how many drivers received strikes.
+func (r *AttendanceRepository) CloseDueRSVPs(ctx context.Context, defaultDeadlineHours, defaultStrikes int) (int64, error) {
+	query := `
+		WITH due AS (
+			UPDATE matches m
+			SET rsvp_closed_at = NOW()
+			FROM leagues l
+			LEFT JOIN attendance_settings s ON s.league_id = l.id
+			WHERE l.id = m.league_id
+			  AND m.rsvp_closed_at IS NULL
+			  AND m.status = 'upcoming'
+			  AND m.starts_at - make_interval(hours => COALESCE(s.rsvp_deadline_hours, $1)) <= NOW()
+			RETURNING m.id, m.league_id, COALESCE(s.missed_rsvp_strikes, $2) AS strikes
+		)
+		INSERT INTO attendance_strikes (participant_id, match_id, strikes, reason)
+		SELECT lp.id, due.id, due.strikes, 'missed_rsvp'
+		FROM due
+		JOIN league_participants lp ON lp.league_id = due.league_id AND lp.status = 'approved' AND 'player' = ANY(lp.roles)
+		WHERE due.strikes > 0
+		  AND NOT EXISTS (SELECT 1 FROM match_rsvps r WHERE r.match_id = due.id AND r.participant_id = lp.id)
+		ON CONFLICT (participant_id, match_id) DO NOTHING
+	`
+
+	result, err := r.db.Pool.ExecContext(ctx, query, defaultDeadlineHours, defaultStrikes)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// ListStrikesByLeague retrieves the attendance strikes given in a league, most recent round first
+func (r *AttendanceRepository) ListStrikesByLeague(ctx context.Context, leagueID uuid.UUID) ([]*model.AttendanceStrike, error) {
+	query := `
+		SELECT ast.id, ast.participant_id, ast.match_id, ast.strikes, ast.reason, ast.created_at, m.round, u.nickname
+		FROM attendance_strikes ast
+		JOIN matches m ON m.id = ast.match_id
+		JOIN league_participants lp ON lp.id = ast.participant_id
+		JOIN users u ON u.id = lp.user_id
+		WHERE m.league_id = $1
+		ORDER BY m.round DESC, u.nickname ASC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	strikes := []*model.AttendanceStrike{}
+	for rows.Next() {
+		s := &model.AttendanceStrike{}
+		if err := rows.Scan(
+			&s.ID,
+			&s.ParticipantID,
+			&s.MatchID,
+			&s.Strikes,
+			&s.Reason,
+			&s.CreatedAt,
+			&s.Round,
+			&s.DriverName,
+		); err != nil {
+			return nil, err
+		}
+		strikes = append(strikes, s)
+	}
+
+	return strikes, rows.Err()
+}
+
+func scanReserveOffer(row interface{ Scan(...any) error }) (*model.ReserveOffer, error) {
+	o := &model.ReserveOffer{}
+	err := row.Scan(
+		&o.ID,
+		&o.MatchID,
+		&o.ReserveParticipantID,
+		&o.ReplacedParticipantID,
+		&o.TeamName,
+		&o.Status,
+		&o.ExpiresAt,
+		&o.RespondedAt,
+		&o.CreatedAt,
+		&o.Round,
+		&o.Track,
+		&o.ReserveName,
+		&o.ReplacedName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
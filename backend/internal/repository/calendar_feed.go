@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/google/uuid"
+)
+
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+type CalendarFeedRepository struct {
+	db *database.DB
+}
+
+func NewCalendarFeedRepository(db *database.DB) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db: db}
+}
+
+// GetToken retrieves a user's personal feed token
+func (r *CalendarFeedRepository) GetToken(ctx context.Context, userID uuid.UUID) (string, error) {
+	var token string
+	err := r.db.Pool.QueryRowContext(ctx, `SELECT token FROM calendar_feed_tokens WHERE user_id = $1`, userID).Scan(&token)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrCalendarFeedNotFound
+		}
+		return "", err
+	}
+	return token, nil
+}
+
+// SetToken stores a user's personal feed token, replacing any previous one
+func (r *CalendarFeedRepository) SetToken(ctx context.Context, userID uuid.UUID, token string) error {
+	query := `
+		INSERT INTO calendar_feed_tokens (user_id, token)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET token = EXCLUDED.token, created_at = NOW()
+	`
+	_, err := r.db.Pool.ExecContext(ctx, query, userID, token)
+	return err
+}
+
+// GetUserID retrieves the user a personal feed token belongs to
+func (r *CalendarFeedRepository) GetUserID(ctx context.Context, token string) (uuid.UUID, error) {
+	var userID uuid.UUID
+	err := r.db.Pool.QueryRowContext(ctx, `SELECT user_id FROM calendar_feed_tokens WHERE token = $1`, token).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, ErrCalendarFeedNotFound
+		}
+		return uuid.Nil, err
+	}
+	return userID, nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type CareerRepository struct {
+	db *database.DB
+}
+
+func NewCareerRepository(db *database.DB) *CareerRepository {
+	return &CareerRepository{db: db}
+}
+
+// ListLeagueStats aggregates a user's session results per league they took part in.
+// A zero season or empty status leaves that filter off.
+func (r *CareerRepository) ListLeagueStats(ctx context.Context, userID uuid.UUID, season int, status string) ([]model.CareerLeague, error) {
+	query := `
+		SELECT l.id, l.name, l.season, l.status, lp.id, lp.team_name,
+		       COUNT(sr.id) FILTER (WHERE s.type <> 'sprint') AS starts,
+		       COUNT(sr.id) FILTER (WHERE s.type <> 'sprint' AND sr.position = 1 AND NOT sr.dnf AND NOT sr.disqualified) AS wins,
+		       COUNT(sr.id) FILTER (WHERE s.type <> 'sprint' AND sr.position <= 3 AND NOT sr.dnf AND NOT sr.disqualified) AS podiums,
+		       COUNT(sr.id) FILTER (WHERE s.type <> 'sprint' AND sr.fastest_lap) AS fastest_laps,
+		       COUNT(sr.id) FILTER (WHERE s.type <> 'sprint' AND sr.dnf) AS dnfs,
+		       COUNT(sr.id) FILTER (WHERE s.type <> 'sprint' AND sr.position IS NOT NULL AND NOT sr.dnf AND NOT sr.disqualified) AS finishes,
+		       COALESCE(SUM(sr.position) FILTER (WHERE s.type <> 'sprint' AND NOT sr.dnf AND NOT sr.disqualified), 0) AS finish_sum,
+		       MIN(sr.position) FILTER (WHERE s.type <> 'sprint' AND NOT sr.dnf AND NOT sr.disqualified) AS best_finish,
+		       COUNT(sr.id) FILTER (WHERE s.type = 'sprint') AS sprint_starts,
+		       COUNT(sr.id) FILTER (WHERE s.type = 'sprint' AND sr.position = 1 AND NOT sr.disqualified) AS sprint_wins,
+		       COALESCE(SUM(sr.points), 0) AS points,
+		       (SELECT COUNT(*) FROM qualifying_results qr
+		        WHERE qr.participant_id = lp.id AND qr.session = 'qualifying' AND qr.position = 1) AS poles
+		FROM league_participants lp
+		JOIN leagues l ON l.id = lp.league_id
+		LEFT JOIN (session_results sr JOIN match_sessions s ON s.id = sr.session_id AND s.type = ANY($4))
+		       ON sr.participant_id = lp.id
+		WHERE lp.user_id = $1
+		  AND ($2 = 0 OR l.season = $2)
+		  AND ($3 = '' OR l.status = $3)
+		  AND (lp.status = 'approved' OR EXISTS (SELECT 1 FROM session_results x WHERE x.participant_id = lp.id))
+		GROUP BY l.id, lp.id
+		ORDER BY l.season DESC, l.name
+	`
+
+	types := make([]string, len(model.PointsSessionTypes))
+	for i, t := range model.PointsSessionTypes {
+		types[i] = string(t)
+	}
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, userID, season, status, pq.Array(types))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var leagues []model.CareerLeague
+	for rows.Next() {
+		var l model.CareerLeague
+		if err := rows.Scan(
+			&l.LeagueID,
+			&l.LeagueName,
+			&l.Season,
+			&l.Status,
+			&l.ParticipantID,
+			&l.TeamName,
+			&l.Starts,
+			&l.Wins,
+			&l.Podiums,
+			&l.FastestLaps,
+			&l.DNFs,
+			&l.Finishes,
+			&l.FinishSum,
+			&l.BestFinish,
+			&l.SprintStarts,
+			&l.SprintWins,
+			&l.Points,
+			&l.Poles,
+		); err != nil {
+			return nil, err
+		}
+		leagues = append(leagues, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return leagues, nil
+}
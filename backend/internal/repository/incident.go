@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrIncidentReportNotFound = errors.New("incident report not found")
+)
+
+// incidentReportSelect is shared by every incident report query; callers append WHERE/ORDER clauses
+const incidentReportSelect = `
+	SELECT ir.id, ir.match_id, ir.session, ir.reporter_id, ir.accused_id, ir.lap, ir.description, ir.evidence_links,
+	       ir.status, ir.decision, ir.decision_reason, ir.penalty_id, ir.reviewed_by, ir.decided_at, ir.published_at,
+	       ir.created_at, ir.updated_at,
+	       m.league_id, m.round, ru.nickname as reporter_name, au.nickname as accused_name, rev.nickname as reviewer_name
+	FROM incident_reports ir
+	JOIN matches m ON ir.match_id = m.id
+	JOIN league_participants rp ON ir.reporter_id = rp.id
+	JOIN users ru ON rp.user_id = ru.id
+	JOIN league_participants ap ON ir.accused_id = ap.id
+	JOIN users au ON ap.user_id = au.id
+	LEFT JOIN users rev ON ir.reviewed_by = rev.id
+`
+
+type IncidentRepository struct {
+	db *database.DB
+}
+
+func NewIncidentRepository(db *database.DB) *IncidentRepository {
+	return &IncidentRepository{db: db}
+}
+
+// Create creates a new incident report
+func (r *IncidentRepository) Create(ctx context.Context, report *model.IncidentReport) error {
+	query := `
+		INSERT INTO incident_reports (match_id, session, reporter_id, accused_id, lap, description, evidence_links, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at
+	`
+
+	return r.db.Pool.QueryRowContext(ctx, query,
+		report.MatchID,
+		report.Session,
+		report.ReporterID,
+		report.AccusedID,
+		report.Lap,
+		report.Description,
+		report.EvidenceLinks,
+		report.Status,
+	).Scan(&report.ID, &report.CreatedAt, &report.UpdatedAt)
+}
+
+// GetByID retrieves an incident report by ID
+func (r *IncidentRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.IncidentReport, error) {
+	reports, err := r.query(ctx, incidentReportSelect+` WHERE ir.id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, ErrIncidentReportNotFound
+	}
+	return reports[0], nil
+}
+
+// ListByLeague retrieves all incident reports for a league, optionally filtered by status
+func (r *IncidentRepository) ListByLeague(ctx context.Context, leagueID uuid.UUID, status string) ([]*model.IncidentReport, error) {
+	return r.query(ctx, incidentReportSelect+`
+		WHERE m.league_id = $1 AND ($2 = '' OR ir.status = $2)
+		ORDER BY ir.created_at DESC
+	`, leagueID, status)
+}
+
+// ListByParticipant retrieves incident reports filed by or against a participant
+func (r *IncidentRepository) ListByParticipant(ctx context.Context, participantID uuid.UUID) ([]*model.IncidentReport, error) {
+	return r.query(ctx, incidentReportSelect+`
+		WHERE ir.reporter_id = $1 OR ir.accused_id = $1
+		ORDER BY ir.created_at DESC
+	`, participantID)
+}
+
+// ListPublishedByLeague retrieves incident reports whose decisions have been published
+func (r *IncidentRepository) ListPublishedByLeague(ctx context.Context, leagueID uuid.UUID) ([]*model.IncidentReport, error) {
+	return r.query(ctx, incidentReportSelect+`
+		WHERE m.league_id = $1 AND ir.status = 'published'
+		ORDER BY m.round DESC, ir.published_at DESC
+	`, leagueID)
+}
+
+// UpdateStatus updates the status of an incident report
+func (r *IncidentRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status model.IncidentStatus) error {
+	query := `
+		UPDATE incident_reports
+		SET status = $1,
+		    published_at = CASE WHEN $1 = 'published' THEN NOW() ELSE published_at END,
+		    updated_at = NOW()
+		WHERE id = $2
+	`
+
+	return r.exec(ctx, query, status, id)
+}
+
+// StartReview marks an incident report as under review by a steward
+func (r *IncidentRepository) StartReview(ctx context.Context, id, reviewedBy uuid.UUID) error {
+	query := `
+		UPDATE incident_reports
+		SET status = 'under_review', reviewed_by = $1, updated_at = NOW()
+		WHERE id = $2
+	`
+
+	return r.exec(ctx, query, reviewedBy, id)
+}
+
+// Decide records the stewards' decision on an incident report
+func (r *IncidentRepository) Decide(ctx context.Context, id uuid.UUID, decision model.IncidentDecision, reason string, penaltyID *uuid.UUID, reviewedBy uuid.UUID) error {
+	query := `
+		UPDATE incident_reports
+		SET status = 'decided', decision = $1, decision_reason = $2, penalty_id = $3,
+		    reviewed_by = $4, decided_at = NOW(), updated_at = NOW()
+		WHERE id = $5
+	`
+
+	return r.exec(ctx, query, decision, reason, penaltyID, reviewedBy, id)
+}
+
+func (r *IncidentRepository) exec(ctx context.Context, query string, args ...any) error {
+	result, err := r.db.Pool.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrIncidentReportNotFound
+	}
+
+	return nil
+}
+
+func (r *IncidentRepository) query(ctx context.Context, query string, args ...any) ([]*model.IncidentReport, error) {
+	rows, err := r.db.Pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []*model.IncidentReport
+	for rows.Next() {
+		report := &model.IncidentReport{}
+		if err := rows.Scan(
+			&report.ID,
+			&report.MatchID,
+			&report.Session,
+			&report.ReporterID,
+			&report.AccusedID,
+			&report.Lap,
+			&report.Description,
+			&report.EvidenceLinks,
+			&report.Status,
+			&report.Decision,
+			&report.DecisionReason,
+			&report.PenaltyID,
+			&report.ReviewedBy,
+			&report.DecidedAt,
+			&report.PublishedAt,
+			&report.CreatedAt,
+			&report.UpdatedAt,
+			&report.LeagueID,
+			&report.Round,
+			&report.ReporterName,
+			&report.AccusedName,
+			&report.ReviewerName,
+		); err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
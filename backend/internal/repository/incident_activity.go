@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+type IncidentActivityRepository struct {
+	db *database.DB
+}
+
+func NewIncidentActivityRepository(db *database.DB) *IncidentActivityRepository {
+	return &IncidentActivityRepository{db: db}
+}
+
+// Create inserts a new activity log entry
+func (r *IncidentActivityRepository) Create(ctx context.Context, log *model.IncidentActivityLog) error {
+	detailsJSON, err := json.Marshal(log.Details)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO incident_activity_log (actor_id, report_id, action_type, details)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	return r.db.Pool.QueryRowContext(ctx, query,
+		log.ActorID,
+		log.ReportID,
+		log.ActionType,
+		detailsJSON,
+	).Scan(&log.ID, &log.CreatedAt)
+}
+
+// ListByReport retrieves all activity logs for a specific incident report
+func (r *IncidentActivityRepository) ListByReport(ctx context.Context, reportID uuid.UUID) ([]*model.IncidentActivityLog, error) {
+	query := `
+		SELECT
+			al.id, al.actor_id, al.report_id, al.action_type, al.details, al.created_at,
+			actor.nickname as actor_nickname
+		FROM incident_activity_log al
+		JOIN users actor ON al.actor_id = actor.id
+		WHERE al.report_id = $1
+		ORDER BY al.created_at DESC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, reportID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var logs []*model.IncidentActivityLog
+	for rows.Next() {
+		log := &model.IncidentActivityLog{}
+		var detailsJSON []byte
+		if err := rows.Scan(
+			&log.ID,
+			&log.ActorID,
+			&log.ReportID,
+			&log.ActionType,
+			&detailsJSON,
+			&log.CreatedAt,
+			&log.ActorNickname,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(detailsJSON, &log.Details); err != nil {
+			log.Details = make(map[string]any)
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/f1-rivals-cup/backend/internal/database"
@@ -27,13 +29,18 @@ func NewLeagueRepository(db *database.DB) *LeagueRepository {
 
 // Create creates a new league
 func (r *LeagueRepository) Create(ctx context.Context, league *model.League) error {
+	settingsJSON, err := marshalLeagueSettings(league.Settings)
+	if err != nil {
+		return err
+	}
+
 	query := `
-		INSERT INTO leagues (name, description, status, season, created_by, start_date, end_date, match_time, rules, settings, contact_info)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO leagues (name, description, status, season, created_by, start_date, end_date, match_time, timezone, rules, settings, settings_note, contact_info)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.db.Pool.QueryRowContext(ctx, query,
+	err = r.db.Pool.QueryRowContext(ctx, query,
 		league.Name,
 		league.Description,
 		league.Status,
@@ -42,8 +49,10 @@ func (r *LeagueRepository) Create(ctx context.Context, league *model.League) err
 		league.StartDate,
 		league.EndDate,
 		league.MatchTime,
+		league.Timezone,
 		league.Rules,
-		league.Settings,
+		settingsJSON,
+		league.SettingsNote,
 		league.ContactInfo,
 	).Scan(&league.ID, &league.CreatedAt, &league.UpdatedAt)
 
@@ -53,12 +62,13 @@ func (r *LeagueRepository) Create(ctx context.Context, league *model.League) err
 // GetByID retrieves a league by ID
 func (r *LeagueRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.League, error) {
 	query := `
-		SELECT id, name, description, status, season, created_by, start_date, end_date, match_time::text, rules, settings, contact_info, created_at, updated_at
+		SELECT id, name, description, status, season, created_by, start_date, end_date, match_time::text, timezone, rules, settings, settings_note, contact_info, created_at, updated_at
 		FROM leagues
 		WHERE id = $1
 	`
 
 	league := &model.League{}
+	var settingsJSON []byte
 	err := r.db.Pool.QueryRowContext(ctx, query, id).Scan(
 		&league.ID,
 		&league.Name,
@@ -69,8 +79,10 @@ func (r *LeagueRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Le
 		&league.StartDate,
 		&league.EndDate,
 		&league.MatchTime,
+		&league.Timezone,
 		&league.Rules,
-		&league.Settings,
+		&settingsJSON,
+		&league.SettingsNote,
 		&league.ContactInfo,
 		&league.CreatedAt,
 		&league.UpdatedAt,
@@ -83,6 +95,10 @@ func (r *LeagueRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Le
 		return nil, err
 	}
 
+	if league.Settings, err = unmarshalLeagueSettings(settingsJSON); err != nil {
+		return nil, err
+	}
+
 	return league, nil
 }
 
@@ -99,7 +115,7 @@ func (r *LeagueRepository) List(ctx context.Context, page, limit int, status str
 
 	// Get leagues
 	query := `
-		SELECT id, name, description, status, season, created_by, start_date, end_date, match_time::text, rules, settings, contact_info, created_at, updated_at
+		SELECT id, name, description, status, season, created_by, start_date, end_date, match_time::text, timezone, rules, settings, settings_note, contact_info, created_at, updated_at
 		FROM leagues
 		WHERE ($1 = '' OR status = $1)
 		ORDER BY created_at DESC
@@ -115,6 +131,7 @@ func (r *LeagueRepository) List(ctx context.Context, page, limit int, status str
 	var leagues []*model.League
 	for rows.Next() {
 		league := &model.League{}
+		var settingsJSON []byte
 		if err := rows.Scan(
 			&league.ID,
 			&league.Name,
@@ -125,14 +142,21 @@ func (r *LeagueRepository) List(ctx context.Context, page, limit int, status str
 			&league.StartDate,
 			&league.EndDate,
 			&league.MatchTime,
+			&league.Timezone,
 			&league.Rules,
-			&league.Settings,
+			&settingsJSON,
+			&league.SettingsNote,
 			&league.ContactInfo,
 			&league.CreatedAt,
 			&league.UpdatedAt,
 		); err != nil {
 			return nil, 0, err
 		}
+		settings, err := unmarshalLeagueSettings(settingsJSON)
+		if err != nil {
+			return nil, 0, err
+		}
+		league.Settings = settings
 		leagues = append(leagues, league)
 	}
 
@@ -143,15 +167,28 @@ func (r *LeagueRepository) List(ctx context.Context, page, limit int, status str
 	return leagues, total, nil
 }
 
-// Update updates a league
+// Update updates a league. Match and session start instants are recomputed so the league-local
+// schedule stays on the same wall-clock times when the timezone changes.
 func (r *LeagueRepository) Update(ctx context.Context, league *model.League) error {
+	settingsJSON, err := marshalLeagueSettings(league.Settings)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
 		UPDATE leagues
-		SET name = $1, description = $2, status = $3, season = $4, start_date = $5, end_date = $6, match_time = $7, rules = $8, settings = $9, contact_info = $10, updated_at = NOW()
-		WHERE id = $11
+		SET name = $1, description = $2, status = $3, season = $4, start_date = $5, end_date = $6, match_time = $7, timezone = $8,
+		    rules = $9, settings = $10, settings_note = $11, contact_info = $12, updated_at = NOW()
+		WHERE id = $13
 	`
 
-	result, err := r.db.Pool.ExecContext(ctx, query,
+	result, err := tx.ExecContext(ctx, query,
 		league.Name,
 		league.Description,
 		league.Status,
@@ -159,8 +196,10 @@ func (r *LeagueRepository) Update(ctx context.Context, league *model.League) err
 		league.StartDate,
 		league.EndDate,
 		league.MatchTime,
+		league.Timezone,
 		league.Rules,
-		league.Settings,
+		settingsJSON,
+		league.SettingsNote,
 		league.ContactInfo,
 		league.ID,
 	)
@@ -176,7 +215,26 @@ func (r *LeagueRepository) Update(ctx context.Context, league *model.League) err
 		return ErrLeagueNotFound
 	}
 
-	return nil
+	query = `
+		UPDATE matches
+		SET starts_at = (match_date + COALESCE(match_time, TIME '00:00')) AT TIME ZONE $1
+		WHERE league_id = $2
+	`
+	if _, err := tx.ExecContext(ctx, query, league.Timezone, league.ID); err != nil {
+		return err
+	}
+
+	query = `
+		UPDATE match_sessions s
+		SET starts_at = (s.session_date + COALESCE(s.session_time, TIME '00:00')) AT TIME ZONE $1
+		FROM matches m
+		WHERE m.id = s.match_id AND m.league_id = $2
+	`
+	if _, err := tx.ExecContext(ctx, query, league.Timezone, league.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // Delete deletes a league
@@ -222,3 +280,66 @@ func ParseTime(s string) (*time.Time, error) {
 	}
 	return &t, nil
 }
+
+var timezoneCache sync.Map
+
+// inTimezone presents a timestamp in the given IANA timezone, leaving it as scanned when the zone is unknown
+func inTimezone(t *time.Time, name string) {
+	if t == nil || name == "" {
+		return
+	}
+
+	if loc, ok := timezoneCache.Load(name); ok {
+		*t = t.In(loc.(*time.Location))
+		return
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return
+	}
+	timezoneCache.Store(name, loc)
+	*t = t.In(loc)
+}
+
+// updateLeagueSettingsTx replaces only the settings document of a league
+func updateLeagueSettingsTx(ctx context.Context, tx *sql.Tx, leagueID uuid.UUID, settings *model.LeagueSettings) error {
+	settingsJSON, err := marshalLeagueSettings(settings)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE leagues SET settings = $1, updated_at = NOW() WHERE id = $2`, settingsJSON, leagueID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrLeagueNotFound
+	}
+
+	return nil
+}
+
+// marshalLeagueSettings encodes a settings document for the JSONB column, keeping NULL when there is none
+func marshalLeagueSettings(settings *model.LeagueSettings) ([]byte, error) {
+	if settings == nil {
+		return nil, nil
+	}
+	return json.Marshal(settings)
+}
+
+func unmarshalLeagueSettings(raw []byte) (*model.LeagueSettings, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	settings := &model.LeagueSettings{}
+	if err := json.Unmarshal(raw, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
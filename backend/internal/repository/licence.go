@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrLicenceSettingsNotFound = errors.New("licence settings not found")
+	ErrRaceBanNotFound         = errors.New("race ban not found")
+	ErrRaceBanExists           = errors.New("race ban already exists")
+)
+
+const licencePointSelect = `
+	SELECT lpt.id, lpt.participant_id, lpt.match_id, lpt.points, lpt.reason, lpt.incident_report_id,
+	       lpt.issued_by, lpt.ban_id, lpt.created_at, m.round, pu.nickname, iu.nickname
+	FROM licence_points lpt
+	JOIN matches m ON lpt.match_id = m.id
+	JOIN league_participants lp ON lpt.participant_id = lp.id
+	JOIN users pu ON lp.user_id = pu.id
+	LEFT JOIN users iu ON lpt.issued_by = iu.id
+`
+
+const raceBanSelect = `
+	SELECT rb.id, rb.participant_id, rb.match_id, rb.points_at_ban, rb.reason, rb.created_at,
+	       m.round, m.track, pu.nickname
+	FROM race_bans rb
+	JOIN matches m ON rb.match_id = m.id
+	JOIN league_participants lp ON rb.participant_id = lp.id
+	JOIN users pu ON lp.user_id = pu.id
+`
+
+type LicenceRepository struct {
+	db *database.DB
+}
+
+func NewLicenceRepository(db *database.DB) *LicenceRepository {
+	return &LicenceRepository{db: db}
+}
+
+// GetSettings retrieves the licence settings configured for a league
+func (r *LicenceRepository) GetSettings(ctx context.Context, leagueID uuid.UUID) (*model.LicenceSettings, error) {
+	query := `
+		SELECT id, league_id, ban_threshold, expiry_rounds, expiry_days, created_at, updated_at
+		FROM licence_settings
+		WHERE league_id = $1
+	`
+
+	s := &model.LicenceSettings{}
+	err := r.db.Pool.QueryRowContext(ctx, query, leagueID).Scan(
+		&s.ID,
+		&s.LeagueID,
+		&s.BanThreshold,
+		&s.ExpiryRounds,
+		&s.ExpiryDays,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrLicenceSettingsNotFound
+		}
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// UpsertSettings creates or replaces the licence settings for a league
+func (r *LicenceRepository) UpsertSettings(ctx context.Context, s *model.LicenceSettings) error {
+	query := `
+		INSERT INTO licence_settings (league_id, ban_threshold, expiry_rounds, expiry_days)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (league_id)
+		DO UPDATE SET
+			ban_threshold = EXCLUDED.ban_threshold,
+			expiry_rounds = EXCLUDED.expiry_rounds,
+			expiry_days = EXCLUDED.expiry_days,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	return r.db.Pool.QueryRowContext(ctx, query,
+		s.LeagueID,
+		s.BanThreshold,
+		s.ExpiryRounds,
+		s.ExpiryDays,
+	).Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt)
+}
+
+// CreatePoints inserts licence points for a participant
+func (r *LicenceRepository) CreatePoints(ctx context.Context, p *model.LicencePoint) error {
+	query := `
+		INSERT INTO licence_points (participant_id, match_id, points, reason, incident_report_id, issued_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	return r.db.Pool.QueryRowContext(ctx, query,
+		p.ParticipantID,
+		p.MatchID,
+		p.Points,
+		p.Reason,
+		p.IncidentReportID,
+		p.IssuedBy,
+	).Scan(&p.ID, &p.CreatedAt)
+}
+
+// ListPointsByLeague retrieves all licence points issued in a league, newest first
+func (r *LicenceRepository) ListPointsByLeague(ctx context.Context, leagueID uuid.UUID) ([]*model.LicencePoint, error) {
+	return r.listPoints(ctx, licencePointSelect+`
+		WHERE m.league_id = $1
+		ORDER BY lpt.created_at DESC
+	`, leagueID)
+}
+
+// ListPointsByParticipant retrieves a participant's licence points, newest first
+func (r *LicenceRepository) ListPointsByParticipant(ctx context.Context, participantID uuid.UUID) ([]*model.LicencePoint, error) {
+	return r.listPoints(ctx, licencePointSelect+`
+		WHERE lpt.participant_id = $1
+		ORDER BY lpt.created_at DESC
+	`, participantID)
+}
+
+func (r *LicenceRepository) listPoints(ctx context.Context, query string, args ...any) ([]*model.LicencePoint, error) {
+	rows, err := r.db.Pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []*model.LicencePoint
+	for rows.Next() {
+		p := &model.LicencePoint{}
+		if err := rows.Scan(
+			&p.ID,
+			&p.ParticipantID,
+			&p.MatchID,
+			&p.Points,
+			&p.Reason,
+			&p.IncidentReportID,
+			&p.IssuedBy,
+			&p.BanID,
+			&p.CreatedAt,
+			&p.Round,
+			&p.ParticipantName,
+			&p.IssuerName,
+		); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return points, nil
+}
+
+// CreateBan inserts a race ban and marks the points that triggered it as consumed in a single transaction
+func (r *LicenceRepository) CreateBan(ctx context.Context, ban *model.RaceBan, pointIDs []uuid.UUID) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO race_bans (participant_id, match_id, points_at_ban, reason)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (participant_id, match_id) DO NOTHING
+		RETURNING id, created_at
+	`
+
+	err = tx.QueryRowContext(ctx, query,
+		ban.ParticipantID,
+		ban.MatchID,
+		ban.PointsAtBan,
+		ban.Reason,
+	).Scan(&ban.ID, &ban.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRaceBanExists
+		}
+		return err
+	}
+
+	ids := make(pq.StringArray, len(pointIDs))
+	for i, id := range pointIDs {
+		ids[i] = id.String()
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE licence_points
+		SET ban_id = $1
+		WHERE id = ANY($2::uuid[])
+	`, ban.ID, ids); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetUpcomingBan retrieves the participant's ban for the earliest match that has not been completed yet
+func (r *LicenceRepository) GetUpcomingBan(ctx context.Context, participantID uuid.UUID) (*model.RaceBan, error) {
+	bans, err := r.listBans(ctx, raceBanSelect+`
+		WHERE rb.participant_id = $1
+		  AND m.status NOT IN ('completed', 'cancelled')
+		ORDER BY m.round ASC
+		LIMIT 1
+	`, participantID)
+	if err != nil {
+		return nil, err
+	}
+	if len(bans) == 0 {
+		return nil, ErrRaceBanNotFound
+	}
+	return bans[0], nil
+}
+
+// ListBansByLeague retrieves all race bans in a league, newest first
+func (r *LicenceRepository) ListBansByLeague(ctx context.Context, leagueID uuid.UUID) ([]*model.RaceBan, error) {
+	return r.listBans(ctx, raceBanSelect+`
+		WHERE m.league_id = $1
+		ORDER BY rb.created_at DESC
+	`, leagueID)
+}
+
+// ListBansByMatch retrieves the bans served at a match
+func (r *LicenceRepository) ListBansByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.RaceBan, error) {
+	return r.listBans(ctx, raceBanSelect+`
+		WHERE rb.match_id = $1
+		ORDER BY pu.nickname ASC
+	`, matchID)
+}
+
+func (r *LicenceRepository) listBans(ctx context.Context, query string, args ...any) ([]*model.RaceBan, error) {
+	rows, err := r.db.Pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bans []*model.RaceBan
+	for rows.Next() {
+		b := &model.RaceBan{}
+		if err := rows.Scan(
+			&b.ID,
+			&b.ParticipantID,
+			&b.MatchID,
+			&b.PointsAtBan,
+			&b.Reason,
+			&b.CreatedAt,
+			&b.Round,
+			&b.Track,
+			&b.ParticipantName,
+		); err != nil {
+			return nil, err
+		}
+		bans = append(bans, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return bans, nil
+}
@@ -13,8 +13,29 @@ import (
 var (
 	ErrMatchNotFound   = errors.New("match not found")
 	ErrDuplicateRound  = errors.New("round already exists for this league")
+	ErrRoundAlreadyPlayed = errors.New("a later round has already been played")
 )
 
+// matchColumns selects a match along with its sprint schedule, which is derived from the first sprint session
+const matchColumns = `
+	m.id, m.league_id, m.round, m.track, m.track_id, t.country_code, m.match_date, m.match_time::text, m.starts_at, l.timezone,
+	sp.id IS NOT NULL, sp.session_date::text, sp.session_time::text, sp.starts_at,
+	COALESCE(sp.status, 'upcoming'), m.status, m.description, m.schedule_revision, m.created_at, m.updated_at
+`
+
+const matchFrom = `
+	FROM matches m
+	JOIN leagues l ON l.id = m.league_id
+	LEFT JOIN tracks t ON t.id = m.track_id
+	LEFT JOIN LATERAL (
+		SELECT id, session_date, session_time, starts_at, status
+		FROM match_sessions
+		WHERE match_id = m.id AND type = 'sprint'
+		ORDER BY session_order ASC
+		LIMIT 1
+	) sp ON true
+`
+
 type MatchRepository struct {
 	db *database.DB
 }
@@ -23,24 +44,105 @@ func NewMatchRepository(db *database.DB) *MatchRepository {
 	return &MatchRepository{db: db}
 }
 
-// Create creates a new match
+// Create creates a new match together with its race session and, when requested, a sprint session
 func (r *MatchRepository) Create(ctx context.Context, match *model.Match) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := createMatchTx(ctx, tx, match); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CreateBatch creates several matches and their sessions at once; either all of them are saved or none
+func (r *MatchRepository) CreateBatch(ctx context.Context, matches []*model.Match) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, match := range matches {
+		if err := createMatchTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// InsertRound adds a match mid-season. Every round numbered from the new match's round onwards moves one round
+// later and, when shiftDays is not zero, has its date and sessions pushed back by that many days.
+func (r *MatchRepository) InsertRound(ctx context.Context, match *model.Match, shiftDays int) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var played bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM matches
+			WHERE league_id = $1 AND round >= $2 AND status IN ('in_progress', 'completed')
+		)
+	`, match.LeagueID, match.Round).Scan(&played)
+	if err != nil {
+		return err
+	}
+	if played {
+		return ErrRoundAlreadyPlayed
+	}
+
+	if shiftDays != 0 {
+		ids, err := matchIDsTx(ctx, tx, `SELECT id FROM matches WHERE league_id = $1 AND round >= $2`, match.LeagueID, match.Round)
+		if err != nil {
+			return err
+		}
+		if err := shiftScheduleTx(ctx, tx, ids, shiftDays); err != nil {
+			return err
+		}
+		if err := invalidateAttendanceTx(ctx, tx, ids); err != nil {
+			return err
+		}
+	}
+
+	// Rounds are moved through negative numbers first so the unique round constraint holds after every row
+	if _, err := tx.ExecContext(ctx, `UPDATE matches SET round = -round WHERE league_id = $1 AND round >= $2`, match.LeagueID, match.Round); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE matches SET round = 1 - round, updated_at = NOW() WHERE league_id = $1 AND round < 0`, match.LeagueID); err != nil {
+		return err
+	}
+
+	if err := createMatchTx(ctx, tx, match); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// createMatchTx inserts a match with its race session and, when requested, a sprint session
+func createMatchTx(ctx context.Context, tx *sql.Tx, match *model.Match) error {
 	query := `
-		INSERT INTO matches (league_id, round, track, match_date, match_time, has_sprint, sprint_date, sprint_time, sprint_status, status, description)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO matches (league_id, round, track, track_id, match_date, match_time, status, description, starts_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8,
+		        ($5::date + COALESCE($6::time, TIME '00:00')) AT TIME ZONE (SELECT timezone FROM leagues WHERE id = $1))
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.db.Pool.QueryRowContext(ctx, query,
+	err := tx.QueryRowContext(ctx, query,
 		match.LeagueID,
 		match.Round,
 		match.Track,
+		match.TrackID,
 		match.MatchDate,
 		match.MatchTime,
-		match.HasSprint,
-		match.SprintDate,
-		match.SprintTime,
-		match.SprintStatus,
 		match.Status,
 		match.Description,
 	).Scan(&match.ID, &match.CreatedAt, &match.UpdatedAt)
@@ -52,31 +154,53 @@ func (r *MatchRepository) Create(ctx context.Context, match *model.Match) error
 		return err
 	}
 
+	sessionQuery := `
+		INSERT INTO match_sessions (match_id, type, session_order, session_date, session_time, status, starts_at)
+		VALUES ($1, $2, $3, $4, $5, $6, ` + sessionStartsAt("$1", "$4", "$5") + `)
+	`
+
+	if match.HasSprint {
+		sprintDate := match.SprintDate
+		if sprintDate == nil {
+			sprintDate = &match.MatchDate
+		}
+		if _, err := tx.ExecContext(ctx, sessionQuery, match.ID, model.SessionTypeSprint, 1, sprintDate, match.SprintTime, match.SprintStatus); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, sessionQuery, match.ID, model.SessionTypeRace, 2, match.MatchDate, match.MatchTime, match.Status); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // GetByID retrieves a match by ID
 func (r *MatchRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Match, error) {
-	query := `
-		SELECT id, league_id, round, track, match_date, match_time::text, has_sprint, sprint_date::text, sprint_time::text, sprint_status, status, description, created_at, updated_at
-		FROM matches
-		WHERE id = $1
-	`
+	query := `SELECT ` + matchColumns + matchFrom + ` WHERE m.id = $1`
 
 	match := &model.Match{}
+	var countryCode sql.NullString
 	err := r.db.Pool.QueryRowContext(ctx, query, id).Scan(
 		&match.ID,
 		&match.LeagueID,
 		&match.Round,
 		&match.Track,
+		&match.TrackID,
+		&countryCode,
 		&match.MatchDate,
 		&match.MatchTime,
+		&match.StartsAt,
+		&match.Timezone,
 		&match.HasSprint,
 		&match.SprintDate,
 		&match.SprintTime,
+		&match.SprintStartsAt,
 		&match.SprintStatus,
 		&match.Status,
 		&match.Description,
+		&match.Revision,
 		&match.CreatedAt,
 		&match.UpdatedAt,
 	)
@@ -87,17 +211,18 @@ func (r *MatchRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Mat
 		}
 		return nil, err
 	}
+	match.TrackFlag = countryFlag(countryCode.String)
+	inTimezone(match.StartsAt, match.Timezone)
+	inTimezone(match.SprintStartsAt, match.Timezone)
 
 	return match, nil
 }
 
 // ListByLeague retrieves all matches for a league
 func (r *MatchRepository) ListByLeague(ctx context.Context, leagueID uuid.UUID) ([]*model.Match, error) {
-	query := `
-		SELECT id, league_id, round, track, match_date, match_time::text, has_sprint, sprint_date::text, sprint_time::text, sprint_status, status, description, created_at, updated_at
-		FROM matches
-		WHERE league_id = $1
-		ORDER BY round ASC
+	query := `SELECT ` + matchColumns + matchFrom + `
+		WHERE m.league_id = $1
+		ORDER BY m.round ASC
 	`
 
 	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID)
@@ -109,48 +234,84 @@ func (r *MatchRepository) ListByLeague(ctx context.Context, leagueID uuid.UUID)
 	var matches []*model.Match
 	for rows.Next() {
 		m := &model.Match{}
+		var countryCode sql.NullString
 		if err := rows.Scan(
 			&m.ID,
 			&m.LeagueID,
 			&m.Round,
 			&m.Track,
+			&m.TrackID,
+			&countryCode,
 			&m.MatchDate,
 			&m.MatchTime,
+			&m.StartsAt,
+			&m.Timezone,
 			&m.HasSprint,
 			&m.SprintDate,
 			&m.SprintTime,
+			&m.SprintStartsAt,
 			&m.SprintStatus,
 			&m.Status,
 			&m.Description,
+			&m.Revision,
 			&m.CreatedAt,
 			&m.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
+		m.TrackFlag = countryFlag(countryCode.String)
+		inTimezone(m.StartsAt, m.Timezone)
+		inTimezone(m.SprintStartsAt, m.Timezone)
 		matches = append(matches, m)
 	}
 
 	return matches, nil
 }
 
-// Update updates a match
+// ListIDsByStatus retrieves the IDs of every match in the given status
+func (r *MatchRepository) ListIDsByStatus(ctx context.Context, status model.MatchStatus) ([]uuid.UUID, error) {
+	rows, err := r.db.Pool.QueryContext(ctx, `SELECT id FROM matches WHERE status = $1 ORDER BY starts_at ASC`, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// Update updates a match and keeps its race and sprint sessions in sync with the legacy schedule fields
 func (r *MatchRepository) Update(ctx context.Context, match *model.Match) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
 		UPDATE matches
-		SET round = $1, track = $2, match_date = $3, match_time = $4, has_sprint = $5, sprint_date = $6, sprint_time = $7, sprint_status = $8, status = $9, description = $10, updated_at = NOW()
-		WHERE id = $11
+		SET round = $1, track = $2, track_id = $3, match_date = $4, match_time = $5, status = $6, description = $7,
+		    starts_at = ($4::date + COALESCE($5::time, TIME '00:00')) AT TIME ZONE (SELECT timezone FROM leagues WHERE id = matches.league_id),
+		    schedule_revision = schedule_revision + CASE WHEN match_date IS DISTINCT FROM $4::date OR match_time IS DISTINCT FROM $5::time THEN 1 ELSE 0 END,
+		    updated_at = NOW()
+		WHERE id = $8
 		RETURNING updated_at
 	`
 
-	err := r.db.Pool.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		match.Round,
 		match.Track,
+		match.TrackID,
 		match.MatchDate,
 		match.MatchTime,
-		match.HasSprint,
-		match.SprintDate,
-		match.SprintTime,
-		match.SprintStatus,
 		match.Status,
 		match.Description,
 		match.ID,
@@ -166,7 +327,59 @@ func (r *MatchRepository) Update(ctx context.Context, match *model.Match) error
 		return err
 	}
 
-	return nil
+	// A postponed weekend keeps its race session upcoming; the scheduler skips it until the match is rescheduled
+	raceStatus := match.Status
+	if raceStatus == model.MatchStatusPostponed {
+		raceStatus = model.MatchStatusUpcoming
+	}
+	raceQuery := `
+		UPDATE match_sessions
+		SET session_date = $1, session_time = $2, status = $3, starts_at = ` + sessionStartsAt("$4", "$1", "$2") + `, updated_at = NOW()
+		WHERE match_id = $4 AND type = 'race'
+	`
+	if _, err := tx.ExecContext(ctx, raceQuery, match.MatchDate, match.MatchTime, raceStatus, match.ID); err != nil {
+		return err
+	}
+
+	if !match.HasSprint {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM match_sessions WHERE match_id = $1 AND type = 'sprint'`, match.ID); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	sprintDate := match.SprintDate
+	if sprintDate == nil {
+		sprintDate = &match.MatchDate
+	}
+
+	sprintQuery := `
+		UPDATE match_sessions
+		SET session_date = $1, session_time = $2, status = $3, starts_at = ` + sessionStartsAt("$4", "$1", "$2") + `, updated_at = NOW()
+		WHERE match_id = $4 AND type = 'sprint'
+	`
+	result, err := tx.ExecContext(ctx, sprintQuery, sprintDate, match.SprintTime, match.SprintStatus, match.ID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		// A newly added sprint runs ahead of every existing session
+		insertQuery := `
+			INSERT INTO match_sessions (match_id, type, session_order, session_date, session_time, status, starts_at)
+			VALUES ($1, 'sprint', (SELECT COALESCE(MIN(session_order), 1) - 1 FROM match_sessions WHERE match_id = $1), $2, $3, $4,
+			        ` + sessionStartsAt("$1", "$2", "$3") + `)
+		`
+		if _, err := tx.ExecContext(ctx, insertQuery, match.ID, sprintDate, match.SprintTime, match.SprintStatus); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
 // Delete removes a match
@@ -213,12 +426,12 @@ func (r *MatchRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status
 	return nil
 }
 
-// UpdateSprintStatus updates only the sprint status of a match
+// UpdateSprintStatus updates only the status of a match's sprint session
 func (r *MatchRepository) UpdateSprintStatus(ctx context.Context, id uuid.UUID, sprintStatus model.MatchStatus) error {
 	query := `
-		UPDATE matches
-		SET sprint_status = $1, updated_at = NOW()
-		WHERE id = $2
+		UPDATE match_sessions
+		SET status = $1, updated_at = NOW()
+		WHERE match_id = $2 AND type = 'sprint'
 	`
 
 	result, err := r.db.Pool.ExecContext(ctx, query, sprintStatus, id)
@@ -236,49 +449,3 @@ func (r *MatchRepository) UpdateSprintStatus(ctx context.Context, id uuid.UUID,
 
 	return nil
 }
-
-// ListUpcomingMatches retrieves all matches with upcoming status
-func (r *MatchRepository) ListUpcomingMatches(ctx context.Context) ([]*model.Match, error) {
-	query := `
-		SELECT id, league_id, round, track, match_date, match_time::text, has_sprint, sprint_date::text, sprint_time::text, sprint_status, status, description, created_at, updated_at
-		FROM matches
-		WHERE status = $1
-		ORDER BY match_date ASC, match_time ASC
-	`
-
-	rows, err := r.db.Pool.QueryContext(ctx, query, model.MatchStatusUpcoming)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var matches []*model.Match
-	for rows.Next() {
-		m := &model.Match{}
-		if err := rows.Scan(
-			&m.ID,
-			&m.LeagueID,
-			&m.Round,
-			&m.Track,
-			&m.MatchDate,
-			&m.MatchTime,
-			&m.HasSprint,
-			&m.SprintDate,
-			&m.SprintTime,
-			&m.SprintStatus,
-			&m.Status,
-			&m.Description,
-			&m.CreatedAt,
-			&m.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		matches = append(matches, m)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return matches, nil
-}
@@ -0,0 +1,333 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type MatchRescheduleRepository struct {
+	db *database.DB
+}
+
+func NewMatchRescheduleRepository(db *database.DB) *MatchRescheduleRepository {
+	return &MatchRescheduleRepository{db: db}
+}
+
+// Postpone marks a match as postponed, records why and clears the RSVPs collected for the old date
+func (r *MatchRescheduleRepository) Postpone(ctx context.Context, match *model.Match, entry *model.MatchReschedule) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE matches
+		SET status = $1, schedule_revision = schedule_revision + 1, updated_at = NOW()
+		WHERE id = $2
+	`, model.MatchStatusPostponed, match.ID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrMatchNotFound
+	}
+
+	if err := createRescheduleTx(ctx, tx, entry); err != nil {
+		return err
+	}
+	if err := invalidateAttendanceTx(ctx, tx, []uuid.UUID{match.ID}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Reschedule moves a match to its new date and time, which must already be set on match, and reopens it.
+// Its other sessions move by days. With cascade, every later upcoming or postponed round is pushed back
+// by days as well; the IDs of those rounds are returned.
+func (r *MatchRescheduleRepository) Reschedule(ctx context.Context, match *model.Match, entry *model.MatchReschedule, days int, cascade bool) ([]uuid.UUID, error) {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE matches
+		SET match_date = $1, match_time = $2, status = $3, schedule_revision = schedule_revision + 1,
+		    starts_at = ($1::date + COALESCE($2::time, TIME '00:00')) AT TIME ZONE (SELECT timezone FROM leagues WHERE id = matches.league_id),
+		    updated_at = NOW()
+		WHERE id = $4
+	`, match.MatchDate, match.MatchTime, model.MatchStatusUpcoming, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrMatchNotFound
+	}
+
+	sessionQuery := `
+		UPDATE match_sessions
+		SET session_date = session_date + $2::int,
+		    starts_at = ` + sessionStartsAt("match_sessions.match_id", "(session_date + $2::int)", "session_time") + `,
+		    updated_at = NOW()
+		WHERE match_id = $1 AND type <> 'race' AND session_date IS NOT NULL
+	`
+	if _, err := tx.ExecContext(ctx, sessionQuery, match.ID, days); err != nil {
+		return nil, err
+	}
+	raceQuery := `
+		UPDATE match_sessions
+		SET session_date = $1, session_time = $2, starts_at = ` + sessionStartsAt("$3", "$1", "$2") + `, updated_at = NOW()
+		WHERE match_id = $3 AND type = 'race'
+	`
+	if _, err := tx.ExecContext(ctx, raceQuery, match.MatchDate, match.MatchTime, match.ID); err != nil {
+		return nil, err
+	}
+
+	if err := createRescheduleTx(ctx, tx, entry); err != nil {
+		return nil, err
+	}
+
+	var shifted []uuid.UUID
+	if cascade && days != 0 {
+		shifted, err = matchIDsTx(ctx, tx, `
+			SELECT id FROM matches
+			WHERE league_id = $1 AND round > $2 AND status IN ('upcoming', 'postponed')
+			ORDER BY round
+		`, match.LeagueID, match.Round)
+		if err != nil {
+			return nil, err
+		}
+
+		historyQuery := `
+			INSERT INTO match_reschedules (match_id, kind, from_date, from_time, to_date, to_time, reason, cause_match_id, actor_id)
+			SELECT id, $2, match_date, match_time, match_date + $3::int, match_time, $4, $5, $6
+			FROM matches
+			WHERE id = ANY($1::uuid[])
+		`
+		if _, err := tx.ExecContext(ctx, historyQuery, uuidArray(shifted), model.MatchRescheduleShifted, days, entry.Reason, match.ID, entry.ActorID); err != nil {
+			return nil, err
+		}
+		if err := shiftScheduleTx(ctx, tx, shifted, days); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := invalidateAttendanceTx(ctx, tx, append([]uuid.UUID{match.ID}, shifted...)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return shifted, nil
+}
+
+// RecordEdit records a date change made by editing a match directly and clears its RSVPs
+func (r *MatchRescheduleRepository) RecordEdit(ctx context.Context, entry *model.MatchReschedule) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := createRescheduleTx(ctx, tx, entry); err != nil {
+		return err
+	}
+	if err := invalidateAttendanceTx(ctx, tx, []uuid.UUID{entry.MatchID}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListByMatch retrieves the schedule history of a match, most recent first
+func (r *MatchRescheduleRepository) ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.MatchReschedule, error) {
+	query := `
+		SELECT mr.id, mr.match_id, mr.kind, mr.from_date::text, mr.from_time::text, mr.to_date::text, mr.to_time::text,
+		       mr.reason, mr.cause_match_id, mr.actor_id, mr.created_at, u.nickname
+		FROM match_reschedules mr
+		LEFT JOIN users u ON u.id = mr.actor_id
+		WHERE mr.match_id = $1
+		ORDER BY mr.created_at DESC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reschedules := []*model.MatchReschedule{}
+	for rows.Next() {
+		mr := &model.MatchReschedule{}
+		if err := rows.Scan(
+			&mr.ID,
+			&mr.MatchID,
+			&mr.Kind,
+			&mr.FromDate,
+			&mr.FromTime,
+			&mr.ToDate,
+			&mr.ToTime,
+			&mr.Reason,
+			&mr.CauseMatchID,
+			&mr.ActorID,
+			&mr.CreatedAt,
+			&mr.ActorName,
+		); err != nil {
+			return nil, err
+		}
+		reschedules = append(reschedules, mr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reschedules, nil
+}
+
+// LatestProposal returns the date and time proposed when a match was last postponed, if any
+func (r *MatchRescheduleRepository) LatestProposal(ctx context.Context, matchID uuid.UUID) (*string, *string, error) {
+	var date, timeOfDay *string
+	err := r.db.Pool.QueryRowContext(ctx, `
+		SELECT to_date::text, to_time::text
+		FROM match_reschedules
+		WHERE match_id = $1 AND kind = $2
+		ORDER BY created_at DESC
+		LIMIT 1
+	`, matchID, model.MatchReschedulePostponed).Scan(&date, &timeOfDay)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, nil, err
+	}
+	return date, timeOfDay, nil
+}
+
+func createRescheduleTx(ctx context.Context, tx *sql.Tx, entry *model.MatchReschedule) error {
+	query := `
+		INSERT INTO match_reschedules (match_id, kind, from_date, from_time, to_date, to_time, reason, cause_match_id, actor_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at
+	`
+
+	return tx.QueryRowContext(ctx, query,
+		entry.MatchID,
+		entry.Kind,
+		entry.FromDate,
+		entry.FromTime,
+		entry.ToDate,
+		entry.ToTime,
+		entry.Reason,
+		entry.CauseMatchID,
+		entry.ActorID,
+	).Scan(&entry.ID, &entry.CreatedAt)
+}
+
+// shiftScheduleTx moves matches and all their dated sessions by a number of days and bumps their schedule revision
+func shiftScheduleTx(ctx context.Context, tx *sql.Tx, ids []uuid.UUID, days int) error {
+	if len(ids) == 0 || days == 0 {
+		return nil
+	}
+
+	sessionQuery := `
+		UPDATE match_sessions
+		SET session_date = session_date + $2::int,
+		    starts_at = ` + sessionStartsAt("match_sessions.match_id", "(session_date + $2::int)", "session_time") + `,
+		    updated_at = NOW()
+		WHERE match_id = ANY($1::uuid[]) AND session_date IS NOT NULL
+	`
+	if _, err := tx.ExecContext(ctx, sessionQuery, uuidArray(ids), days); err != nil {
+		return err
+	}
+
+	matchQuery := `
+		UPDATE matches m
+		SET match_date = m.match_date + $2::int,
+		    starts_at = ((m.match_date + $2::int) + COALESCE(m.match_time, TIME '00:00')) AT TIME ZONE l.timezone,
+		    schedule_revision = m.schedule_revision + 1,
+		    updated_at = NOW()
+		FROM leagues l
+		WHERE l.id = m.league_id AND m.id = ANY($1::uuid[])
+	`
+	_, err := tx.ExecContext(ctx, matchQuery, uuidArray(ids), days)
+	return err
+}
+
+// invalidateAttendanceTx drops the RSVPs, missed-RSVP strikes and open seat offers of matches whose date
+// changed. RSVPs reopen unless the new deadline has already passed, in which case they stay closed
+// without handing out strikes.
+func invalidateAttendanceTx(ctx context.Context, tx *sql.Tx, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	matchIDs := uuidArray(ids)
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM match_rsvps WHERE match_id = ANY($1::uuid[])`, matchIDs); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM attendance_strikes WHERE match_id = ANY($1::uuid[]) AND reason = 'missed_rsvp'`, matchIDs); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE reserve_offers SET status = 'withdrawn', responded_at = NOW()
+		WHERE match_id = ANY($1::uuid[]) AND status = 'pending'
+	`, matchIDs); err != nil {
+		return err
+	}
+
+	// 24 hours matches the rsvp_deadline_hours column default
+	_, err := tx.ExecContext(ctx, `
+		UPDATE matches m
+		SET rsvp_closed_at = CASE
+		        WHEN m.starts_at - make_interval(hours => COALESCE(s.rsvp_deadline_hours, 24)) <= NOW() THEN NOW()
+		    END
+		FROM leagues l
+		LEFT JOIN attendance_settings s ON s.league_id = l.id
+		WHERE l.id = m.league_id AND m.id = ANY($1::uuid[])
+	`, matchIDs)
+	return err
+}
+
+// matchIDsTx collects the match IDs returned by a query
+func matchIDsTx(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]uuid.UUID, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func uuidArray(ids []uuid.UUID) pq.StringArray {
+	arr := make(pq.StringArray, len(ids))
+	for i, id := range ids {
+		arr[i] = id.String()
+	}
+	return arr
+}
@@ -10,10 +10,8 @@ import (
 	"github.com/google/uuid"
 )
 
-var (
-	ErrMatchResultNotFound = errors.New("match result not found")
-)
-
+// MatchResultRepository exposes a match's race and sprint session results in the combined
+// per-driver layout used by the result entry screens and standings
 type MatchResultRepository struct {
 	db *database.DB
 }
@@ -22,95 +20,37 @@ func NewMatchResultRepository(db *database.DB) *MatchResultRepository {
 	return &MatchResultRepository{db: db}
 }
 
-// Upsert creates or updates a match result
-func (r *MatchResultRepository) Upsert(ctx context.Context, result *model.MatchResult) error {
-	query := `
-		INSERT INTO match_results (match_id, participant_id, team_name, position, points, fastest_lap, dnf, dnf_reason, sprint_position, sprint_points)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		ON CONFLICT (match_id, participant_id)
-		DO UPDATE SET
-			team_name = COALESCE(EXCLUDED.team_name, match_results.team_name),
-			position = EXCLUDED.position,
-			points = EXCLUDED.points,
-			fastest_lap = EXCLUDED.fastest_lap,
-			dnf = EXCLUDED.dnf,
-			dnf_reason = EXCLUDED.dnf_reason,
-			sprint_position = EXCLUDED.sprint_position,
-			sprint_points = EXCLUDED.sprint_points,
-			updated_at = NOW()
-		RETURNING id, created_at, updated_at
-	`
-
-	err := r.db.Pool.QueryRowContext(ctx, query,
-		result.MatchID,
-		result.ParticipantID,
-		result.StoredTeamName,
-		result.Position,
-		result.Points,
-		result.FastestLap,
-		result.DNF,
-		result.DNFReason,
-		result.SprintPosition,
-		result.SprintPoints,
-	).Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
-
-	return err
-}
-
-// GetByID retrieves a match result by ID
-func (r *MatchResultRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.MatchResult, error) {
-	query := `
-		SELECT mr.id, mr.match_id, mr.participant_id, mr.team_name, mr.position, mr.points, mr.fastest_lap,
-		       mr.dnf, mr.dnf_reason, mr.sprint_position, mr.sprint_points, mr.created_at, mr.updated_at,
-		       u.nickname, lp.team_name
-		FROM match_results mr
-		JOIN league_participants lp ON mr.participant_id = lp.id
-		JOIN users u ON lp.user_id = u.id
-		WHERE mr.id = $1
-	`
-
-	result := &model.MatchResult{}
-	err := r.db.Pool.QueryRowContext(ctx, query, id).Scan(
-		&result.ID,
-		&result.MatchID,
-		&result.ParticipantID,
-		&result.StoredTeamName,
-		&result.Position,
-		&result.Points,
-		&result.FastestLap,
-		&result.DNF,
-		&result.DNFReason,
-		&result.SprintPosition,
-		&result.SprintPoints,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-		&result.ParticipantName,
-		&result.TeamName,
-	)
-
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrMatchResultNotFound
-		}
-		return nil, err
-	}
-
-	return result, nil
-}
-
-// ListByMatch retrieves all results for a match
+// ListByMatch retrieves all results for a match, combining each driver's race and sprint session results
 func (r *MatchResultRepository) ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.MatchResult, error) {
 	query := `
-		SELECT mr.id, mr.match_id, mr.participant_id, mr.team_name, mr.position, mr.points, mr.fastest_lap,
-		       mr.dnf, mr.dnf_reason, mr.sprint_position, mr.sprint_points, mr.created_at, mr.updated_at,
-		       u.nickname, lp.team_name
-		FROM match_results mr
-		JOIN league_participants lp ON mr.participant_id = lp.id
+		WITH race AS (
+			SELECT sr.*
+			FROM session_results sr
+			JOIN match_sessions s ON sr.session_id = s.id
+			WHERE s.match_id = $1 AND s.type = 'race'
+		), sprint AS (
+			SELECT sr.*
+			FROM session_results sr
+			JOIN match_sessions s ON sr.session_id = s.id
+			WHERE s.match_id = $1 AND s.type = 'sprint'
+		)
+		SELECT COALESCE(race.id, sprint.id), $1::uuid, lp.id, COALESCE(sub.team_name, race.team_name, sprint.team_name),
+		       race.position, COALESCE(race.points, 0), COALESCE(race.points_manual, false), COALESCE(race.fastest_lap, false),
+		       COALESCE(race.dnf, false), race.dnf_reason, sprint.position, COALESCE(sprint.points, 0), COALESCE(sprint.points_manual, false),
+		       race.time_ms, sprint.time_ms, race.original_position, sprint.original_position,
+		       COALESCE(race.disqualified, false), COALESCE(sprint.disqualified, false),
+		       LEAST(race.created_at, sprint.created_at), GREATEST(race.updated_at, sprint.updated_at),
+		       u.nickname, lp.team_name, su.nickname
+		FROM race
+		FULL OUTER JOIN sprint ON race.participant_id = sprint.participant_id
+		JOIN league_participants lp ON lp.id = COALESCE(race.participant_id, sprint.participant_id)
 		JOIN users u ON lp.user_id = u.id
-		WHERE mr.match_id = $1
+		LEFT JOIN match_substitutions sub ON sub.match_id = $1 AND sub.reserve_participant_id = lp.id
+		LEFT JOIN league_participants slp ON slp.id = sub.replaced_participant_id
+		LEFT JOIN users su ON su.id = slp.user_id
 		ORDER BY
-			CASE WHEN mr.position IS NULL THEN 1 ELSE 0 END,
-			mr.position ASC
+			CASE WHEN race.position IS NULL THEN 1 ELSE 0 END,
+			race.position ASC
 	`
 
 	rows, err := r.db.Pool.QueryContext(ctx, query, matchID)
@@ -129,15 +69,24 @@ func (r *MatchResultRepository) ListByMatch(ctx context.Context, matchID uuid.UU
 			&r.StoredTeamName,
 			&r.Position,
 			&r.Points,
+			&r.PointsManual,
 			&r.FastestLap,
 			&r.DNF,
 			&r.DNFReason,
 			&r.SprintPosition,
 			&r.SprintPoints,
+			&r.SprintPointsManual,
+			&r.RaceTimeMs,
+			&r.SprintTimeMs,
+			&r.OriginalPosition,
+			&r.OriginalSprintPosition,
+			&r.Disqualified,
+			&r.SprintDisqualified,
 			&r.CreatedAt,
 			&r.UpdatedAt,
 			&r.ParticipantName,
 			&r.TeamName,
+			&r.SubstituteFor,
 		); err != nil {
 			return nil, err
 		}
@@ -147,53 +96,58 @@ func (r *MatchResultRepository) ListByMatch(ctx context.Context, matchID uuid.UU
 	return results, nil
 }
 
-// Delete removes a match result
-func (r *MatchResultRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM match_results WHERE id = $1`
-
-	result, err := r.db.Pool.ExecContext(ctx, query, id)
+// DeleteByMatch removes all results for every session of a match
+func (r *MatchResultRepository) DeleteByMatch(ctx context.Context, matchID uuid.UUID) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
+	query := `
+		DELETE FROM session_results
+		WHERE session_id IN (SELECT id FROM match_sessions WHERE match_id = $1)
+	`
+	if _, err := tx.ExecContext(ctx, query, matchID); err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return ErrMatchResultNotFound
-	}
 
-	return nil
-}
+	query = `
+		UPDATE match_sessions
+		SET results_status = NULL, results_submitted_at = NULL, results_official_at = NULL, updated_at = NOW()
+		WHERE match_id = $1
+	`
+	if _, err := tx.ExecContext(ctx, query, matchID); err != nil {
+		return err
+	}
 
-// DeleteByMatch removes all results for a match
-func (r *MatchResultRepository) DeleteByMatch(ctx context.Context, matchID uuid.UUID) error {
-	query := `DELETE FROM match_results WHERE match_id = $1`
-	_, err := r.db.Pool.ExecContext(ctx, query, matchID)
-	return err
+	return tx.Commit()
 }
 
-// GetLeagueStandings returns aggregated standings for a league
-func (r *MatchResultRepository) GetLeagueStandings(ctx context.Context, leagueID uuid.UUID) ([]model.StandingsEntry, error) {
+// GetLeagueStandings returns aggregated standings for a league, optionally counting official results only.
+// Wins, podiums, fastest laps, DNFs and races completed only count non-sprint sessions.
+func (r *MatchResultRepository) GetLeagueStandings(ctx context.Context, leagueID uuid.UUID, officialOnly bool) ([]model.StandingsEntry, error) {
 	query := `
 		SELECT
 			lp.id as participant_id,
 			lp.user_id,
 			u.nickname as driver_name,
 			lp.team_name,
-			COALESCE(SUM(mr.points), 0) + COALESCE(SUM(mr.sprint_points), 0) as total_points,
-			COALESCE(SUM(mr.points), 0) as race_points,
-			COALESCE(SUM(mr.sprint_points), 0) as sprint_points,
-			COUNT(CASE WHEN mr.position = 1 THEN 1 END) as wins,
-			COUNT(CASE WHEN mr.position <= 3 AND mr.position IS NOT NULL THEN 1 END) as podiums,
-			COUNT(CASE WHEN mr.fastest_lap = true THEN 1 END) as fastest_laps,
-			COUNT(CASE WHEN mr.dnf = true THEN 1 END) as dnfs,
-			COUNT(CASE WHEN mr.position IS NOT NULL OR mr.dnf = true THEN 1 END) as races_completed
+			COALESCE(SUM(sr.points), 0) as total_points,
+			COALESCE(SUM(sr.points) FILTER (WHERE s.type <> 'sprint'), 0) as race_points,
+			COALESCE(SUM(sr.points) FILTER (WHERE s.type = 'sprint'), 0) as sprint_points,
+			COUNT(*) FILTER (WHERE s.type <> 'sprint' AND sr.position = 1) as wins,
+			COUNT(*) FILTER (WHERE s.type <> 'sprint' AND sr.position <= 3) as podiums,
+			COUNT(*) FILTER (WHERE s.type <> 'sprint' AND sr.fastest_lap) as fastest_laps,
+			COUNT(*) FILTER (WHERE s.type <> 'sprint' AND sr.dnf) as dnfs,
+			COUNT(*) FILTER (WHERE s.type <> 'sprint' AND (sr.position IS NOT NULL OR sr.dnf)) as races_completed
 		FROM league_participants lp
 		JOIN users u ON lp.user_id = u.id
-		LEFT JOIN match_results mr ON lp.id = mr.participant_id
-		LEFT JOIN matches m ON mr.match_id = m.id AND m.league_id = $1
+		LEFT JOIN (
+			session_results sr
+			JOIN match_sessions s ON sr.session_id = s.id AND s.type = ANY($2)
+			                     AND (NOT $3 OR s.results_status = 'official')
+		) ON sr.participant_id = lp.id
 		WHERE lp.league_id = $1
 		  AND lp.status = 'approved'
 		  AND (lp.roles && ARRAY['player','reserve'])
@@ -201,7 +155,7 @@ func (r *MatchResultRepository) GetLeagueStandings(ctx context.Context, leagueID
 		ORDER BY total_points DESC, wins DESC, podiums DESC, fastest_laps DESC
 	`
 
-	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID)
+	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID, sessionTypeArray(model.PointsSessionTypes), officialOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -234,33 +188,37 @@ func (r *MatchResultRepository) GetLeagueStandings(ctx context.Context, leagueID
 	return standings, nil
 }
 
-// GetTeamStandings returns aggregated team standings for a league
-// Uses team_name stored in match_results at the time of result recording
-func (r *MatchResultRepository) GetTeamStandings(ctx context.Context, leagueID uuid.UUID) ([]model.TeamStandingsEntry, error) {
+// GetTeamStandings returns aggregated team standings for a league, optionally counting official results only.
+// Uses team_name stored in session_results at the time of result recording, or the substitution's team for reserves
+func (r *MatchResultRepository) GetTeamStandings(ctx context.Context, leagueID uuid.UUID, officialOnly bool) ([]model.TeamStandingsEntry, error) {
 	query := `
 		SELECT
-			mr.team_name,
-			COALESCE(SUM(mr.points), 0) + COALESCE(SUM(mr.sprint_points), 0) as total_points,
-			COALESCE(SUM(mr.points), 0) as race_points,
-			COALESCE(SUM(mr.sprint_points), 0) as sprint_points,
-			COUNT(CASE WHEN mr.position = 1 THEN 1 END) as wins,
-			COUNT(CASE WHEN mr.position <= 3 AND mr.position IS NOT NULL THEN 1 END) as podiums,
-			COUNT(CASE WHEN mr.fastest_lap = true THEN 1 END) as fastest_laps,
-			COUNT(CASE WHEN mr.dnf = true THEN 1 END) as dnfs,
-			COUNT(DISTINCT lp.id) as driver_count
-		FROM match_results mr
-		JOIN matches m ON mr.match_id = m.id
-		JOIN league_participants lp ON mr.participant_id = lp.id
+			COALESCE(sub.team_name, sr.team_name) as team_name,
+			COALESCE(SUM(sr.points), 0) as total_points,
+			COALESCE(SUM(sr.points) FILTER (WHERE s.type <> 'sprint'), 0) as race_points,
+			COALESCE(SUM(sr.points) FILTER (WHERE s.type = 'sprint'), 0) as sprint_points,
+			COUNT(*) FILTER (WHERE s.type <> 'sprint' AND sr.position = 1) as wins,
+			COUNT(*) FILTER (WHERE s.type <> 'sprint' AND sr.position <= 3) as podiums,
+			COUNT(*) FILTER (WHERE s.type <> 'sprint' AND sr.fastest_lap) as fastest_laps,
+			COUNT(*) FILTER (WHERE s.type <> 'sprint' AND sr.dnf) as dnfs,
+			COUNT(DISTINCT lp.id) FILTER (WHERE sub.id IS NULL) as driver_count
+		FROM session_results sr
+		JOIN match_sessions s ON sr.session_id = s.id
+		JOIN matches m ON s.match_id = m.id
+		JOIN league_participants lp ON sr.participant_id = lp.id
+		LEFT JOIN match_substitutions sub ON sub.match_id = m.id AND sub.reserve_participant_id = sr.participant_id
 		WHERE m.league_id = $1
+		  AND s.type = ANY($2)
+		  AND (NOT $3 OR s.results_status = 'official')
 		  AND lp.status = 'approved'
 		  AND (lp.roles && ARRAY['player','reserve'])
-		  AND mr.team_name IS NOT NULL
-		  AND mr.team_name != ''
-		GROUP BY mr.team_name
+		  AND COALESCE(sub.team_name, sr.team_name) IS NOT NULL
+		  AND COALESCE(sub.team_name, sr.team_name) != ''
+		GROUP BY COALESCE(sub.team_name, sr.team_name)
 		ORDER BY total_points DESC, wins DESC, podiums DESC, fastest_laps DESC
 	`
 
-	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID)
+	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID, sessionTypeArray(model.PointsSessionTypes), officialOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -290,123 +248,170 @@ func (r *MatchResultRepository) GetTeamStandings(ctx context.Context, leagueID u
 	return standings, nil
 }
 
-// BulkUpsert creates or updates multiple results at once (all fields)
-func (r *MatchResultRepository) BulkUpsert(ctx context.Context, matchID uuid.UUID, results []model.CreateMatchResultRequest) error {
-	tx, err := r.db.Pool.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	defer tx.Rollback()
-
+// sessionIDTx returns the ID of the first session of the given type in a match
+func sessionIDTx(ctx context.Context, tx *sql.Tx, matchID uuid.UUID, sessionType model.SessionType) (uuid.UUID, error) {
 	query := `
-		INSERT INTO match_results (match_id, participant_id, team_name, position, points, fastest_lap, dnf, dnf_reason, sprint_position, sprint_points)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		ON CONFLICT (match_id, participant_id)
-		DO UPDATE SET
-			team_name = COALESCE(EXCLUDED.team_name, match_results.team_name),
-			position = EXCLUDED.position,
-			points = EXCLUDED.points,
-			fastest_lap = EXCLUDED.fastest_lap,
-			dnf = EXCLUDED.dnf,
-			dnf_reason = EXCLUDED.dnf_reason,
-			sprint_position = EXCLUDED.sprint_position,
-			sprint_points = EXCLUDED.sprint_points,
-			updated_at = NOW()
+		SELECT id FROM match_sessions
+		WHERE match_id = $1 AND type = $2
+		ORDER BY session_order ASC
+		LIMIT 1
 	`
 
-	for _, result := range results {
-		_, err := tx.ExecContext(ctx, query,
-			matchID,
-			result.ParticipantID,
-			result.TeamName,
-			result.Position,
-			result.Points,
-			result.FastestLap,
-			result.DNF,
-			result.DNFReason,
-			result.SprintPosition,
-			result.SprintPoints,
-		)
-		if err != nil {
-			return err
+	var id uuid.UUID
+	if err := tx.QueryRowContext(ctx, query, matchID, sessionType).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, ErrSessionNotFound
 		}
+		return uuid.Nil, err
 	}
 
-	return tx.Commit()
+	return id, nil
 }
 
-// BulkUpsertSprintResults creates or updates sprint results only (sprint_position, sprint_points)
-func (r *MatchResultRepository) BulkUpsertSprintResults(ctx context.Context, matchID uuid.UUID, results []model.CreateMatchResultRequest) error {
+func upsertRaceResultTx(ctx context.Context, tx *sql.Tx, sessionID uuid.UUID, result model.CreateMatchResultRequest) error {
+	_, err := tx.ExecContext(ctx, sessionResultUpsertQuery,
+		sessionID,
+		result.ParticipantID,
+		result.TeamName,
+		result.Position,
+		result.Points,
+		result.PointsManual,
+		result.FastestLap,
+		result.DNF,
+		result.DNFReason,
+		result.RaceTimeMs,
+		nil,
+	)
+	return err
+}
+
+func upsertSprintResultTx(ctx context.Context, tx *sql.Tx, sessionID uuid.UUID, result model.CreateMatchResultRequest) error {
+	_, err := tx.ExecContext(ctx, sessionResultUpsertQuery,
+		sessionID,
+		result.ParticipantID,
+		result.TeamName,
+		result.SprintPosition,
+		result.SprintPoints,
+		result.SprintPointsManual,
+		false,
+		false,
+		nil,
+		result.SprintTimeMs,
+		nil,
+	)
+	return err
+}
+
+// BulkUpsert creates or updates race and sprint results at once (all fields).
+// A driver's sprint result is removed when the entry carries no sprint classification.
+func (r *MatchResultRepository) BulkUpsert(ctx context.Context, matchID uuid.UUID, results []model.CreateMatchResultRequest) error {
 	tx, err := r.db.Pool.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	query := `
-		INSERT INTO match_results (match_id, participant_id, team_name, sprint_position, sprint_points)
-		VALUES ($1, $2, $3, $4, $5)
-		ON CONFLICT (match_id, participant_id)
-		DO UPDATE SET
-			team_name = COALESCE(EXCLUDED.team_name, match_results.team_name),
-			sprint_position = EXCLUDED.sprint_position,
-			sprint_points = EXCLUDED.sprint_points,
-			updated_at = NOW()
-	`
+	raceID, err := sessionIDTx(ctx, tx, matchID, model.SessionTypeRace)
+	if err != nil {
+		return err
+	}
+	sprintID, err := sessionIDTx(ctx, tx, matchID, model.SessionTypeSprint)
+	if err != nil && !errors.Is(err, ErrSessionNotFound) {
+		return err
+	}
 
+	sprintEntered := false
 	for _, result := range results {
-		_, err := tx.ExecContext(ctx, query,
-			matchID,
-			result.ParticipantID,
-			result.TeamName,
-			result.SprintPosition,
-			result.SprintPoints,
-		)
-		if err != nil {
+		if err := upsertRaceResultTx(ctx, tx, raceID, result); err != nil {
 			return err
 		}
-	}
 
-	return tx.Commit()
-}
+		if sprintID == uuid.Nil {
+			continue
+		}
+		if result.SprintPosition == nil && !result.SprintPointsManual {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM session_results WHERE session_id = $1 AND participant_id = $2`, sprintID, result.ParticipantID); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := upsertSprintResultTx(ctx, tx, sprintID, result); err != nil {
+			return err
+		}
+		sprintEntered = true
+	}
 
-// BulkUpsertRaceResults creates or updates race results only (position, points, fastest_lap, dnf, dnf_reason)
-func (r *MatchResultRepository) BulkUpsertRaceResults(ctx context.Context, matchID uuid.UUID, results []model.CreateMatchResultRequest) error {
-	tx, err := r.db.Pool.BeginTx(ctx, nil)
-	if err != nil {
+	if err := markResultsProvisionalTx(ctx, tx, raceID); err != nil {
 		return err
 	}
-	defer tx.Rollback()
+	if sprintEntered {
+		if err := markResultsProvisionalTx(ctx, tx, sprintID); err != nil {
+			return err
+		}
+	}
 
+	return tx.Commit()
+}
+
+// ListRoundResults returns per-session results of approved drivers in a league for every
+// points-scoring session, optionally official results only, ordered by round and session order.
+// Results driven as a substitute carry the team the reserve stood in for.
+func (r *MatchResultRepository) ListRoundResults(ctx context.Context, leagueID uuid.UUID, officialOnly bool) ([]model.RoundResult, error) {
 	query := `
-		INSERT INTO match_results (match_id, participant_id, team_name, position, points, fastest_lap, dnf, dnf_reason)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		ON CONFLICT (match_id, participant_id)
-		DO UPDATE SET
-			team_name = COALESCE(EXCLUDED.team_name, match_results.team_name),
-			position = EXCLUDED.position,
-			points = EXCLUDED.points,
-			fastest_lap = EXCLUDED.fastest_lap,
-			dnf = EXCLUDED.dnf,
-			dnf_reason = EXCLUDED.dnf_reason,
-			updated_at = NOW()
+		SELECT m.id, m.round, s.type, sr.participant_id, COALESCE(sub.team_name, sr.team_name), sr.position, sr.points,
+		       sr.fastest_lap, sr.dnf, s.results_status IS DISTINCT FROM 'official' as provisional, sub.id IS NOT NULL
+		FROM session_results sr
+		JOIN match_sessions s ON sr.session_id = s.id
+		JOIN matches m ON s.match_id = m.id
+		JOIN league_participants lp ON sr.participant_id = lp.id
+		LEFT JOIN match_substitutions sub ON sub.match_id = m.id AND sub.reserve_participant_id = sr.participant_id
+		WHERE m.league_id = $1
+		  AND s.type = ANY($2)
+		  AND (NOT $3 OR s.results_status = 'official')
+		  AND lp.status = 'approved'
+		  AND (lp.roles && ARRAY['player','reserve'])
+		ORDER BY m.round ASC, s.session_order ASC, sr.position ASC NULLS LAST
 	`
 
-	for _, result := range results {
-		_, err := tx.ExecContext(ctx, query,
-			matchID,
-			result.ParticipantID,
-			result.TeamName,
-			result.Position,
-			result.Points,
-			result.FastestLap,
-			result.DNF,
-			result.DNFReason,
-		)
-		if err != nil {
-			return err
+	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID, sessionTypeArray(model.PointsSessionTypes), officialOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []model.RoundResult
+	for rows.Next() {
+		var rr model.RoundResult
+		var position *int
+		var points float64
+		if err := rows.Scan(
+			&rr.MatchID,
+			&rr.Round,
+			&rr.Session,
+			&rr.ParticipantID,
+			&rr.TeamName,
+			&position,
+			&points,
+			&rr.FastestLap,
+			&rr.DNF,
+			&rr.Provisional,
+			&rr.Substitute,
+		); err != nil {
+			return nil, err
+		}
+		if rr.Session == model.SessionTypeSprint {
+			rr.SprintPosition = position
+			rr.SprintPoints = points
+		} else {
+			rr.Position = position
+			rr.Points = points
 		}
+		results = append(results, rr)
 	}
 
-	return tx.Commit()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
 }
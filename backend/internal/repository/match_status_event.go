@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+type MatchStatusEventRepository struct {
+	db *database.DB
+}
+
+func NewMatchStatusEventRepository(db *database.DB) *MatchStatusEventRepository {
+	return &MatchStatusEventRepository{db: db}
+}
+
+// Create records a status change
+func (r *MatchStatusEventRepository) Create(ctx context.Context, event *model.MatchStatusEvent) error {
+	query := `
+		INSERT INTO match_status_events (match_id, session_id, subject, from_status, to_status, reason, actor_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	return r.db.Pool.QueryRowContext(ctx, query,
+		event.MatchID,
+		event.SessionID,
+		event.Subject,
+		event.FromStatus,
+		event.ToStatus,
+		event.Reason,
+		event.ActorID,
+	).Scan(&event.ID, &event.CreatedAt)
+}
+
+// ListByMatch retrieves the status history of a match and its sessions, most recent first
+func (r *MatchStatusEventRepository) ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.MatchStatusEvent, error) {
+	query := `
+		SELECT e.id, e.match_id, e.session_id, e.subject, e.from_status, e.to_status, e.reason, e.actor_id, e.created_at,
+		       u.nickname
+		FROM match_status_events e
+		LEFT JOIN users u ON u.id = e.actor_id
+		WHERE e.match_id = $1
+		ORDER BY e.created_at DESC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*model.MatchStatusEvent
+	for rows.Next() {
+		e := &model.MatchStatusEvent{}
+		if err := rows.Scan(
+			&e.ID,
+			&e.MatchID,
+			&e.SessionID,
+			&e.Subject,
+			&e.FromStatus,
+			&e.ToStatus,
+			&e.Reason,
+			&e.ActorID,
+			&e.CreatedAt,
+			&e.ActorNickname,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
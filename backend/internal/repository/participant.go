@@ -260,6 +260,19 @@ func (r *ParticipantRepository) CountPlayersByTeam(ctx context.Context, leagueID
 	return count, err
 }
 
+// CountByRole counts approved participants holding a role in a league
+func (r *ParticipantRepository) CountByRole(ctx context.Context, leagueID uuid.UUID, role model.ParticipantRole) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM league_participants
+		WHERE league_id = $1
+		AND status = 'approved'
+		AND $2 = ANY(roles)
+	`
+	var count int
+	err := r.db.Pool.QueryRowContext(ctx, query, leagueID, string(role)).Scan(&count)
+	return count, err
+}
+
 // CountPlayersByTeamExcluding counts approved players in a team, excluding a specific participant
 func (r *ParticipantRepository) CountPlayersByTeamExcluding(ctx context.Context, leagueID uuid.UUID, teamName string, excludeParticipantID uuid.UUID) (int, error) {
 	query := `
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPenaltyNotFound       = errors.New("penalty not found")
+	ErrPenaltyAlreadyRevoked = errors.New("penalty already revoked")
+)
+
+type PenaltyRepository struct {
+	db *database.DB
+}
+
+func NewPenaltyRepository(db *database.DB) *PenaltyRepository {
+	return &PenaltyRepository{db: db}
+}
+
+// createPenaltyTx inserts a new penalty. Penalties are stored together with the classification they produce.
+func createPenaltyTx(ctx context.Context, tx *sql.Tx, p *model.Penalty) error {
+	query := `
+		INSERT INTO penalties (match_id, participant_id, session, type, value, reason, issued_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`
+
+	return tx.QueryRowContext(ctx, query,
+		p.MatchID,
+		p.ParticipantID,
+		p.Session,
+		p.Type,
+		p.Value,
+		p.Reason,
+		p.IssuedBy,
+	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+}
+
+// GetByID retrieves a penalty by ID
+func (r *PenaltyRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Penalty, error) {
+	query := `
+		SELECT p.id, p.match_id, p.participant_id, p.session, p.type, p.value, p.reason, p.issued_by,
+		       p.revoked_by, p.revoked_at, p.created_at, p.updated_at, pu.nickname, iu.nickname
+		FROM penalties p
+		JOIN league_participants lp ON p.participant_id = lp.id
+		JOIN users pu ON lp.user_id = pu.id
+		LEFT JOIN users iu ON p.issued_by = iu.id
+		WHERE p.id = $1
+	`
+
+	p := &model.Penalty{}
+	err := r.db.Pool.QueryRowContext(ctx, query, id).Scan(
+		&p.ID,
+		&p.MatchID,
+		&p.ParticipantID,
+		&p.Session,
+		&p.Type,
+		&p.Value,
+		&p.Reason,
+		&p.IssuedBy,
+		&p.RevokedBy,
+		&p.RevokedAt,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+		&p.ParticipantName,
+		&p.IssuerName,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPenaltyNotFound
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// ListByMatch retrieves all penalties issued for a match, including revoked ones
+func (r *PenaltyRepository) ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.Penalty, error) {
+	query := `
+		SELECT p.id, p.match_id, p.participant_id, p.session, p.type, p.value, p.reason, p.issued_by,
+		       p.revoked_by, p.revoked_at, p.created_at, p.updated_at, pu.nickname, iu.nickname
+		FROM penalties p
+		JOIN league_participants lp ON p.participant_id = lp.id
+		JOIN users pu ON lp.user_id = pu.id
+		LEFT JOIN users iu ON p.issued_by = iu.id
+		WHERE p.match_id = $1
+		ORDER BY p.created_at ASC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var penalties []*model.Penalty
+	for rows.Next() {
+		p := &model.Penalty{}
+		if err := rows.Scan(
+			&p.ID,
+			&p.MatchID,
+			&p.ParticipantID,
+			&p.Session,
+			&p.Type,
+			&p.Value,
+			&p.Reason,
+			&p.IssuedBy,
+			&p.RevokedBy,
+			&p.RevokedAt,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+			&p.ParticipantName,
+			&p.IssuerName,
+		); err != nil {
+			return nil, err
+		}
+		penalties = append(penalties, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return penalties, nil
+}
+
+// ListActiveByMatch retrieves penalties for a match that have not been revoked
+func (r *PenaltyRepository) ListActiveByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.Penalty, error) {
+	penalties, err := r.ListByMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var active []*model.Penalty
+	for _, p := range penalties {
+		if p.RevokedAt == nil {
+			active = append(active, p)
+		}
+	}
+	return active, nil
+}
+
+// revokePenaltyTx marks a penalty as revoked. Penalties are revoked together with the classification
+// that no longer carries them.
+func revokePenaltyTx(ctx context.Context, tx *sql.Tx, id, revokedBy uuid.UUID) error {
+	query := `
+		UPDATE penalties
+		SET revoked_by = $2, revoked_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := tx.ExecContext(ctx, query, id, revokedBy)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrPenaltyAlreadyRevoked
+	}
+
+	return nil
+}
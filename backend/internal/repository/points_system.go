@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPointsSystemNotFound = errors.New("points system not found")
+)
+
+type PointsSystemRepository struct {
+	db *database.DB
+}
+
+func NewPointsSystemRepository(db *database.DB) *PointsSystemRepository {
+	return &PointsSystemRepository{db: db}
+}
+
+// GetByLeague retrieves the points system configured for a league
+func (r *PointsSystemRepository) GetByLeague(ctx context.Context, leagueID uuid.UUID) (*model.PointsSystem, error) {
+	query := `
+		SELECT id, league_id, race_points, sprint_points, fastest_lap_points, fastest_lap_max_position, dnf_no_points, created_at, updated_at
+		FROM points_systems
+		WHERE league_id = $1
+	`
+
+	ps := &model.PointsSystem{}
+	err := r.db.Pool.QueryRowContext(ctx, query, leagueID).Scan(
+		&ps.ID,
+		&ps.LeagueID,
+		&ps.RacePoints,
+		&ps.SprintPoints,
+		&ps.FastestLapPoints,
+		&ps.FastestLapMaxPosition,
+		&ps.DNFNoPoints,
+		&ps.CreatedAt,
+		&ps.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPointsSystemNotFound
+		}
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+// Save replaces the points system of a league together with the stored result points it produces.
+// When settings is given, the league's settings document is switched over in the same transaction.
+func (r *PointsSystemRepository) Save(ctx context.Context, ps *model.PointsSystem, settings *model.LeagueSettings, results []*model.SessionResult) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := upsertPointsSystemTx(ctx, tx, ps); err != nil {
+		return err
+	}
+	if settings != nil {
+		if err := updateLeagueSettingsTx(ctx, tx, ps.LeagueID, settings); err != nil {
+			return err
+		}
+	}
+	if err := updateResultPointsTx(ctx, tx, results); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func upsertPointsSystemTx(ctx context.Context, tx *sql.Tx, ps *model.PointsSystem) error {
+	query := `
+		INSERT INTO points_systems (league_id, race_points, sprint_points, fastest_lap_points, fastest_lap_max_position, dnf_no_points)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (league_id)
+		DO UPDATE SET
+			race_points = EXCLUDED.race_points,
+			sprint_points = EXCLUDED.sprint_points,
+			fastest_lap_points = EXCLUDED.fastest_lap_points,
+			fastest_lap_max_position = EXCLUDED.fastest_lap_max_position,
+			dnf_no_points = EXCLUDED.dnf_no_points,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	return tx.QueryRowContext(ctx, query,
+		ps.LeagueID,
+		ps.RacePoints,
+		ps.SprintPoints,
+		ps.FastestLapPoints,
+		ps.FastestLapMaxPosition,
+		ps.DNFNoPoints,
+	).Scan(&ps.ID, &ps.CreatedAt, &ps.UpdatedAt)
+}
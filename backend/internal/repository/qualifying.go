@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+type QualifyingRepository struct {
+	db *database.DB
+}
+
+func NewQualifyingRepository(db *database.DB) *QualifyingRepository {
+	return &QualifyingRepository{db: db}
+}
+
+// ReplaceSession replaces every qualifying result of a match session in a single transaction
+func (r *QualifyingRepository) ReplaceSession(ctx context.Context, matchID uuid.UUID, session model.QualifyingSession, results []*model.QualifyingResult) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM qualifying_results WHERE match_id = $1 AND session = $2`, matchID, session); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO qualifying_results (match_id, participant_id, session, team_name, position, best_lap_ms, gap_ms,
+		                                grid_penalty, pit_lane_start, grid_position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	for _, result := range results {
+		if _, err := tx.ExecContext(ctx, query,
+			matchID,
+			result.ParticipantID,
+			session,
+			result.TeamName,
+			result.Position,
+			result.BestLapMs,
+			result.GapMs,
+			result.GridPenalty,
+			result.PitLaneStart,
+			result.GridPosition,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListByMatch retrieves the qualifying results of a match session ordered by qualifying position
+func (r *QualifyingRepository) ListByMatch(ctx context.Context, matchID uuid.UUID, session model.QualifyingSession) ([]*model.QualifyingResult, error) {
+	query := `
+		SELECT qr.id, qr.match_id, qr.participant_id, qr.session, qr.team_name, qr.position, qr.best_lap_ms, qr.gap_ms,
+		       qr.grid_penalty, qr.pit_lane_start, qr.grid_position, qr.created_at, qr.updated_at, u.nickname
+		FROM qualifying_results qr
+		JOIN league_participants lp ON qr.participant_id = lp.id
+		JOIN users u ON lp.user_id = u.id
+		WHERE qr.match_id = $1 AND qr.session = $2
+		ORDER BY qr.position ASC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, matchID, session)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*model.QualifyingResult
+	for rows.Next() {
+		q := &model.QualifyingResult{}
+		if err := rows.Scan(
+			&q.ID,
+			&q.MatchID,
+			&q.ParticipantID,
+			&q.Session,
+			&q.TeamName,
+			&q.Position,
+			&q.BestLapMs,
+			&q.GapMs,
+			&q.GridPenalty,
+			&q.PitLaneStart,
+			&q.GridPosition,
+			&q.CreatedAt,
+			&q.UpdatedAt,
+			&q.ParticipantName,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, q)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ListStatsByLeague returns pole counts and positions gained for every driver with qualifying results in a league.
+// Positions gained compare the grid slot with the classified finish of the race session (qualifying) or sprint session (sprint shootout).
+func (r *QualifyingRepository) ListStatsByLeague(ctx context.Context, leagueID uuid.UUID) (map[uuid.UUID]model.QualifyingStats, error) {
+	query := `
+		SELECT qr.participant_id,
+		       COUNT(*) FILTER (WHERE qr.session = 'qualifying' AND qr.position = 1) AS poles,
+		       COALESCE(SUM(
+		           CASE
+		               WHEN sr.position IS NOT NULL AND NOT sr.dnf AND NOT sr.disqualified
+		                   THEN qr.grid_position - sr.position
+		               ELSE 0
+		           END
+		       ), 0) AS positions_gained
+		FROM qualifying_results qr
+		JOIN matches m ON qr.match_id = m.id
+		LEFT JOIN match_sessions s ON s.match_id = qr.match_id
+		      AND s.type = CASE qr.session WHEN 'sprint_shootout' THEN 'sprint' ELSE 'race' END
+		LEFT JOIN session_results sr ON sr.session_id = s.id AND sr.participant_id = qr.participant_id
+		WHERE m.league_id = $1
+		GROUP BY qr.participant_id
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make(map[uuid.UUID]model.QualifyingStats)
+	for rows.Next() {
+		var s model.QualifyingStats
+		if err := rows.Scan(&s.ParticipantID, &s.Poles, &s.PositionsGained); err != nil {
+			return nil, err
+		}
+		stats[s.ParticipantID] = s
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// ListByLeague retrieves the qualifying results of every match in a league for one session type
+func (r *QualifyingRepository) ListByLeague(ctx context.Context, leagueID uuid.UUID, session model.QualifyingSession) ([]*model.QualifyingResult, error) {
+	query := `
+		SELECT qr.id, qr.match_id, qr.participant_id, qr.session, qr.team_name, qr.position, qr.best_lap_ms, qr.gap_ms,
+		       qr.grid_penalty, qr.pit_lane_start, qr.grid_position, qr.created_at, qr.updated_at, u.nickname
+		FROM qualifying_results qr
+		JOIN matches m ON qr.match_id = m.id
+		JOIN league_participants lp ON qr.participant_id = lp.id
+		JOIN users u ON lp.user_id = u.id
+		WHERE m.league_id = $1 AND qr.session = $2
+		ORDER BY m.round ASC, qr.position ASC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, leagueID, session)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*model.QualifyingResult
+	for rows.Next() {
+		q := &model.QualifyingResult{}
+		if err := rows.Scan(
+			&q.ID,
+			&q.MatchID,
+			&q.ParticipantID,
+			&q.Session,
+			&q.TeamName,
+			&q.Position,
+			&q.BestLapMs,
+			&q.GapMs,
+			&q.GridPenalty,
+			&q.PitLaneStart,
+			&q.GridPosition,
+			&q.CreatedAt,
+			&q.UpdatedAt,
+			&q.ParticipantName,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, q)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
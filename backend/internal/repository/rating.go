@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDriverRatingNotFound = errors.New("driver rating not found")
+)
+
+type RatingRepository struct {
+	db *database.DB
+}
+
+func NewRatingRepository(db *database.DB) *RatingRepository {
+	return &RatingRepository{db: db}
+}
+
+// completedMatchOrder sorts matches chronologically across league timezones, breaking ties deterministically
+const completedMatchOrder = `m.starts_at, m.round, m.id`
+
+// ListCompletedMatchIDs returns every completed match in chronological order
+func (r *RatingRepository) ListCompletedMatchIDs(ctx context.Context) ([]uuid.UUID, error) {
+	query := `SELECT m.id FROM matches m WHERE m.status = 'completed' ORDER BY ` + completedMatchOrder
+
+	rows, err := r.db.Pool.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// ListResults returns the main race classification of every completed match, grouped by match in chronological order.
+// A weekend's main race is its last race session, with reverse grid races only used when nothing else was run.
+func (r *RatingRepository) ListResults(ctx context.Context) ([]model.RatingResult, error) {
+	query := `
+		WITH main_race AS (
+			SELECT DISTINCT ON (s.match_id) s.id, s.match_id
+			FROM match_sessions s
+			WHERE s.type IN ('race', 'feature_race', 'endurance_stint', 'reverse_grid_race')
+			ORDER BY s.match_id, s.type = 'reverse_grid_race', s.session_order DESC
+		)
+		SELECT m.id, lp.user_id, sr.position, sr.dnf OR sr.disqualified OR sr.position IS NULL
+		FROM matches m
+		JOIN main_race mr ON mr.match_id = m.id
+		JOIN session_results sr ON sr.session_id = mr.id
+		JOIN league_participants lp ON lp.id = sr.participant_id
+		WHERE m.status = 'completed'
+		ORDER BY ` + completedMatchOrder + `, lp.user_id
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []model.RatingResult
+	for rows.Next() {
+		var res model.RatingResult
+		if err := rows.Scan(&res.MatchID, &res.UserID, &res.Position, &res.DNF); err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	return results, rows.Err()
+}
+
+// CountPending counts completed matches that have not been through the rating engine yet
+func (r *RatingRepository) CountPending(ctx context.Context) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM matches m
+		WHERE m.status = 'completed'
+		  AND NOT EXISTS (SELECT 1 FROM rated_matches rm WHERE rm.match_id = m.id)
+	`
+
+	var count int
+	err := r.db.Pool.QueryRowContext(ctx, query).Scan(&count)
+	return count, err
+}
+
+// ReplaceAll swaps the stored ratings and history for a full recompute
+func (r *RatingRepository) ReplaceAll(ctx context.Context, ratings []model.DriverRating, history []model.RatingHistoryEntry, matchIDs []uuid.UUID) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"driver_rating_history", "driver_ratings", "rated_matches"} {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table); err != nil {
+			return err
+		}
+	}
+
+	for _, rating := range ratings {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO driver_ratings (user_id, rating, peak_rating, matches_rated, last_match_id)
+			VALUES ($1, $2, $3, $4, $5)
+		`, rating.UserID, rating.Rating, rating.PeakRating, rating.MatchesRated, rating.LastMatchID); err != nil {
+			return err
+		}
+	}
+
+	for _, h := range history {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO driver_rating_history (user_id, match_id, rating_before, rating_after, position, opponents)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, h.UserID, h.MatchID, h.RatingBefore, h.RatingAfter, h.Position, h.Opponents); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range matchIDs {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO rated_matches (match_id) VALUES ($1)`, id); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+const driverRatingColumns = `
+	RANK() OVER (ORDER BY dr.rating DESC), dr.user_id, u.nickname, dr.rating, dr.peak_rating,
+	dr.matches_rated, dr.last_match_id, dr.updated_at
+`
+
+// Leaderboard lists ratings from highest to lowest for drivers with at least minMatches rated matches
+func (r *RatingRepository) Leaderboard(ctx context.Context, page, limit, minMatches int) ([]model.DriverRating, int, error) {
+	offset := (page - 1) * limit
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM driver_ratings WHERE matches_rated >= $1`
+	if err := r.db.Pool.QueryRowContext(ctx, countQuery, minMatches).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT ` + driverRatingColumns + `
+		FROM driver_ratings dr
+		JOIN users u ON u.id = dr.user_id
+		WHERE dr.matches_rated >= $1
+		ORDER BY dr.rating DESC, dr.user_id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, minMatches, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	ratings := []model.DriverRating{}
+	for rows.Next() {
+		rating, err := scanDriverRating(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		ratings = append(ratings, *rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return ratings, total, nil
+}
+
+// GetByUser retrieves a user's current rating and overall rank
+func (r *RatingRepository) GetByUser(ctx context.Context, userID uuid.UUID) (*model.DriverRating, error) {
+	query := `
+		SELECT * FROM (
+			SELECT ` + driverRatingColumns + `
+			FROM driver_ratings dr
+			JOIN users u ON u.id = dr.user_id
+		) ranked
+		WHERE user_id = $1
+	`
+
+	rating, err := scanDriverRating(r.db.Pool.QueryRowContext(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDriverRatingNotFound
+		}
+		return nil, err
+	}
+
+	return rating, nil
+}
+
+// ListHistory retrieves a user's rating changes, most recent match first
+func (r *RatingRepository) ListHistory(ctx context.Context, userID uuid.UUID) ([]model.RatingHistoryEntry, error) {
+	query := `
+		SELECT h.id, h.user_id, h.match_id, h.rating_before, h.rating_after, h.position, h.opponents, h.created_at,
+		       m.league_id, l.name, m.round, m.track, m.match_date::text
+		FROM driver_rating_history h
+		JOIN matches m ON m.id = h.match_id
+		JOIN leagues l ON l.id = m.league_id
+		WHERE h.user_id = $1
+		ORDER BY m.starts_at DESC, m.round DESC, m.id DESC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []model.RatingHistoryEntry{}
+	for rows.Next() {
+		var h model.RatingHistoryEntry
+		if err := rows.Scan(
+			&h.ID,
+			&h.UserID,
+			&h.MatchID,
+			&h.RatingBefore,
+			&h.RatingAfter,
+			&h.Position,
+			&h.Opponents,
+			&h.CreatedAt,
+			&h.LeagueID,
+			&h.LeagueName,
+			&h.Round,
+			&h.Track,
+			&h.MatchDate,
+		); err != nil {
+			return nil, err
+		}
+		h.Delta = math.Round((h.RatingAfter-h.RatingBefore)*100) / 100
+		history = append(history, h)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func scanDriverRating(row interface{ Scan(...any) error }) (*model.DriverRating, error) {
+	rating := &model.DriverRating{}
+	err := row.Scan(
+		&rating.Rank,
+		&rating.UserID,
+		&rating.Nickname,
+		&rating.Rating,
+		&rating.PeakRating,
+		&rating.MatchesRated,
+		&rating.LastMatchID,
+		&rating.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
@@ -0,0 +1,623 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrSessionNotFound       = errors.New("session not found")
+	ErrDuplicateSessionOrder = errors.New("session order already exists for this match")
+	ErrResultsNotProvisional = errors.New("session results are not provisional")
+)
+
+const sessionOrderConstraint = `pq: duplicate key value violates unique constraint "match_sessions_match_id_session_order_key"`
+
+// sessionTimezone selects the timezone of the league a session row belongs to
+const sessionTimezone = `(SELECT l.timezone FROM matches lm JOIN leagues l ON l.id = lm.league_id WHERE lm.id = match_sessions.match_id)`
+
+const sessionColumns = `
+	id, match_id, type, name, session_order, session_date::text, session_time::text, starts_at, ` + sessionTimezone + `,
+	status, reverse_grid_size, duration_minutes, created_at, updated_at,
+	results_status, results_submitted_at, results_official_at
+`
+
+// sessionStartsAt builds the SQL expression turning a session's local date and time into its start instant,
+// using the timezone of the league the match belongs to
+func sessionStartsAt(matchID, date, timeOfDay string) string {
+	return `((` + date + `::date + COALESCE(` + timeOfDay + `::time, TIME '00:00')) AT TIME ZONE (
+		SELECT l.timezone FROM matches lm JOIN leagues l ON l.id = lm.league_id WHERE lm.id = ` + matchID + `))`
+}
+
+type SessionRepository struct {
+	db *database.DB
+}
+
+func NewSessionRepository(db *database.DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+func scanSession(row interface{ Scan(...any) error }) (*model.MatchSession, error) {
+	s := &model.MatchSession{}
+	var timezone string
+	err := row.Scan(
+		&s.ID,
+		&s.MatchID,
+		&s.Type,
+		&s.Name,
+		&s.Order,
+		&s.SessionDate,
+		&s.SessionTime,
+		&s.StartsAt,
+		&timezone,
+		&s.Status,
+		&s.ReverseGridSize,
+		&s.DurationMinutes,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+		&s.ResultsStatus,
+		&s.ResultsSubmittedAt,
+		&s.ResultsOfficialAt,
+	)
+	inTimezone(s.StartsAt, timezone)
+	return s, err
+}
+
+// Create adds a session to a match. A zero order appends the session after the match's last session.
+func (r *SessionRepository) Create(ctx context.Context, session *model.MatchSession) error {
+	query := `
+		INSERT INTO match_sessions (match_id, type, name, session_order, session_date, session_time, status, reverse_grid_size, duration_minutes, starts_at)
+		VALUES ($1, $2, $3,
+		        CASE WHEN $4::int > 0 THEN $4::int
+		             ELSE (SELECT COALESCE(MAX(session_order), 0) + 1 FROM match_sessions WHERE match_id = $1) END,
+		        $5, $6, $7, $8, $9, ` + sessionStartsAt("$1", "$5", "$6") + `)
+		RETURNING id, session_order, starts_at, ` + sessionTimezone + `, created_at, updated_at
+	`
+
+	var timezone string
+	err := r.db.Pool.QueryRowContext(ctx, query,
+		session.MatchID,
+		session.Type,
+		session.Name,
+		session.Order,
+		session.SessionDate,
+		session.SessionTime,
+		session.Status,
+		session.ReverseGridSize,
+		session.DurationMinutes,
+	).Scan(&session.ID, &session.Order, &session.StartsAt, &timezone, &session.CreatedAt, &session.UpdatedAt)
+
+	if err != nil {
+		if err.Error() == sessionOrderConstraint {
+			return ErrDuplicateSessionOrder
+		}
+		return err
+	}
+
+	inTimezone(session.StartsAt, timezone)
+
+	return nil
+}
+
+// GetByID retrieves a session by ID
+func (r *SessionRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.MatchSession, error) {
+	query := `SELECT ` + sessionColumns + ` FROM match_sessions WHERE id = $1`
+
+	session, err := scanSession(r.db.Pool.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// GetByMatchAndType retrieves the first session of the given type in a match
+func (r *SessionRepository) GetByMatchAndType(ctx context.Context, matchID uuid.UUID, sessionType model.SessionType) (*model.MatchSession, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM match_sessions
+		WHERE match_id = $1 AND type = $2
+		ORDER BY session_order ASC
+		LIMIT 1
+	`
+
+	session, err := scanSession(r.db.Pool.QueryRowContext(ctx, query, matchID, sessionType))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// ListByMatch retrieves the sessions of a match in running order
+func (r *SessionRepository) ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.MatchSession, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM match_sessions
+		WHERE match_id = $1
+		ORDER BY session_order ASC
+	`
+
+	return r.list(ctx, query, matchID)
+}
+
+// ListByLeague retrieves the sessions of every match in a league, grouped by match in running order
+func (r *SessionRepository) ListByLeague(ctx context.Context, leagueID uuid.UUID) ([]*model.MatchSession, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM match_sessions
+		WHERE match_id IN (SELECT id FROM matches WHERE league_id = $1)
+		ORDER BY match_id, session_order ASC
+	`
+
+	return r.list(ctx, query, leagueID)
+}
+
+// ListUpcoming retrieves every upcoming session with a scheduled date, earliest first, skipping postponed matches
+func (r *SessionRepository) ListUpcoming(ctx context.Context) ([]*model.MatchSession, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM match_sessions
+		WHERE status = $1 AND starts_at IS NOT NULL
+		  AND NOT EXISTS (SELECT 1 FROM matches m WHERE m.id = match_sessions.match_id AND m.status = $2)
+		ORDER BY starts_at ASC
+	`
+
+	return r.list(ctx, query, model.MatchStatusUpcoming, model.MatchStatusPostponed)
+}
+
+// ListInProgress retrieves every running session, earliest start first
+func (r *SessionRepository) ListInProgress(ctx context.Context) ([]*model.MatchSession, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM match_sessions
+		WHERE status = $1
+		ORDER BY starts_at ASC NULLS LAST
+	`
+
+	return r.list(ctx, query, model.MatchStatusInProgress)
+}
+
+func (r *SessionRepository) list(ctx context.Context, query string, args ...any) ([]*model.MatchSession, error) {
+	rows, err := r.db.Pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*model.MatchSession
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// Update updates a session's schedule and settings
+func (r *SessionRepository) Update(ctx context.Context, session *model.MatchSession) error {
+	query := `
+		UPDATE match_sessions
+		SET name = $1, session_order = $2, session_date = $3, session_time = $4, status = $5,
+		    reverse_grid_size = $6, duration_minutes = $7, starts_at = ` + sessionStartsAt("match_sessions.match_id", "$3", "$4") + `,
+		    updated_at = NOW()
+		WHERE id = $8
+		RETURNING starts_at, ` + sessionTimezone + `, updated_at
+	`
+
+	var timezone string
+	err := r.db.Pool.QueryRowContext(ctx, query,
+		session.Name,
+		session.Order,
+		session.SessionDate,
+		session.SessionTime,
+		session.Status,
+		session.ReverseGridSize,
+		session.DurationMinutes,
+		session.ID,
+	).Scan(&session.StartsAt, &timezone, &session.UpdatedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSessionNotFound
+		}
+		if err.Error() == sessionOrderConstraint {
+			return ErrDuplicateSessionOrder
+		}
+		return err
+	}
+
+	inTimezone(session.StartsAt, timezone)
+	return nil
+}
+
+// UpdateStatus updates only the status of a session
+func (r *SessionRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status model.MatchStatus) error {
+	query := `
+		UPDATE match_sessions
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2
+	`
+
+	result, err := r.db.Pool.ExecContext(ctx, query, status, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a session along with its results
+func (r *SessionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM match_sessions WHERE id = $1`
+
+	result, err := r.db.Pool.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// sessionResultUpsertQuery stores one result, keeping the entered position as the pre-penalty classification
+const sessionResultUpsertQuery = `
+	INSERT INTO session_results (session_id, participant_id, team_name, position, points, points_manual, fastest_lap,
+	                             dnf, dnf_reason, time_ms, laps, original_position)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $4)
+	ON CONFLICT (session_id, participant_id)
+	DO UPDATE SET
+		team_name = COALESCE(EXCLUDED.team_name, session_results.team_name),
+		position = EXCLUDED.position,
+		points = EXCLUDED.points,
+		points_manual = EXCLUDED.points_manual,
+		fastest_lap = EXCLUDED.fastest_lap,
+		dnf = EXCLUDED.dnf,
+		dnf_reason = EXCLUDED.dnf_reason,
+		time_ms = EXCLUDED.time_ms,
+		laps = EXCLUDED.laps,
+		original_position = EXCLUDED.original_position,
+		disqualified = false,
+		updated_at = NOW()
+`
+
+// UpsertResults creates or updates the results of a session in a single transaction
+func (r *SessionRepository) UpsertResults(ctx context.Context, sessionID uuid.UUID, results []model.CreateSessionResultRequest) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, result := range results {
+		if _, err := tx.ExecContext(ctx, sessionResultUpsertQuery,
+			sessionID,
+			result.ParticipantID,
+			result.TeamName,
+			result.Position,
+			result.Points,
+			result.PointsManual,
+			result.FastestLap,
+			result.DNF,
+			result.DNFReason,
+			result.TimeMs,
+			result.Laps,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := markResultsProvisionalTx(ctx, tx, sessionID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// markResultsProvisionalTx restarts the appeal window of a session whose results were just entered
+func markResultsProvisionalTx(ctx context.Context, tx *sql.Tx, sessionID uuid.UUID) error {
+	query := `
+		UPDATE match_sessions
+		SET results_status = 'provisional', results_submitted_at = NOW(), results_official_at = NULL, updated_at = NOW()
+		WHERE id = $1
+	`
+	_, err := tx.ExecContext(ctx, query, sessionID)
+	return err
+}
+
+// DeleteResults removes every result of a session
+func (r *SessionRepository) DeleteResults(ctx context.Context, sessionID uuid.UUID) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM session_results WHERE session_id = $1`, sessionID); err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE match_sessions
+		SET results_status = NULL, results_submitted_at = NULL, results_official_at = NULL, updated_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := tx.ExecContext(ctx, query, sessionID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FinaliseResults makes the provisional results of a session official
+func (r *SessionRepository) FinaliseResults(ctx context.Context, sessionID uuid.UUID) error {
+	query := `
+		UPDATE match_sessions
+		SET results_status = 'official', results_official_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND results_status = 'provisional'
+	`
+
+	result, err := r.db.Pool.ExecContext(ctx, query, sessionID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrResultsNotProvisional
+	}
+
+	return nil
+}
+
+// FinaliseMatchResults makes every provisional session result of a match official
+// and returns the number of sessions finalised
+func (r *SessionRepository) FinaliseMatchResults(ctx context.Context, matchID uuid.UUID) (int64, error) {
+	query := `
+		UPDATE match_sessions
+		SET results_status = 'official', results_official_at = NOW(), updated_at = NOW()
+		WHERE match_id = $1 AND results_status = 'provisional'
+	`
+
+	result, err := r.db.Pool.ExecContext(ctx, query, matchID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// FinaliseExpired makes provisional results official once their league's appeal window has passed.
+// Leagues without standings rules use defaultWindowHours.
+func (r *SessionRepository) FinaliseExpired(ctx context.Context, defaultWindowHours int) (int64, error) {
+	query := `
+		UPDATE match_sessions s
+		SET results_status = 'official', results_official_at = NOW(), updated_at = NOW()
+		FROM matches m
+		LEFT JOIN standings_rules sr ON sr.league_id = m.league_id
+		WHERE s.match_id = m.id
+		  AND s.results_status = 'provisional'
+		  AND s.results_submitted_at + make_interval(hours => COALESCE(sr.appeal_window_hours, $1)) <= NOW()
+	`
+
+	result, err := r.db.Pool.ExecContext(ctx, query, defaultWindowHours)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+const sessionResultColumns = `
+	sr.id, sr.session_id, sr.participant_id, COALESCE(sub.team_name, sr.team_name), sr.position, sr.points, sr.points_manual,
+	sr.fastest_lap, sr.dnf, sr.dnf_reason, sr.time_ms, sr.laps, sr.original_position, sr.disqualified, sr.created_at, sr.updated_at,
+	u.nickname, s.type, s.match_id, su.nickname
+`
+
+// sessionResultJoins credits a reserve's results to the team they substituted for
+const sessionResultJoins = `
+	JOIN league_participants lp ON sr.participant_id = lp.id
+	JOIN users u ON lp.user_id = u.id
+	LEFT JOIN match_substitutions sub ON sub.match_id = s.match_id AND sub.reserve_participant_id = sr.participant_id
+	LEFT JOIN league_participants slp ON slp.id = sub.replaced_participant_id
+	LEFT JOIN users su ON su.id = slp.user_id
+`
+
+// ListResults retrieves the results of a session ordered by position
+func (r *SessionRepository) ListResults(ctx context.Context, sessionID uuid.UUID) ([]*model.SessionResult, error) {
+	query := `
+		SELECT ` + sessionResultColumns + `
+		FROM session_results sr
+		JOIN match_sessions s ON sr.session_id = s.id
+		` + sessionResultJoins + `
+		WHERE sr.session_id = $1
+		ORDER BY sr.position ASC NULLS LAST
+	`
+
+	return r.listResults(ctx, query, sessionID)
+}
+
+// ListResultsByMatch retrieves the results of every session in a match, in session order
+func (r *SessionRepository) ListResultsByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.SessionResult, error) {
+	query := `
+		SELECT ` + sessionResultColumns + `
+		FROM session_results sr
+		JOIN match_sessions s ON sr.session_id = s.id
+		` + sessionResultJoins + `
+		WHERE s.match_id = $1
+		ORDER BY s.session_order ASC, sr.position ASC NULLS LAST
+	`
+
+	return r.listResults(ctx, query, matchID)
+}
+
+// ListResultsByLeague retrieves the results of the given session types for every match in a league
+func (r *SessionRepository) ListResultsByLeague(ctx context.Context, leagueID uuid.UUID, types []model.SessionType) ([]*model.SessionResult, error) {
+	query := `
+		SELECT ` + sessionResultColumns + `
+		FROM session_results sr
+		JOIN match_sessions s ON sr.session_id = s.id
+		JOIN matches m ON s.match_id = m.id
+		` + sessionResultJoins + `
+		WHERE m.league_id = $1 AND s.type = ANY($2)
+		ORDER BY m.round ASC, s.session_order ASC, sr.position ASC NULLS LAST
+	`
+
+	return r.listResults(ctx, query, leagueID, sessionTypeArray(types))
+}
+
+func (r *SessionRepository) listResults(ctx context.Context, query string, args ...any) ([]*model.SessionResult, error) {
+	rows, err := r.db.Pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*model.SessionResult
+	for rows.Next() {
+		sr := &model.SessionResult{}
+		if err := rows.Scan(
+			&sr.ID,
+			&sr.SessionID,
+			&sr.ParticipantID,
+			&sr.TeamName,
+			&sr.Position,
+			&sr.Points,
+			&sr.PointsManual,
+			&sr.FastestLap,
+			&sr.DNF,
+			&sr.DNFReason,
+			&sr.TimeMs,
+			&sr.Laps,
+			&sr.OriginalPosition,
+			&sr.Disqualified,
+			&sr.CreatedAt,
+			&sr.UpdatedAt,
+			&sr.ParticipantName,
+			&sr.SessionType,
+			&sr.MatchID,
+			&sr.SubstituteFor,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, sr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// UpdateResultPoints writes recalculated points for the given session results in a single transaction
+func (r *SessionRepository) UpdateResultPoints(ctx context.Context, results []*model.SessionResult) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := updateResultPointsTx(ctx, tx, results); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func updateResultPointsTx(ctx context.Context, tx *sql.Tx, results []*model.SessionResult) error {
+	query := `
+		UPDATE session_results
+		SET points = $1, updated_at = NOW()
+		WHERE id = $2
+	`
+
+	for _, result := range results {
+		if _, err := tx.ExecContext(ctx, query, result.Points, result.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateClassification writes reclassified positions, points and disqualification flags of session results.
+// A newly issued penalty, or the revocation of one, is stored in the same transaction, so it is only kept
+// along with its classification.
+func (r *SessionRepository) UpdateClassification(ctx context.Context, results []*model.SessionResult, issued, revoked *model.Penalty) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if issued != nil {
+		if err := createPenaltyTx(ctx, tx, issued); err != nil {
+			return err
+		}
+	}
+	if revoked != nil {
+		if err := revokePenaltyTx(ctx, tx, revoked.ID, *revoked.RevokedBy); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		UPDATE session_results
+		SET position = $1, points = $2, disqualified = $3, updated_at = NOW()
+		WHERE id = $4
+	`
+
+	for _, result := range results {
+		if _, err := tx.ExecContext(ctx, query, result.Position, result.Points, result.Disqualified, result.ID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func sessionTypeArray(types []model.SessionType) pq.StringArray {
+	arr := make(pq.StringArray, len(types))
+	for i, t := range types {
+		arr[i] = string(t)
+	}
+	return arr
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrStandingsRulesNotFound = errors.New("standings rules not found")
+)
+
+type StandingsRulesRepository struct {
+	db *database.DB
+}
+
+func NewStandingsRulesRepository(db *database.DB) *StandingsRulesRepository {
+	return &StandingsRulesRepository{db: db}
+}
+
+// GetByLeague retrieves the standings rules configured for a league
+func (r *StandingsRulesRepository) GetByLeague(ctx context.Context, leagueID uuid.UUID) (*model.StandingsRules, error) {
+	query := `
+		SELECT id, league_id, counted_rounds, drop_worst_rounds, apply_to_teams, appeal_window_hours,
+		       reserve_points_mode, reserve_round_cap, created_at, updated_at
+		FROM standings_rules
+		WHERE league_id = $1
+	`
+
+	rules := &model.StandingsRules{}
+	err := r.db.Pool.QueryRowContext(ctx, query, leagueID).Scan(
+		&rules.ID,
+		&rules.LeagueID,
+		&rules.CountedRounds,
+		&rules.DropWorstRounds,
+		&rules.ApplyToTeams,
+		&rules.AppealWindowHours,
+		&rules.ReservePointsMode,
+		&rules.ReserveRoundCap,
+		&rules.CreatedAt,
+		&rules.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrStandingsRulesNotFound
+		}
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// Upsert creates or replaces the standings rules for a league
+func (r *StandingsRulesRepository) Upsert(ctx context.Context, rules *model.StandingsRules) error {
+	query := `
+		INSERT INTO standings_rules (league_id, counted_rounds, drop_worst_rounds, apply_to_teams, appeal_window_hours, reserve_points_mode, reserve_round_cap)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (league_id)
+		DO UPDATE SET
+			counted_rounds = EXCLUDED.counted_rounds,
+			drop_worst_rounds = EXCLUDED.drop_worst_rounds,
+			apply_to_teams = EXCLUDED.apply_to_teams,
+			appeal_window_hours = EXCLUDED.appeal_window_hours,
+			reserve_points_mode = EXCLUDED.reserve_points_mode,
+			reserve_round_cap = EXCLUDED.reserve_round_cap,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	return r.db.Pool.QueryRowContext(ctx, query,
+		rules.LeagueID,
+		rules.CountedRounds,
+		rules.DropWorstRounds,
+		rules.ApplyToTeams,
+		rules.AppealWindowHours,
+		rules.ReservePointsMode,
+		rules.ReserveRoundCap,
+	).Scan(&rules.ID, &rules.CreatedAt, &rules.UpdatedAt)
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSubstitutionNotFound  = errors.New("substitution not found")
+	ErrReserveAlreadyUsed    = errors.New("reserve already substituting in this match")
+	ErrDriverAlreadyReplaced = errors.New("driver already replaced in this match")
+)
+
+type SubstitutionRepository struct {
+	db *database.DB
+}
+
+func NewSubstitutionRepository(db *database.DB) *SubstitutionRepository {
+	return &SubstitutionRepository{db: db}
+}
+
+// Create records a reserve standing in for a driver in a match
+func (r *SubstitutionRepository) Create(ctx context.Context, sub *model.Substitution) error {
+	query := `
+		INSERT INTO match_substitutions (match_id, reserve_participant_id, replaced_participant_id, team_name, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err := r.db.Pool.QueryRowContext(ctx, query,
+		sub.MatchID,
+		sub.ReserveParticipantID,
+		sub.ReplacedParticipantID,
+		sub.TeamName,
+		sub.CreatedBy,
+	).Scan(&sub.ID, &sub.CreatedAt)
+
+	if err != nil {
+		switch err.Error() {
+		case `pq: duplicate key value violates unique constraint "uq_match_substitutions_reserve"`:
+			return ErrReserveAlreadyUsed
+		case `pq: duplicate key value violates unique constraint "uq_match_substitutions_replaced"`:
+			return ErrDriverAlreadyReplaced
+		}
+		return err
+	}
+
+	return nil
+}
+
+const substitutionColumns = `
+	ms.id, ms.match_id, ms.reserve_participant_id, ms.replaced_participant_id, ms.team_name, ms.created_by, ms.created_at,
+	ru.nickname, pu.nickname
+`
+
+const substitutionJoins = `
+	JOIN league_participants rlp ON rlp.id = ms.reserve_participant_id
+	JOIN users ru ON ru.id = rlp.user_id
+	JOIN league_participants plp ON plp.id = ms.replaced_participant_id
+	JOIN users pu ON pu.id = plp.user_id
+`
+
+// GetByID retrieves a substitution by ID
+func (r *SubstitutionRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Substitution, error) {
+	query := `SELECT ` + substitutionColumns + ` FROM match_substitutions ms ` + substitutionJoins + ` WHERE ms.id = $1`
+
+	sub, err := scanSubstitution(r.db.Pool.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSubstitutionNotFound
+		}
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// ListByMatch retrieves every substitution recorded for a match
+func (r *SubstitutionRepository) ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.Substitution, error) {
+	query := `
+		SELECT ` + substitutionColumns + `
+		FROM match_substitutions ms
+		` + substitutionJoins + `
+		WHERE ms.match_id = $1
+		ORDER BY ms.team_name, ms.created_at
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []*model.Substitution
+	for rows.Next() {
+		sub, err := scanSubstitution(rows)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+// Delete removes a substitution
+func (r *SubstitutionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.ExecContext(ctx, `DELETE FROM match_substitutions WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrSubstitutionNotFound
+	}
+
+	return nil
+}
+
+func scanSubstitution(row interface{ Scan(...any) error }) (*model.Substitution, error) {
+	sub := &model.Substitution{}
+	err := row.Scan(
+		&sub.ID,
+		&sub.MatchID,
+		&sub.ReserveParticipantID,
+		&sub.ReplacedParticipantID,
+		&sub.TeamName,
+		&sub.CreatedBy,
+		&sub.CreatedAt,
+		&sub.ReserveName,
+		&sub.ReplacedName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTelemetryClassificationNotFound = errors.New("telemetry classification not found")
+)
+
+type TelemetryRepository struct {
+	db *database.DB
+}
+
+func NewTelemetryRepository(db *database.DB) *TelemetryRepository {
+	return &TelemetryRepository{db: db}
+}
+
+// ReplaceSession replaces the provisional classification of a match session in a single transaction
+func (r *TelemetryRepository) ReplaceSession(ctx context.Context, matchID uuid.UUID, sessionType model.SessionType, results []*model.TelemetryClassification) error {
+	tx, err := r.db.Pool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM telemetry_classifications WHERE match_id = $1 AND session_type = $2`, matchID, sessionType); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO telemetry_classifications (match_id, session_type, car_index, participant_id, driver_name, race_number, position,
+		                                       grid_position, laps, best_lap_ms, total_time_ms, penalties_sec, pit_stops, result_status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING id, created_at
+	`
+
+	for _, result := range results {
+		if err := tx.QueryRowContext(ctx, query,
+			matchID,
+			sessionType,
+			result.CarIndex,
+			result.ParticipantID,
+			result.DriverName,
+			result.RaceNumber,
+			result.Position,
+			result.GridPosition,
+			result.Laps,
+			result.BestLapMs,
+			result.TotalTimeMs,
+			result.PenaltiesSec,
+			result.PitStops,
+			result.ResultStatus,
+		).Scan(&result.ID, &result.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+const telemetryClassificationColumns = `
+	tc.id, tc.match_id, tc.session_type, tc.car_index, tc.participant_id, tc.driver_name, tc.race_number, tc.position,
+	tc.grid_position, tc.laps, tc.best_lap_ms, tc.total_time_ms, tc.penalties_sec, tc.pit_stops, tc.result_status,
+	tc.created_at, u.nickname
+`
+
+// GetByID retrieves a provisional classification row by ID
+func (r *TelemetryRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.TelemetryClassification, error) {
+	query := `
+		SELECT ` + telemetryClassificationColumns + `
+		FROM telemetry_classifications tc
+		LEFT JOIN league_participants lp ON tc.participant_id = lp.id
+		LEFT JOIN users u ON lp.user_id = u.id
+		WHERE tc.id = $1
+	`
+
+	results, err := r.list(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, ErrTelemetryClassificationNotFound
+	}
+
+	return results[0], nil
+}
+
+// ListByMatch retrieves the provisional classifications of a match ordered by session and position
+func (r *TelemetryRepository) ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*model.TelemetryClassification, error) {
+	query := `
+		SELECT ` + telemetryClassificationColumns + `
+		FROM telemetry_classifications tc
+		LEFT JOIN league_participants lp ON tc.participant_id = lp.id
+		LEFT JOIN users u ON lp.user_id = u.id
+		WHERE tc.match_id = $1
+		ORDER BY tc.session_type ASC, tc.position ASC
+	`
+
+	return r.list(ctx, query, matchID)
+}
+
+func (r *TelemetryRepository) list(ctx context.Context, query string, args ...any) ([]*model.TelemetryClassification, error) {
+	rows, err := r.db.Pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*model.TelemetryClassification
+	for rows.Next() {
+		tc := &model.TelemetryClassification{}
+		if err := rows.Scan(
+			&tc.ID,
+			&tc.MatchID,
+			&tc.SessionType,
+			&tc.CarIndex,
+			&tc.ParticipantID,
+			&tc.DriverName,
+			&tc.RaceNumber,
+			&tc.Position,
+			&tc.GridPosition,
+			&tc.Laps,
+			&tc.BestLapMs,
+			&tc.TotalTimeMs,
+			&tc.PenaltiesSec,
+			&tc.PitStops,
+			&tc.ResultStatus,
+			&tc.CreatedAt,
+			&tc.ParticipantName,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, tc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// AssignParticipant links a provisional classification row to a league participant
+func (r *TelemetryRepository) AssignParticipant(ctx context.Context, id, participantID uuid.UUID) error {
+	query := `UPDATE telemetry_classifications SET participant_id = $1 WHERE id = $2`
+
+	result, err := r.db.Pool.ExecContext(ctx, query, participantID, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrTelemetryClassificationNotFound
+	}
+
+	return nil
+}
+
+// DeleteSession removes the provisional classification of a match session
+func (r *TelemetryRepository) DeleteSession(ctx context.Context, matchID uuid.UUID, sessionType model.SessionType) error {
+	query := `DELETE FROM telemetry_classifications WHERE match_id = $1 AND session_type = $2`
+	_, err := r.db.Pool.ExecContext(ctx, query, matchID, sessionType)
+	return err
+}
@@ -0,0 +1,342 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/f1-rivals-cup/backend/internal/database"
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTrackNotFound   = errors.New("track not found")
+	ErrTrackSlugExists = errors.New("track slug already exists")
+	ErrTrackInUse      = errors.New("track is used by matches")
+)
+
+type TrackRepository struct {
+	db *database.DB
+}
+
+func NewTrackRepository(db *database.DB) *TrackRepository {
+	return &TrackRepository{db: db}
+}
+
+const trackColumns = `
+	id, slug, name, country, country_code, layout, full_race_laps, length_km, default_race_laps, aliases, created_at, updated_at
+`
+
+// Create adds a track to the catalogue
+func (r *TrackRepository) Create(ctx context.Context, track *model.Track) error {
+	query := `
+		INSERT INTO tracks (slug, name, country, country_code, layout, full_race_laps, length_km, default_race_laps, aliases)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.Pool.QueryRowContext(ctx, query,
+		track.Slug,
+		track.Name,
+		track.Country,
+		track.CountryCode,
+		track.Layout,
+		track.FullRaceLaps,
+		track.LengthKm,
+		track.DefaultRaceLaps,
+		track.Aliases,
+	).Scan(&track.ID, &track.CreatedAt, &track.UpdatedAt)
+
+	if err != nil {
+		if err.Error() == `pq: duplicate key value violates unique constraint "tracks_slug_key"` {
+			return ErrTrackSlugExists
+		}
+		return err
+	}
+
+	track.Flag = countryFlag(track.CountryCode)
+	return nil
+}
+
+// GetByID retrieves a track by ID
+func (r *TrackRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Track, error) {
+	query := `SELECT ` + trackColumns + ` FROM tracks WHERE id = $1`
+
+	track, err := scanTrack(r.db.Pool.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTrackNotFound
+		}
+		return nil, err
+	}
+
+	return track, nil
+}
+
+// Resolve finds the catalogue track a free-text name refers to by its name, slug or one of its aliases.
+// A trailing "Grand Prix", "GP" or "그랑프리" is ignored.
+func (r *TrackRepository) Resolve(ctx context.Context, name string) (*model.Track, error) {
+	query := `
+		SELECT ` + trackColumns + `
+		FROM tracks
+		WHERE LOWER(name) = $1 OR slug = $1 OR $1 = ANY(aliases)
+		   OR REGEXP_REPLACE($1, '\s*(grand prix|gp|그랑프리|서킷)$', '') = ANY(aliases)
+		ORDER BY LOWER(name) = $1 DESC, slug = $1 DESC
+		LIMIT 1
+	`
+
+	track, err := scanTrack(r.db.Pool.QueryRowContext(ctx, query, strings.ToLower(strings.TrimSpace(name))))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTrackNotFound
+		}
+		return nil, err
+	}
+
+	return track, nil
+}
+
+// List retrieves catalogue tracks ordered by name, optionally filtered by a search term
+// matched against the name, country and aliases
+func (r *TrackRepository) List(ctx context.Context, search string) ([]*model.Track, error) {
+	query := `
+		SELECT ` + trackColumns + `
+		FROM tracks
+		WHERE $1 = ''
+		   OR name ILIKE '%' || $1 || '%'
+		   OR country ILIKE '%' || $1 || '%'
+		   OR EXISTS (SELECT 1 FROM unnest(aliases) a WHERE a LIKE '%' || LOWER($1) || '%')
+		ORDER BY name ASC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, strings.TrimSpace(search))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tracks []*model.Track
+	for rows.Next() {
+		track, err := scanTrack(rows)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, track)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+// Update saves a track's details
+func (r *TrackRepository) Update(ctx context.Context, track *model.Track) error {
+	query := `
+		UPDATE tracks
+		SET name = $1, country = $2, country_code = $3, layout = $4, full_race_laps = $5, length_km = $6,
+		    default_race_laps = $7, aliases = $8, updated_at = NOW()
+		WHERE id = $9
+		RETURNING updated_at
+	`
+
+	err := r.db.Pool.QueryRowContext(ctx, query,
+		track.Name,
+		track.Country,
+		track.CountryCode,
+		track.Layout,
+		track.FullRaceLaps,
+		track.LengthKm,
+		track.DefaultRaceLaps,
+		track.Aliases,
+		track.ID,
+	).Scan(&track.UpdatedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTrackNotFound
+		}
+		return err
+	}
+
+	track.Flag = countryFlag(track.CountryCode)
+	return nil
+}
+
+// Delete removes a track that no match refers to
+func (r *TrackRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Pool.ExecContext(ctx, `DELETE FROM tracks WHERE id = $1`, id)
+	if err != nil {
+		if strings.Contains(err.Error(), `violates foreign key constraint "matches_track_id_fkey"`) {
+			return ErrTrackInUse
+		}
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrTrackNotFound
+	}
+
+	return nil
+}
+
+// CountRaces counts completed matches held at a track, optionally within one league
+func (r *TrackRepository) CountRaces(ctx context.Context, trackID uuid.UUID, leagueID *uuid.UUID) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM matches
+		WHERE track_id = $1 AND status = 'completed' AND ($2::uuid IS NULL OR league_id = $2)
+	`
+
+	var count int
+	err := r.db.Pool.QueryRowContext(ctx, query, trackID, leagueID).Scan(&count)
+	return count, err
+}
+
+// ListWinners retrieves the race winners of completed matches at a track, most recent first
+func (r *TrackRepository) ListWinners(ctx context.Context, trackID uuid.UUID, leagueID *uuid.UUID) ([]model.TrackWinner, error) {
+	query := `
+		SELECT m.id, l.id, l.name, l.season, m.round, m.match_date::text, lp.user_id, u.nickname,
+		       COALESCE(sub.team_name, sr.team_name)
+		FROM matches m
+		JOIN leagues l ON l.id = m.league_id
+		JOIN match_sessions s ON s.match_id = m.id AND s.type = 'race'
+		JOIN session_results sr ON sr.session_id = s.id AND sr.position = 1 AND NOT sr.dnf AND NOT sr.disqualified
+		JOIN league_participants lp ON lp.id = sr.participant_id
+		JOIN users u ON u.id = lp.user_id
+		LEFT JOIN match_substitutions sub ON sub.match_id = m.id AND sub.reserve_participant_id = sr.participant_id
+		WHERE m.track_id = $1
+		  AND m.status = 'completed'
+		  AND ($2::uuid IS NULL OR m.league_id = $2)
+		ORDER BY m.starts_at DESC, m.round DESC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, trackID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	winners := []model.TrackWinner{}
+	for rows.Next() {
+		var w model.TrackWinner
+		if err := rows.Scan(
+			&w.MatchID,
+			&w.LeagueID,
+			&w.LeagueName,
+			&w.Season,
+			&w.Round,
+			&w.MatchDate,
+			&w.UserID,
+			&w.DriverName,
+			&w.TeamName,
+		); err != nil {
+			return nil, err
+		}
+		winners = append(winners, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return winners, nil
+}
+
+// ListLapRecords retrieves the fastest lap set at a track in each league, taken from
+// qualifying best laps and confirmed-driver telemetry, fastest first
+func (r *TrackRepository) ListLapRecords(ctx context.Context, trackID uuid.UUID, leagueID *uuid.UUID) ([]model.TrackLapRecord, error) {
+	query := `
+		WITH laps AS (
+			SELECT qr.match_id, qr.participant_id, qr.best_lap_ms AS lap_ms, qr.session::text AS source
+			FROM qualifying_results qr
+			WHERE qr.best_lap_ms IS NOT NULL
+			UNION ALL
+			SELECT tc.match_id, tc.participant_id, tc.best_lap_ms, 'telemetry'
+			FROM telemetry_classifications tc
+			WHERE tc.best_lap_ms IS NOT NULL AND tc.participant_id IS NOT NULL
+		)
+		SELECT * FROM (
+			SELECT DISTINCT ON (m.league_id) m.league_id, l.name, l.season, m.id, m.round, lp.user_id, u.nickname,
+			       laps.lap_ms, laps.source
+			FROM laps
+			JOIN matches m ON m.id = laps.match_id
+			JOIN leagues l ON l.id = m.league_id
+			JOIN league_participants lp ON lp.id = laps.participant_id
+			JOIN users u ON u.id = lp.user_id
+			WHERE m.track_id = $1
+			  AND ($2::uuid IS NULL OR m.league_id = $2)
+			ORDER BY m.league_id, laps.lap_ms ASC, m.starts_at ASC
+		) records
+		ORDER BY lap_ms ASC
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, trackID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := []model.TrackLapRecord{}
+	for rows.Next() {
+		var rec model.TrackLapRecord
+		if err := rows.Scan(
+			&rec.LeagueID,
+			&rec.LeagueName,
+			&rec.Season,
+			&rec.MatchID,
+			&rec.Round,
+			&rec.UserID,
+			&rec.DriverName,
+			&rec.LapTimeMs,
+			&rec.Source,
+		); err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func scanTrack(row interface{ Scan(...any) error }) (*model.Track, error) {
+	track := &model.Track{}
+	err := row.Scan(
+		&track.ID,
+		&track.Slug,
+		&track.Name,
+		&track.Country,
+		&track.CountryCode,
+		&track.Layout,
+		&track.FullRaceLaps,
+		&track.LengthKm,
+		&track.DefaultRaceLaps,
+		&track.Aliases,
+		&track.CreatedAt,
+		&track.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	track.Flag = countryFlag(track.CountryCode)
+	return track, nil
+}
+
+// countryFlag converts an ISO 3166-1 alpha-2 country code into its emoji flag
+func countryFlag(code string) string {
+	code = strings.ToUpper(code)
+	if len(code) != 2 || code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+		return ""
+	}
+	return string([]rune{rune(code[0]) - 'A' + 0x1F1E6, rune(code[1]) - 'A' + 0x1F1E6})
+}
@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/service"
+)
+
+// AttendanceScheduler closes RSVPs at their deadline and passes unanswered reserve offers on
+type AttendanceScheduler struct {
+	attendanceService *service.AttendanceService
+	interval          time.Duration
+	stopCh            chan struct{}
+	stopOnce          sync.Once
+}
+
+// NewAttendanceScheduler creates a new AttendanceScheduler instance
+func NewAttendanceScheduler(attendanceService *service.AttendanceService, interval time.Duration) *AttendanceScheduler {
+	return &AttendanceScheduler{
+		attendanceService: attendanceService,
+		interval:          interval,
+		stopCh:            make(chan struct{}),
+	}
+}
+
+// Start begins the scheduler loop
+func (s *AttendanceScheduler) Start(ctx context.Context) {
+	slog.Info("AttendanceScheduler started", "interval", s.interval)
+
+	// Run immediately on start
+	s.run(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("AttendanceScheduler stopping due to context cancellation")
+			return
+		case <-s.stopCh:
+			slog.Info("AttendanceScheduler stopped")
+			return
+		case <-ticker.C:
+			s.run(ctx)
+		}
+	}
+}
+
+// Stop signals the scheduler to stop (idempotent)
+func (s *AttendanceScheduler) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+func (s *AttendanceScheduler) run(ctx context.Context) {
+	expired, err := s.attendanceService.ExpireOffers(ctx, time.Now())
+	if err != nil {
+		slog.Error("AttendanceScheduler: failed to expire reserve offers", "error", err)
+	} else if expired > 0 {
+		slog.Info("AttendanceScheduler: expired reserve offers", "count", expired)
+	}
+
+	strikes, err := s.attendanceService.CloseRSVPs(ctx)
+	if err != nil {
+		slog.Error("AttendanceScheduler: failed to close rsvps", "error", err)
+	} else if strikes > 0 {
+		slog.Info("AttendanceScheduler: recorded missed rsvp strikes", "count", strikes)
+	}
+}
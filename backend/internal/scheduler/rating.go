@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/service"
+)
+
+// RatingScheduler replays driver ratings when matches have completed since the last run
+type RatingScheduler struct {
+	ratingService *service.RatingService
+	interval      time.Duration
+	stopCh        chan struct{}
+	stopOnce      sync.Once
+}
+
+// NewRatingScheduler creates a new RatingScheduler instance
+func NewRatingScheduler(ratingService *service.RatingService, interval time.Duration) *RatingScheduler {
+	return &RatingScheduler{
+		ratingService: ratingService,
+		interval:      interval,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start begins the scheduler loop
+func (s *RatingScheduler) Start(ctx context.Context) {
+	slog.Info("RatingScheduler started", "interval", s.interval)
+
+	// Run immediately on start
+	s.updateRatings(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("RatingScheduler stopping due to context cancellation")
+			return
+		case <-s.stopCh:
+			slog.Info("RatingScheduler stopped")
+			return
+		case <-ticker.C:
+			s.updateRatings(ctx)
+		}
+	}
+}
+
+// Stop signals the scheduler to stop (idempotent)
+func (s *RatingScheduler) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+func (s *RatingScheduler) updateRatings(ctx context.Context) {
+	result, err := s.ratingService.ProcessPending(ctx)
+	if err != nil {
+		slog.Error("RatingScheduler: failed to update ratings", "error", err)
+		return
+	}
+	if result != nil {
+		slog.Info("RatingScheduler: recomputed ratings", "matches", result.Matches, "drivers", result.Drivers)
+	}
+}
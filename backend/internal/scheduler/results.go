@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/repository"
+)
+
+// ResultsScheduler makes provisional session results official once the appeal window has passed
+type ResultsScheduler struct {
+	sessionRepo        *repository.SessionRepository
+	defaultWindowHours int
+	interval           time.Duration
+	stopCh             chan struct{}
+	stopOnce           sync.Once
+}
+
+// NewResultsScheduler creates a new ResultsScheduler instance
+func NewResultsScheduler(sessionRepo *repository.SessionRepository, defaultWindowHours int, interval time.Duration) *ResultsScheduler {
+	return &ResultsScheduler{
+		sessionRepo:        sessionRepo,
+		defaultWindowHours: defaultWindowHours,
+		interval:           interval,
+		stopCh:             make(chan struct{}),
+	}
+}
+
+// Start begins the scheduler loop
+func (s *ResultsScheduler) Start(ctx context.Context) {
+	slog.Info("ResultsScheduler started", "interval", s.interval)
+
+	// Run immediately on start
+	s.finaliseResults(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("ResultsScheduler stopping due to context cancellation")
+			return
+		case <-s.stopCh:
+			slog.Info("ResultsScheduler stopped")
+			return
+		case <-ticker.C:
+			s.finaliseResults(ctx)
+		}
+	}
+}
+
+// Stop signals the scheduler to stop (idempotent)
+func (s *ResultsScheduler) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+func (s *ResultsScheduler) finaliseResults(ctx context.Context) {
+	count, err := s.sessionRepo.FinaliseExpired(ctx, s.defaultWindowHours)
+	if err != nil {
+		slog.Error("ResultsScheduler: failed to finalise results", "error", err)
+		return
+	}
+	if count > 0 {
+		slog.Info("ResultsScheduler: finalised provisional results", "count", count)
+	}
+}
@@ -3,36 +3,26 @@ package scheduler
 import (
 	"context"
 	"log/slog"
-	"strings"
 	"sync"
 	"time"
 
-	"github.com/f1-rivals-cup/backend/internal/model"
-	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/service"
 )
 
-// MatchScheduler handles automatic match status updates
+// MatchScheduler drives the automatic match and session lifecycle
 type MatchScheduler struct {
-	matchRepo *repository.MatchRepository
+	lifecycle *service.MatchLifecycleService
 	interval  time.Duration
 	stopCh    chan struct{}
 	stopOnce  sync.Once
-	location  *time.Location
 }
 
 // New creates a new MatchScheduler instance
-func New(matchRepo *repository.MatchRepository, interval time.Duration) *MatchScheduler {
-	loc, err := time.LoadLocation("Asia/Seoul")
-	if err != nil {
-		slog.Warn("Failed to load Asia/Seoul timezone, using UTC", "error", err)
-		loc = time.UTC
-	}
-
+func New(lifecycle *service.MatchLifecycleService, interval time.Duration) *MatchScheduler {
 	return &MatchScheduler{
-		matchRepo: matchRepo,
+		lifecycle: lifecycle,
 		interval:  interval,
 		stopCh:    make(chan struct{}),
-		location:  loc,
 	}
 }
 
@@ -67,72 +57,22 @@ func (s *MatchScheduler) Stop() {
 	})
 }
 
-// checkAndUpdateMatches checks upcoming matches and updates their status if needed
+// checkAndUpdateMatches starts and completes sessions and matches whose time has come
 func (s *MatchScheduler) checkAndUpdateMatches(ctx context.Context) {
-	now := time.Now().In(s.location)
-	slog.Debug("MatchScheduler checking matches", "time", now)
+	now := time.Now()
+	slog.Debug("MatchScheduler checking sessions", "time", now)
 
-	matches, err := s.matchRepo.ListUpcomingMatches(ctx)
+	result, err := s.lifecycle.Advance(ctx, now)
 	if err != nil {
-		slog.Error("MatchScheduler: failed to list upcoming matches", "error", err)
+		slog.Error("MatchScheduler: failed to advance match lifecycle", "error", err)
 		return
 	}
 
-	if len(matches) == 0 {
-		slog.Debug("MatchScheduler: no upcoming matches found")
-		return
-	}
-
-	for _, match := range matches {
-		matchTime, err := s.parseMatchDateTime(match.MatchDate, match.MatchTime)
-		if err != nil {
-			slog.Warn("MatchScheduler: failed to parse match datetime",
-				"match_id", match.ID,
-				"match_date", match.MatchDate,
-				"match_time", match.MatchTime,
-				"error", err)
-			continue
-		}
-
-		if now.After(matchTime) || now.Equal(matchTime) {
-			if err := s.matchRepo.UpdateStatus(ctx, match.ID, model.MatchStatusInProgress); err != nil {
-				slog.Error("MatchScheduler: failed to update match status",
-					"match_id", match.ID,
-					"error", err)
-				continue
-			}
-			slog.Info("MatchScheduler: match status updated to in_progress",
-				"match_id", match.ID,
-				"track", match.Track,
-				"round", match.Round,
-				"match_time", matchTime)
-		}
-	}
-}
-
-// parseMatchDateTime parses date and optional time into time.Time.
-// date may be "2006-01-02" or ISO 8601 "2006-01-02T15:04:05Z".
-func (s *MatchScheduler) parseMatchDateTime(dateStr string, timeStr *string) (time.Time, error) {
-	// Normalise ISO 8601 date to plain YYYY-MM-DD
-	if idx := strings.IndexByte(dateStr, 'T'); idx != -1 {
-		dateStr = dateStr[:idx]
-	}
-
-	// Default time to 00:00:00 if not provided
-	timeVal := "00:00:00"
-	if timeStr != nil && *timeStr != "" {
-		timeVal = *timeStr
+	if result.SessionsStarted+result.SessionsCompleted+result.MatchesStarted+result.MatchesCompleted > 0 {
+		slog.Info("MatchScheduler: updated match statuses",
+			"sessions_started", result.SessionsStarted,
+			"sessions_completed", result.SessionsCompleted,
+			"matches_started", result.MatchesStarted,
+			"matches_completed", result.MatchesCompleted)
 	}
-
-	// Parse combined datetime with multiple format support
-	combined := dateStr + " " + timeVal
-
-	// Try HH:MM:SS format first
-	t, err := time.ParseInLocation("2006-01-02 15:04:05", combined, s.location)
-	if err == nil {
-		return t, nil
-	}
-
-	// Try HH:MM format (no seconds)
-	return time.ParseInLocation("2006-01-02 15:04", combined, s.location)
 }
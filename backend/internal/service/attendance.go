@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+// Attendance rules used when a league has not configured its own
+const (
+	DefaultRSVPDeadlineHours   = 24
+	DefaultOfferTimeoutMinutes = 120
+	DefaultMissedRSVPStrikes   = 1
+)
+
+var ErrRSVPClosed = errors.New("rsvp deadline has passed")
+
+// DefaultAttendanceSettings returns the RSVP rules used when a league has not configured its own
+func DefaultAttendanceSettings(leagueID uuid.UUID) *model.AttendanceSettings {
+	return &model.AttendanceSettings{
+		LeagueID:            leagueID,
+		RSVPDeadlineHours:   DefaultRSVPDeadlineHours,
+		OfferTimeoutMinutes: DefaultOfferTimeoutMinutes,
+		MissedRSVPStrikes:   DefaultMissedRSVPStrikes,
+		IsDefault:           true,
+	}
+}
+
+// AttendanceService collects drivers' RSVPs and calls up reserves for the seats they leave empty
+type AttendanceService struct {
+	attendanceRepo   *repository.AttendanceRepository
+	matchRepo        *repository.MatchRepository
+	substitutionRepo *repository.SubstitutionRepository
+}
+
+// NewAttendanceService creates a new AttendanceService
+func NewAttendanceService(attendanceRepo *repository.AttendanceRepository, matchRepo *repository.MatchRepository, substitutionRepo *repository.SubstitutionRepository) *AttendanceService {
+	return &AttendanceService{
+		attendanceRepo:   attendanceRepo,
+		matchRepo:        matchRepo,
+		substitutionRepo: substitutionRepo,
+	}
+}
+
+// SettingsFor returns the RSVP rules of a league, falling back to the defaults
+func (s *AttendanceService) SettingsFor(ctx context.Context, leagueID uuid.UUID) (*model.AttendanceSettings, error) {
+	settings, err := s.attendanceRepo.GetSettings(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAttendanceSettingsNotFound) {
+			return DefaultAttendanceSettings(leagueID), nil
+		}
+		return nil, err
+	}
+	return settings, nil
+}
+
+// Respond saves a driver's RSVP. Declining offers the seat to the first available reserve;
+// confirming withdraws any offer still open for the seat.
+func (s *AttendanceService) Respond(ctx context.Context, match *model.Match, driver *model.LeagueParticipant, rsvp *model.MatchRSVP, now time.Time) (*model.ReserveOffer, error) {
+	settings, err := s.SettingsFor(ctx, match.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	if !rsvpOpen(match, settings, now) {
+		return nil, ErrRSVPClosed
+	}
+
+	if err := s.attendanceRepo.UpsertRSVP(ctx, rsvp); err != nil {
+		return nil, err
+	}
+
+	if rsvp.Status != model.RSVPNotAttending {
+		return nil, s.attendanceRepo.WithdrawOffers(ctx, match.ID, driver.ID)
+	}
+
+	teamName := ""
+	if driver.TeamName != nil {
+		teamName = *driver.TeamName
+	}
+	return s.OfferSeat(ctx, match, driver.ID, teamName, settings, now)
+}
+
+// OfferSeat offers a driver's seat to the highest-priority reserve who has not been offered a seat
+// in the match and is not already racing in it. It returns nil when no reserve is left to ask.
+func (s *AttendanceService) OfferSeat(ctx context.Context, match *model.Match, replacedID uuid.UUID, teamName string, settings *model.AttendanceSettings, now time.Time) (*model.ReserveOffer, error) {
+	if match.Status != model.MatchStatusUpcoming || (match.StartsAt != nil && !now.Before(*match.StartsAt)) {
+		return nil, nil
+	}
+
+	subs, err := s.substitutionRepo.ListByMatch(ctx, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	skip := map[uuid.UUID]bool{replacedID: true}
+	for _, sub := range subs {
+		if sub.ReplacedParticipantID == replacedID {
+			return nil, nil
+		}
+		skip[sub.ReserveParticipantID] = true
+	}
+
+	offers, err := s.attendanceRepo.ListOffersByMatch(ctx, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range offers {
+		skip[o.ReserveParticipantID] = true
+	}
+
+	reserves, err := s.attendanceRepo.ListReserves(ctx, match.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	reserve := nextReserve(reserves, skip)
+	if reserve == nil {
+		return nil, nil
+	}
+
+	offer := &model.ReserveOffer{
+		MatchID:               match.ID,
+		ReserveParticipantID:  reserve.ID,
+		ReplacedParticipantID: replacedID,
+		TeamName:              teamName,
+		Status:                model.ReserveOfferPending,
+		ExpiresAt:             offerExpiry(now, time.Duration(settings.OfferTimeoutMinutes)*time.Minute, match.StartsAt),
+		Round:                 match.Round,
+		Track:                 match.Track,
+		ReserveName:           reserve.UserNickname,
+	}
+	if err := s.attendanceRepo.CreateOffer(ctx, offer); err != nil {
+		if errors.Is(err, repository.ErrSeatAlreadyOffered) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// Accept takes up a seat offer, recording the reserve as the replaced driver's substitute
+func (s *AttendanceService) Accept(ctx context.Context, offer *model.ReserveOffer, userID uuid.UUID) (*model.Substitution, error) {
+	sub := &model.Substitution{
+		MatchID:               offer.MatchID,
+		ReserveParticipantID:  offer.ReserveParticipantID,
+		ReplacedParticipantID: offer.ReplacedParticipantID,
+		TeamName:              offer.TeamName,
+		CreatedBy:             &userID,
+	}
+	if err := s.attendanceRepo.AcceptOffer(ctx, offer, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// Decline turns down a seat offer and passes the seat on to the next reserve
+func (s *AttendanceService) Decline(ctx context.Context, offer *model.ReserveOffer, now time.Time) (*model.ReserveOffer, error) {
+	if err := s.attendanceRepo.ResolveOffer(ctx, offer.ID, model.ReserveOfferDeclined); err != nil {
+		return nil, err
+	}
+	return s.passOn(ctx, offer, now)
+}
+
+// ExpireOffers expires unanswered offers and passes each seat on to the next reserve
+func (s *AttendanceService) ExpireOffers(ctx context.Context, now time.Time) (int, error) {
+	expired, err := s.attendanceRepo.ExpireOffers(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, offer := range expired {
+		if _, err := s.passOn(ctx, offer, now); err != nil {
+			slog.Error("Attendance: failed to pass seat on", "error", err, "offer_id", offer.ID, "match_id", offer.MatchID)
+		}
+	}
+
+	return len(expired), nil
+}
+
+// CloseRSVPs closes RSVPs past their deadline and gives strikes to drivers who did not answer
+func (s *AttendanceService) CloseRSVPs(ctx context.Context) (int64, error) {
+	return s.attendanceRepo.CloseDueRSVPs(ctx, DefaultRSVPDeadlineHours, DefaultMissedRSVPStrikes)
+}
+
+// RSVPDeadline returns when RSVPs for a match close, or nil when the match has no start time
+func RSVPDeadline(match *model.Match, settings *model.AttendanceSettings) *time.Time {
+	if match.StartsAt == nil {
+		return nil
+	}
+	deadline := match.StartsAt.Add(-time.Duration(settings.RSVPDeadlineHours) * time.Hour)
+	return &deadline
+}
+
+func (s *AttendanceService) passOn(ctx context.Context, offer *model.ReserveOffer, now time.Time) (*model.ReserveOffer, error) {
+	match, err := s.matchRepo.GetByID(ctx, offer.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.SettingsFor(ctx, match.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	return s.OfferSeat(ctx, match, offer.ReplacedParticipantID, offer.TeamName, settings, now)
+}
+
+// rsvpOpen reports whether drivers may still answer for a match. Postponed matches stay closed
+// until they are rescheduled, which reopens RSVPs for the new date.
+func rsvpOpen(match *model.Match, settings *model.AttendanceSettings, now time.Time) bool {
+	if match.Status != model.MatchStatusUpcoming {
+		return false
+	}
+	deadline := RSVPDeadline(match, settings)
+	return deadline == nil || now.Before(*deadline)
+}
+
+// nextReserve returns the first reserve, in call-up order, who is not skipped
+func nextReserve(reserves []*model.LeagueParticipant, skip map[uuid.UUID]bool) *model.LeagueParticipant {
+	for _, r := range reserves {
+		if !skip[r.ID] {
+			return r
+		}
+	}
+	return nil
+}
+
+// offerExpiry returns when a seat offer made now lapses: after the timeout, but never later than the race start
+func offerExpiry(now time.Time, timeout time.Duration, startsAt *time.Time) time.Time {
+	expiry := now.Add(timeout)
+	if startsAt != nil && startsAt.Before(expiry) {
+		return *startsAt
+	}
+	return expiry
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestAttendanceRules(t *testing.T) {
+	now := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+	startsAt := now.Add(30 * time.Hour)
+	match := &model.Match{Status: model.MatchStatusUpcoming, StartsAt: &startsAt}
+	settings := DefaultAttendanceSettings(uuid.New())
+
+	if !rsvpOpen(match, settings, now) {
+		t.Error("rsvp should be open 30h before the race with a 24h deadline")
+	}
+	if rsvpOpen(match, settings, now.Add(7*time.Hour)) {
+		t.Error("rsvp should be closed 23h before the race with a 24h deadline")
+	}
+
+	if got := offerExpiry(now, 2*time.Hour, &startsAt); !got.Equal(now.Add(2 * time.Hour)) {
+		t.Errorf("offer expiry = %v, want the timeout", got)
+	}
+	if got := offerExpiry(now.Add(29*time.Hour), 2*time.Hour, &startsAt); !got.Equal(startsAt) {
+		t.Errorf("offer expiry = %v, want the race start", got)
+	}
+
+	first, second := &model.LeagueParticipant{ID: uuid.New()}, &model.LeagueParticipant{ID: uuid.New()}
+	reserves := []*model.LeagueParticipant{first, second}
+	if got := nextReserve(reserves, map[uuid.UUID]bool{}); got != first {
+		t.Error("expected the highest-priority reserve")
+	}
+	if got := nextReserve(reserves, map[uuid.UUID]bool{first.ID: true}); got != second {
+		t.Error("expected the next reserve once the first was asked")
+	}
+	if got := nextReserve(reserves, map[uuid.UUID]bool{first.ID: true, second.ID: true}); got != nil {
+		t.Error("expected no reserve once every reserve was asked")
+	}
+}
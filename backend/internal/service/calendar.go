@@ -0,0 +1,53 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+const calendarDateLayout = "2006-01-02"
+
+var ErrCalendarOverflow = errors.New("종료일 전에 모든 라운드를 배치할 수 없습니다")
+
+// CalendarPlan describes how a season's rounds are spread over the calendar
+type CalendarPlan struct {
+	Start      time.Time
+	End        *time.Time // Last date a round may fall on, nil for no limit
+	Weekday    time.Weekday
+	Recurrence model.CalendarRecurrence
+	Excluded   []string // Dates no round may fall on, as YYYY-MM-DD
+	Rounds     int
+}
+
+// CalendarDates returns the date of each round as YYYY-MM-DD. The first round falls on the plan's weekday
+// on or after its start, the rest every week or fortnight after. A round landing on an excluded date moves
+// to the next slot, pushing every later round back with it.
+func CalendarDates(plan CalendarPlan) ([]string, error) {
+	interval := 7
+	if plan.Recurrence == model.CalendarRecurrenceBiweekly {
+		interval = 14
+	}
+
+	excluded := make(map[string]bool, len(plan.Excluded))
+	for _, d := range plan.Excluded {
+		excluded[d] = true
+	}
+
+	offset := (int(plan.Weekday) - int(plan.Start.Weekday()) + 7) % 7
+	day := plan.Start.AddDate(0, 0, offset)
+
+	dates := make([]string, 0, plan.Rounds)
+	for len(dates) < plan.Rounds {
+		if plan.End != nil && day.After(*plan.End) {
+			return nil, ErrCalendarOverflow
+		}
+		if date := day.Format(calendarDateLayout); !excluded[date] {
+			dates = append(dates, date)
+		}
+		day = day.AddDate(0, 0, interval)
+	}
+
+	return dates, nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+func TestCalendarDates(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC) // Monday
+	end := time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	dates, err := CalendarDates(CalendarPlan{
+		Start:      start,
+		Weekday:    time.Saturday,
+		Recurrence: model.CalendarRecurrenceWeekly,
+		Excluded:   []string{"2026-03-14"},
+		Rounds:     3,
+	})
+	if err != nil {
+		t.Fatalf("weekly: unexpected error %v", err)
+	}
+	if want := []string{"2026-03-07", "2026-03-21", "2026-03-28"}; !slices.Equal(dates, want) {
+		t.Errorf("weekly: got %v, want %v", dates, want)
+	}
+
+	dates, err = CalendarDates(CalendarPlan{
+		Start:      start,
+		End:        &end,
+		Weekday:    time.Monday,
+		Recurrence: model.CalendarRecurrenceBiweekly,
+		Rounds:     4,
+	})
+	if err != nil {
+		t.Fatalf("biweekly: unexpected error %v", err)
+	}
+	if want := []string{"2026-03-02", "2026-03-16", "2026-03-30", "2026-04-13"}; !slices.Equal(dates, want) {
+		t.Errorf("biweekly: got %v, want %v", dates, want)
+	}
+
+	_, err = CalendarDates(CalendarPlan{
+		Start:      start,
+		End:        &end,
+		Weekday:    time.Monday,
+		Recurrence: model.CalendarRecurrenceBiweekly,
+		Rounds:     6,
+	})
+	if !errors.Is(err, ErrCalendarOverflow) {
+		t.Errorf("overflow: got %v, want ErrCalendarOverflow", err)
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"math"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+// BuildCareer combines per-league records, ordered newest season first, into a career total and per-season breakdowns
+func BuildCareer(leagues []model.CareerLeague) (model.CareerStats, []model.CareerSeason) {
+	var career model.CareerStats
+	seasons := []model.CareerSeason{}
+
+	for _, l := range leagues {
+		finaliseCareerStats(&l.CareerStats)
+
+		if len(seasons) == 0 || seasons[len(seasons)-1].Season != l.Season {
+			seasons = append(seasons, model.CareerSeason{Season: l.Season})
+		}
+		season := &seasons[len(seasons)-1]
+		season.Leagues = append(season.Leagues, l)
+
+		addCareerStats(&season.CareerStats, &l.CareerStats)
+		addCareerStats(&career, &l.CareerStats)
+	}
+
+	for i := range seasons {
+		finaliseCareerStats(&seasons[i].CareerStats)
+	}
+	finaliseCareerStats(&career)
+
+	return career, seasons
+}
+
+func addCareerStats(total, s *model.CareerStats) {
+	total.Starts += s.Starts
+	total.Wins += s.Wins
+	total.Podiums += s.Podiums
+	total.Poles += s.Poles
+	total.FastestLaps += s.FastestLaps
+	total.DNFs += s.DNFs
+	total.SprintStarts += s.SprintStarts
+	total.SprintWins += s.SprintWins
+	total.Points += s.Points
+	total.Finishes += s.Finishes
+	total.FinishSum += s.FinishSum
+	if s.BestFinish != nil && (total.BestFinish == nil || *s.BestFinish < *total.BestFinish) {
+		best := *s.BestFinish
+		total.BestFinish = &best
+	}
+}
+
+// finaliseCareerStats derives the DNF rate and average finish from the summed counts
+func finaliseCareerStats(s *model.CareerStats) {
+	s.Points = roundPoints(s.Points)
+	if s.Starts > 0 {
+		s.DNFRate = math.Round(float64(s.DNFs)/float64(s.Starts)*1000) / 1000
+	}
+	if s.Finishes > 0 {
+		avg := math.Round(float64(s.FinishSum)/float64(s.Finishes)*100) / 100
+		s.AverageFinish = &avg
+	}
+}
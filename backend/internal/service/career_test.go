@@ -0,0 +1,31 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+func TestBuildCareer(t *testing.T) {
+	best := func(p int) *int { return &p }
+	leagues := []model.CareerLeague{
+		{LeagueName: "Pro", Season: 2, CareerStats: model.CareerStats{Starts: 4, Wins: 1, DNFs: 1, Finishes: 3, FinishSum: 9, BestFinish: best(1), Points: 50.5}},
+		{LeagueName: "Academy", Season: 2, CareerStats: model.CareerStats{Starts: 4, Podiums: 2, Finishes: 4, FinishSum: 11, BestFinish: best(2), Points: 40}},
+		{LeagueName: "Open", Season: 1, CareerStats: model.CareerStats{Starts: 2, DNFs: 2}},
+	}
+
+	career, seasons := BuildCareer(leagues)
+
+	if len(seasons) != 2 || seasons[0].Season != 2 || len(seasons[0].Leagues) != 2 {
+		t.Fatalf("Expected season 2 with two leagues then season 1, got %+v", seasons)
+	}
+	if seasons[0].Starts != 8 || *seasons[0].AverageFinish != 2.86 || *seasons[0].BestFinish != 1 {
+		t.Errorf("Unexpected season 2 totals: %+v", seasons[0].CareerStats)
+	}
+	if seasons[1].AverageFinish != nil || seasons[1].DNFRate != 1 {
+		t.Errorf("Expected season 1 without finishes and every start a DNF, got %+v", seasons[1].CareerStats)
+	}
+	if career.Starts != 10 || career.DNFRate != 0.3 || career.Points != 90.5 || *career.BestFinish != 1 {
+		t.Errorf("Unexpected career totals: %+v", career)
+	}
+}
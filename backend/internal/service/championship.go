@@ -0,0 +1,212 @@
+package service
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+// ChampionshipOutlook holds the clinch and elimination state of every driver and team
+type ChampionshipOutlook struct {
+	RemainingRounds []model.RemainingRound
+	Drivers         []model.ClinchEntry
+	Teams           []model.ClinchEntry
+}
+
+// contestant is a driver or team competing for the title
+type contestant struct {
+	participantID *uuid.UUID
+	name          string
+	rank          int
+	points        float64
+	slots         int // Cars scoring for the entry in each session
+}
+
+// ComputeChampionshipOutlook works out who has clinched, who is still in contention and who is
+// mathematically eliminated, assuming an entry could win every remaining points-scoring session.
+// sessions holds the sessions of the league's matches.
+// Standings rules that drop rounds can only shrink what a rival gains, so the result stays safe to announce.
+func ComputeChampionshipOutlook(standings *LeagueStandings, matches []*model.Match, sessions []*model.MatchSession, ps *model.PointsSystem) *ChampionshipOutlook {
+	rounds := remainingRounds(matches, sessions, ps)
+
+	drivers := make([]contestant, len(standings.Drivers))
+	for i, d := range standings.Drivers {
+		id := d.ParticipantID
+		drivers[i] = contestant{participantID: &id, name: d.DriverName, rank: d.Rank, points: d.TotalPoints, slots: 1}
+	}
+
+	teams := make([]contestant, len(standings.Teams))
+	for i, t := range standings.Teams {
+		teams[i] = contestant{name: t.TeamName, rank: t.Rank, points: t.TotalPoints, slots: max(t.DriverCount, 1)}
+	}
+
+	return &ChampionshipOutlook{
+		RemainingRounds: rounds,
+		Drivers:         clinchEntries(drivers, rounds, ps),
+		Teams:           clinchEntries(teams, rounds, ps),
+	}
+}
+
+// remainingRounds lists the rounds still to be run (upcoming, in progress or postponed) in order,
+// with the points-scoring sessions left in each and the points a single driver can still score there
+func remainingRounds(matches []*model.Match, sessions []*model.MatchSession, ps *model.PointsSystem) []model.RemainingRound {
+	pending := make(map[uuid.UUID][]model.SessionType)
+	for _, s := range sessions {
+		if !slices.Contains(model.PointsSessionTypes, s.Type) || s.Status == model.MatchStatusCompleted || s.Status == model.MatchStatusCancelled {
+			continue
+		}
+		pending[s.MatchID] = append(pending[s.MatchID], s.Type)
+	}
+
+	var rounds []model.RemainingRound
+	for _, m := range matches {
+		if m.Status != model.MatchStatusUpcoming && m.Status != model.MatchStatusInProgress && m.Status != model.MatchStatusPostponed {
+			continue
+		}
+		types := pending[m.ID]
+		if len(types) == 0 {
+			continue
+		}
+		rounds = append(rounds, model.RemainingRound{
+			Round:           m.Round,
+			Track:           m.Track,
+			Sprint:          slices.Contains(types, model.SessionTypeSprint),
+			Sessions:        types,
+			MaxDriverPoints: maxRoundPoints(ps, types, 1),
+		})
+	}
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i].Round < rounds[j].Round })
+	return rounds
+}
+
+// maxRoundPoints returns the most points an entry running the given number of cars can score in a round's sessions.
+// Sprints score from the sprint table; every other session scores from the race table with the fastest lap bonus.
+func maxRoundPoints(ps *model.PointsSystem, sessions []model.SessionType, slots int) float64 {
+	var points float64
+	for _, t := range sessions {
+		if t == model.SessionTypeSprint {
+			points += topPoints(ps.SprintPoints, slots)
+			continue
+		}
+		points += topPoints(ps.RacePoints, slots)
+		if ps.FastestLapPoints > 0 && (ps.FastestLapMaxPosition == nil || *ps.FastestLapMaxPosition >= 1) {
+			points += ps.FastestLapPoints
+		}
+	}
+	return points
+}
+
+// topPoints sums the n highest values of a points table
+func topPoints(table []float64, n int) float64 {
+	sorted := slices.Clone(table)
+	slices.SortFunc(sorted, func(a, b float64) int {
+		switch {
+		case a > b:
+			return -1
+		case a < b:
+			return 1
+		}
+		return 0
+	})
+
+	var sum float64
+	for i := 0; i < n && i < len(sorted); i++ {
+		sum += sorted[i]
+	}
+	return sum
+}
+
+func clinchEntries(entries []contestant, rounds []model.RemainingRound, ps *model.PointsSystem) []model.ClinchEntry {
+	remaining := make([]float64, len(entries))
+	next := make([]float64, len(entries))
+	for i, e := range entries {
+		for j, r := range rounds {
+			points := maxRoundPoints(ps, r.Sessions, e.slots)
+			remaining[i] += points
+			if j == 0 {
+				next[i] = points
+			}
+		}
+	}
+
+	result := make([]model.ClinchEntry, len(entries))
+	for i, e := range entries {
+		entry := model.ClinchEntry{
+			Rank:          e.rank,
+			ParticipantID: e.participantID,
+			Name:          e.name,
+			Points:        roundPoints(e.points),
+			MaxPoints:     roundPoints(e.points + remaining[i]),
+		}
+
+		// leader is the rival with the most points, chaser the rival with the highest ceiling after the next round
+		leader, chaser := -1, -1
+		for j, other := range entries {
+			if j == i {
+				continue
+			}
+			if leader < 0 || other.points > entries[leader].points {
+				leader = j
+			}
+			if chaser < 0 || other.points+remaining[j]-next[j] > entries[chaser].points+remaining[chaser]-next[chaser] {
+				chaser = j
+			}
+		}
+
+		switch {
+		case len(rounds) == 0:
+			// Entries tied on points share the title rank
+			entry.Status = model.ClinchStatusEliminated
+			if e.rank == 1 {
+				entry.Status = model.ClinchStatusClinched
+			}
+		case leader < 0 || isClinched(i, entries, remaining):
+			entry.Status = model.ClinchStatusClinched
+		case e.points+remaining[i] < entries[leader].points:
+			entry.Status = model.ClinchStatusEliminated
+		default:
+			entry.Status = model.ClinchStatusContender
+		}
+
+		if entry.Status == model.ClinchStatusContender {
+			if e.points >= entries[leader].points {
+				// Clinching next round means staying ahead of the chaser's ceiling after that round
+				margin := entries[chaser].points + remaining[chaser] - next[chaser] - e.points
+				if margin < next[i] {
+					entry.NextRound = &model.ClinchScenario{
+						Round:     rounds[0].Round,
+						Goal:      model.ClinchGoalClinch,
+						Rival:     entries[chaser].name,
+						Margin:    roundPoints(margin),
+						MaxPoints: roundPoints(next[i]),
+					}
+				}
+			} else {
+				margin := entries[leader].points - e.points - (remaining[i] - next[i])
+				entry.NextRound = &model.ClinchScenario{
+					Round:     rounds[0].Round,
+					Goal:      model.ClinchGoalStayAlive,
+					Rival:     entries[leader].name,
+					Margin:    roundPoints(margin),
+					MaxPoints: roundPoints(next[i]),
+				}
+			}
+		}
+
+		result[i] = entry
+	}
+
+	return result
+}
+
+// isClinched reports whether entry i stays ahead even if every rival wins everything that remains
+func isClinched(i int, entries []contestant, remaining []float64) bool {
+	for j, other := range entries {
+		if j != i && other.points+remaining[j] >= entries[i].points {
+			return false
+		}
+	}
+	return true
+}
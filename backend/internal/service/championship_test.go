@@ -0,0 +1,112 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestComputeChampionshipOutlook(t *testing.T) {
+	ps := DefaultPointsSystem(uuid.New())
+	matches := []*model.Match{
+		{ID: uuid.New(), Round: 10, Status: model.MatchStatusUpcoming},
+		{ID: uuid.New(), Round: 8, Status: model.MatchStatusCompleted},
+		{ID: uuid.New(), Round: 9, Status: model.MatchStatusUpcoming},
+	}
+	session := func(m *model.Match, t model.SessionType, status model.MatchStatus) *model.MatchSession {
+		return &model.MatchSession{MatchID: m.ID, Type: t, Status: status}
+	}
+	sessions := []*model.MatchSession{
+		session(matches[0], model.SessionTypeQualifying, model.MatchStatusUpcoming),
+		session(matches[0], model.SessionTypeRace, model.MatchStatusUpcoming),
+		session(matches[1], model.SessionTypeSprint, model.MatchStatusCompleted),
+		session(matches[1], model.SessionTypeRace, model.MatchStatusCompleted),
+		session(matches[2], model.SessionTypeSprint, model.MatchStatusUpcoming),
+		session(matches[2], model.SessionTypeRace, model.MatchStatusUpcoming),
+	}
+	standings := &LeagueStandings{
+		Drivers: []model.StandingsEntry{
+			{Rank: 1, ParticipantID: uuid.New(), DriverName: "A", TotalPoints: 200},
+			{Rank: 2, ParticipantID: uuid.New(), DriverName: "B", TotalPoints: 150},
+			{Rank: 3, ParticipantID: uuid.New(), DriverName: "C", TotalPoints: 139},
+		},
+		Teams: []model.TeamStandingsEntry{
+			{Rank: 1, TeamName: "Red", TotalPoints: 300, DriverCount: 2},
+			{Rank: 2, TeamName: "Blue", TotalPoints: 190, DriverCount: 2},
+		},
+	}
+
+	outlook := ComputeChampionshipOutlook(standings, matches, sessions, ps)
+
+	// Round 9 with a sprint is worth 25 + 1 + 8, round 10 is worth 25 + 1
+	if len(outlook.RemainingRounds) != 2 || outlook.RemainingRounds[0].Round != 9 || outlook.RemainingRounds[0].MaxDriverPoints != 34 {
+		t.Fatalf("Expected rounds 9 and 10 with 34 points first, got %+v", outlook.RemainingRounds)
+	}
+
+	want := []model.ClinchStatus{model.ClinchStatusContender, model.ClinchStatusContender, model.ClinchStatusEliminated}
+	for i, d := range outlook.Drivers {
+		if d.Status != want[i] {
+			t.Errorf("Driver %s status = %s, want %s", d.Name, d.Status, want[i])
+		}
+	}
+
+	leader := outlook.Drivers[0].NextRound
+	if leader == nil || leader.Goal != model.ClinchGoalClinch || leader.Rival != "B" || leader.Margin != -24 {
+		t.Errorf("Expected A to clinch next round unless outscored by 24 or more, got %+v", leader)
+	}
+	chaser := outlook.Drivers[1].NextRound
+	if chaser == nil || chaser.Goal != model.ClinchGoalStayAlive || chaser.Margin != 24 || chaser.MaxPoints != 34 {
+		t.Errorf("Expected B to need to outscore A by 24, got %+v", chaser)
+	}
+
+	// Two cars can score at most 25 + 18 + 1 + 8 + 7 and 25 + 18 + 1
+	if outlook.Teams[1].MaxPoints != 293 || outlook.Teams[1].Status != model.ClinchStatusEliminated {
+		t.Errorf("Expected Blue eliminated on 293, got %+v", outlook.Teams[1])
+	}
+	if outlook.Teams[0].Status != model.ClinchStatusClinched {
+		t.Errorf("Expected Red to have clinched, got %s", outlook.Teams[0].Status)
+	}
+
+	// Once no rounds remain the leader is champion
+	final := ComputeChampionshipOutlook(standings, matches[1:2], sessions, ps)
+	if final.Drivers[0].Status != model.ClinchStatusClinched || final.Drivers[1].Status != model.ClinchStatusEliminated {
+		t.Errorf("Expected final standings to be decided, got %+v", final.Drivers)
+	}
+
+	// A postponed round still has to be run, so B stays in contention
+	postponed := &model.Match{ID: matches[2].ID, Round: 9, Status: model.MatchStatusPostponed}
+	open := ComputeChampionshipOutlook(standings, []*model.Match{matches[0], postponed}, sessions, ps)
+	if len(open.RemainingRounds) != 2 || open.RemainingRounds[0].Round != 9 {
+		t.Fatalf("Expected the postponed round to remain, got %+v", open.RemainingRounds)
+	}
+	if open.Drivers[0].Status != model.ClinchStatusContender || open.Drivers[1].Status != model.ClinchStatusContender {
+		t.Errorf("Expected the title to stay open with a postponed round left, got %+v", open.Drivers)
+	}
+
+	// Every points-scoring session left counts: a finished sprint plus a feature and reverse grid race is worth 26 + 26
+	double := &model.Match{ID: uuid.New(), Round: 11, Status: model.MatchStatusInProgress}
+	doubleSessions := []*model.MatchSession{
+		session(double, model.SessionTypeSprint, model.MatchStatusCompleted),
+		session(double, model.SessionTypeFeatureRace, model.MatchStatusUpcoming),
+		session(double, model.SessionTypeReverseGridRace, model.MatchStatusUpcoming),
+	}
+	multi := ComputeChampionshipOutlook(standings, []*model.Match{double}, doubleSessions, ps)
+	if len(multi.RemainingRounds) != 1 || multi.RemainingRounds[0].MaxDriverPoints != 52 || multi.RemainingRounds[0].Sprint {
+		t.Fatalf("Expected one round worth 52 without a sprint, got %+v", multi.RemainingRounds)
+	}
+	if multi.Drivers[1].Status != model.ClinchStatusContender {
+		t.Errorf("Expected B to stay in contention with 52 points left, got %s", multi.Drivers[1].Status)
+	}
+
+	// Drivers tied for the lead when no rounds remain share the title
+	tied := &LeagueStandings{Drivers: []model.StandingsEntry{
+		{Rank: 1, ParticipantID: uuid.New(), DriverName: "A", TotalPoints: 200},
+		{Rank: 1, ParticipantID: uuid.New(), DriverName: "B", TotalPoints: 200},
+		{Rank: 3, ParticipantID: uuid.New(), DriverName: "C", TotalPoints: 150},
+	}}
+	shared := ComputeChampionshipOutlook(tied, nil, nil, ps)
+	if shared.Drivers[0].Status != model.ClinchStatusClinched || shared.Drivers[1].Status != model.ClinchStatusClinched || shared.Drivers[2].Status != model.ClinchStatusEliminated {
+		t.Errorf("Expected both leaders to be champions, got %+v", shared.Drivers)
+	}
+}
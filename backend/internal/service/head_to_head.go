@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+// HeadToHeadSubject selects the results belonging to one side of a comparison
+type HeadToHeadSubject struct {
+	ParticipantID *uuid.UUID // Set when comparing drivers
+	TeamName      string     // Set when comparing teams, matched against the team at the time of each result
+}
+
+func (s HeadToHeadSubject) owns(participantID uuid.UUID, teamName *string) bool {
+	if s.ParticipantID != nil {
+		return participantID == *s.ParticipantID
+	}
+	return teamName != nil && *teamName == s.TeamName
+}
+
+// ResolveHeadToHead finds one side of a comparison among a league's participants.
+// Drivers are matched by participant ID or nickname, teams by name, both case-insensitively.
+func ResolveHeadToHead(participants []*model.LeagueParticipant, value string, teams bool) (HeadToHeadSubject, model.HeadToHeadEntry, bool) {
+	for _, p := range participants {
+		if teams {
+			if p.TeamName != nil && strings.EqualFold(*p.TeamName, value) {
+				return HeadToHeadSubject{TeamName: *p.TeamName}, model.HeadToHeadEntry{Name: *p.TeamName}, true
+			}
+			continue
+		}
+
+		name := ""
+		if p.UserNickname != nil {
+			name = *p.UserNickname
+		}
+		if p.ID.String() == value || (name != "" && strings.EqualFold(name, value)) {
+			id := p.ID
+			return HeadToHeadSubject{ParticipantID: &id}, model.HeadToHeadEntry{ParticipantID: &id, Name: name, TeamName: p.TeamName}, true
+		}
+	}
+	return HeadToHeadSubject{}, model.HeadToHeadEntry{}, false
+}
+
+// HeadToHead holds a computed comparison between two drivers or two teams
+type HeadToHead struct {
+	Race       model.HeadToHeadCount
+	Sprint     model.HeadToHeadCount
+	Qualifying model.HeadToHeadCount
+	PointsA    float64
+	PointsB    float64
+	Rounds     []model.HeadToHeadRound
+}
+
+// HeadToHead compares two drivers or two teams across a league's rounds
+func (s *StandingsService) HeadToHead(ctx context.Context, leagueID uuid.UUID, a, b HeadToHeadSubject, matches []*model.Match) (*HeadToHead, error) {
+	results, err := s.resultRepo.ListRoundResults(ctx, leagueID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	qualifying, err := s.qualifyingRepo.ListByLeague(ctx, leagueID, model.QualifyingSessionQualifying)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds := make(map[uuid.UUID]int, len(matches))
+	for _, m := range matches {
+		rounds[m.ID] = m.Round
+	}
+
+	return ComputeHeadToHead(a, b, results, qualifying, rounds), nil
+}
+
+// ComputeHeadToHead compares two sides session by session. Results must be ordered by round and session,
+// and rounds maps match IDs to round numbers for qualifying sessions without race results.
+func ComputeHeadToHead(a, b HeadToHeadSubject, results []model.RoundResult, qualifying []*model.QualifyingResult, rounds map[uuid.UUID]int) *HeadToHead {
+	h2h := &HeadToHead{}
+	byMatch := make(map[uuid.UUID]*model.HeadToHeadRound)
+
+	roundFor := func(matchID uuid.UUID, round int) *model.HeadToHeadRound {
+		r, ok := byMatch[matchID]
+		if !ok {
+			r = &model.HeadToHeadRound{Round: round, MatchID: matchID, Sessions: []model.HeadToHeadSession{}}
+			byMatch[matchID] = r
+		}
+		return r
+	}
+
+	for start := 0; start < len(results); {
+		end := start
+		for end < len(results) && results[end].MatchID == results[start].MatchID && results[end].Session == results[start].Session {
+			end++
+		}
+		group := results[start:end]
+		start = end
+
+		finishA, finishB := sessionFinish(a, group), sessionFinish(b, group)
+		if finishA == nil && finishB == nil {
+			continue
+		}
+
+		session := model.HeadToHeadSession{Session: group[0].Session, A: finishA, B: finishB}
+		count := &h2h.Race
+		if session.Session == model.SessionTypeSprint {
+			count = &h2h.Sprint
+		}
+		session.Ahead = compareFinish(finishA, finishB, count)
+
+		round := roundFor(group[0].MatchID, group[0].Round)
+		round.Sessions = append(round.Sessions, session)
+	}
+
+	qualifyingA := make(map[uuid.UUID]int)
+	qualifyingB := make(map[uuid.UUID]int)
+	bestQualifying := func(side HeadToHeadSubject, best map[uuid.UUID]int, q *model.QualifyingResult) {
+		if !side.owns(q.ParticipantID, q.TeamName) {
+			return
+		}
+		if p, ok := best[q.MatchID]; !ok || q.Position < p {
+			best[q.MatchID] = q.Position
+		}
+	}
+	for _, q := range qualifying {
+		bestQualifying(a, qualifyingA, q)
+		bestQualifying(b, qualifyingB, q)
+	}
+	for matchID, round := range rounds {
+		posA, okA := qualifyingA[matchID]
+		posB, okB := qualifyingB[matchID]
+		if !okA && !okB {
+			continue
+		}
+		r := roundFor(matchID, round)
+		if okA {
+			r.QualifyingA = &posA
+		}
+		if okB {
+			r.QualifyingB = &posB
+		}
+		if okA && okB {
+			if posA < posB {
+				h2h.Qualifying.A++
+			} else if posB < posA {
+				h2h.Qualifying.B++
+			}
+		}
+	}
+
+	for _, r := range byMatch {
+		h2h.Rounds = append(h2h.Rounds, *r)
+	}
+	sort.Slice(h2h.Rounds, func(i, j int) bool { return h2h.Rounds[i].Round < h2h.Rounds[j].Round })
+
+	for i := range h2h.Rounds {
+		for _, s := range h2h.Rounds[i].Sessions {
+			if s.A != nil {
+				h2h.PointsA += s.A.Points
+			}
+			if s.B != nil {
+				h2h.PointsB += s.B.Points
+			}
+		}
+		h2h.Rounds[i].PointsDelta = roundPoints(h2h.PointsA - h2h.PointsB)
+	}
+	h2h.PointsA = roundPoints(h2h.PointsA)
+	h2h.PointsB = roundPoints(h2h.PointsB)
+
+	return h2h
+}
+
+// sessionFinish returns a side's result in one session, or nil when it did not take part.
+// A team is placed by its best classified car and retires only when every car retired.
+func sessionFinish(side HeadToHeadSubject, group []model.RoundResult) *model.HeadToHeadFinish {
+	var finish *model.HeadToHeadFinish
+	finished := false
+	for _, rr := range group {
+		if !side.owns(rr.ParticipantID, rr.TeamName) {
+			continue
+		}
+		if finish == nil {
+			finish = &model.HeadToHeadFinish{}
+		}
+
+		position, points := rr.Position, rr.Points
+		if rr.Session == model.SessionTypeSprint {
+			position, points = rr.SprintPosition, rr.SprintPoints
+		}
+		finish.Points += points
+
+		if rr.DNF {
+			continue
+		}
+		finished = true
+		if position != nil && (finish.Position == nil || *position < *finish.Position) {
+			finish.Position = position
+		}
+	}
+	if finish != nil {
+		finish.DNF = !finished
+		finish.Points = roundPoints(finish.Points)
+	}
+	return finish
+}
+
+// compareFinish decides which side finished ahead and records it in count.
+// A retirement loses to any finish, and a shared retirement counts for neither side.
+func compareFinish(a, b *model.HeadToHeadFinish, count *model.HeadToHeadCount) model.HeadToHeadSide {
+	if a == nil || b == nil {
+		return model.HeadToHeadNone
+	}
+
+	ahead := model.HeadToHeadNone
+	switch {
+	case a.DNF && b.DNF:
+		count.SharedDNFs++
+		return model.HeadToHeadNone
+	case b.DNF, a.Position != nil && (b.Position == nil || *a.Position < *b.Position):
+		ahead = model.HeadToHeadA
+	case a.DNF, b.Position != nil && (a.Position == nil || *b.Position < *a.Position):
+		ahead = model.HeadToHeadB
+	}
+
+	switch ahead {
+	case model.HeadToHeadA:
+		count.A++
+	case model.HeadToHeadB:
+		count.B++
+	}
+	return ahead
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestComputeHeadToHead(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	m1, m2 := uuid.New(), uuid.New()
+	pos := func(p int) *int { return &p }
+	red, blue := "Red", "Blue"
+
+	results := []model.RoundResult{
+		{MatchID: m1, Round: 1, Session: model.SessionTypeSprint, ParticipantID: b, TeamName: &blue, SprintPosition: pos(1), SprintPoints: 8},
+		{MatchID: m1, Round: 1, Session: model.SessionTypeSprint, ParticipantID: a, TeamName: &red, SprintPosition: pos(2), SprintPoints: 7},
+		{MatchID: m1, Round: 1, Session: model.SessionTypeRace, ParticipantID: a, TeamName: &red, Position: pos(1), Points: 25},
+		{MatchID: m1, Round: 1, Session: model.SessionTypeRace, ParticipantID: c, TeamName: &blue, Position: pos(2), Points: 18},
+		{MatchID: m1, Round: 1, Session: model.SessionTypeRace, ParticipantID: b, TeamName: &blue, DNF: true},
+		{MatchID: m2, Round: 2, Session: model.SessionTypeRace, ParticipantID: a, TeamName: &red, DNF: true},
+		{MatchID: m2, Round: 2, Session: model.SessionTypeRace, ParticipantID: b, TeamName: &blue, DNF: true},
+	}
+	qualifying := []*model.QualifyingResult{
+		{MatchID: m1, ParticipantID: b, TeamName: &blue, Position: 1},
+		{MatchID: m1, ParticipantID: a, TeamName: &red, Position: 2},
+		{MatchID: m2, ParticipantID: a, TeamName: &red, Position: 3},
+		{MatchID: m2, ParticipantID: b, TeamName: &blue, Position: 4},
+	}
+	rounds := map[uuid.UUID]int{m1: 1, m2: 2}
+
+	drivers := ComputeHeadToHead(HeadToHeadSubject{ParticipantID: &a}, HeadToHeadSubject{ParticipantID: &b}, results, qualifying, rounds)
+
+	if drivers.Race != (model.HeadToHeadCount{A: 1, SharedDNFs: 1}) {
+		t.Errorf("Expected a ahead once with one shared DNF, got %+v", drivers.Race)
+	}
+	if drivers.Sprint != (model.HeadToHeadCount{B: 1}) || drivers.Qualifying != (model.HeadToHeadCount{A: 1, B: 1}) {
+		t.Errorf("Unexpected sprint %+v or qualifying %+v", drivers.Sprint, drivers.Qualifying)
+	}
+	if len(drivers.Rounds) != 2 || drivers.Rounds[0].PointsDelta != 24 || drivers.Rounds[1].Sessions[0].Ahead != model.HeadToHeadNone {
+		t.Errorf("Unexpected rounds: %+v", drivers.Rounds)
+	}
+
+	// Teams are placed by their best car, so Blue's second driver finishing keeps it from a DNF
+	teams := ComputeHeadToHead(HeadToHeadSubject{TeamName: red}, HeadToHeadSubject{TeamName: blue}, results, qualifying, rounds)
+	race := teams.Rounds[0].Sessions[1]
+	if race.B == nil || race.B.DNF || *race.B.Position != 2 || race.B.Points != 18 || race.Ahead != model.HeadToHeadA {
+		t.Errorf("Expected Blue classified P2 with 18 points behind Red, got %+v", race)
+	}
+	if teams.PointsA != 32 || teams.PointsB != 26 {
+		t.Errorf("Expected team points 32 and 26, got %.1f and %.1f", teams.PointsA, teams.PointsB)
+	}
+}
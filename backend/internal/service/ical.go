@@ -0,0 +1,167 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+const (
+	icalDateTimeLayout = "20060102T150405Z"
+	icalDateLayout     = "20060102"
+	icalUIDDomain      = "f1-rivals-cup"
+	icalLineLimit      = 75 // Octets per content line before folding
+)
+
+// CalendarEvent is one entry of an iCalendar feed
+type CalendarEvent struct {
+	UID         string
+	Summary     string
+	Location    string
+	Description string
+	Start       time.Time
+	Duration    time.Duration
+	AllDay      bool // Start holds only a date because no time is scheduled
+	Cancelled   bool
+	Tentative   bool // Postponed and waiting for a new date
+	Sequence    int  // Bumped on every reschedule so clients replace their copy
+	UpdatedAt   time.Time
+}
+
+// MatchCalendarEvents builds the feed events of a match: its race and, for sprint weekends, the sprint.
+// UIDs derive from the match ID alone so rescheduling a round updates the existing calendar entries.
+func MatchCalendarEvents(match *model.Match, leagueName string, duration time.Duration) []CalendarEvent {
+	description := ""
+	if match.Description != nil {
+		description = *match.Description
+	}
+
+	race := CalendarEvent{
+		UID:         fmt.Sprintf("race-%s@%s", match.ID, icalUIDDomain),
+		Summary:     fmt.Sprintf("%s R%d %s", leagueName, match.Round, match.Track),
+		Location:    match.Track,
+		Description: description,
+		Duration:    duration,
+		Cancelled:   match.Status == model.MatchStatusCancelled,
+		Tentative:   match.Status == model.MatchStatusPostponed,
+		Sequence:    match.Revision,
+		UpdatedAt:   match.UpdatedAt,
+	}
+	race.Start, race.AllDay = calendarEventStart(match.StartsAt, match.MatchDate)
+
+	events := []CalendarEvent{race}
+	if !match.HasSprint {
+		return events
+	}
+
+	sprintDate := match.MatchDate
+	if match.SprintDate != nil {
+		sprintDate = *match.SprintDate
+	}
+	sprint := CalendarEvent{
+		UID:         fmt.Sprintf("sprint-%s@%s", match.ID, icalUIDDomain),
+		Summary:     fmt.Sprintf("%s R%d %s 스프린트", leagueName, match.Round, match.Track),
+		Location:    match.Track,
+		Description: description,
+		Duration:    duration,
+		Cancelled:   match.Status == model.MatchStatusCancelled || match.SprintStatus == model.MatchStatusCancelled,
+		Tentative:   match.Status == model.MatchStatusPostponed,
+		Sequence:    match.Revision,
+		UpdatedAt:   match.UpdatedAt,
+	}
+	sprint.Start, sprint.AllDay = calendarEventStart(match.SprintStartsAt, sprintDate)
+
+	return append(events, sprint)
+}
+
+// calendarEventStart returns the start instant when one is scheduled, otherwise the bare date as an all-day start
+func calendarEventStart(startsAt *time.Time, date string) (time.Time, bool) {
+	if startsAt != nil {
+		return *startsAt, false
+	}
+	day, _ := time.Parse("2006-01-02", date)
+	return day, true
+}
+
+// BuildICalendar renders events as an RFC 5545 calendar named name
+func BuildICalendar(name string, events []CalendarEvent) string {
+	var sb strings.Builder
+	line := func(s string) {
+		sb.WriteString(foldICalLine(s))
+		sb.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//F1 Rivals Cup//Schedule//KO")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + escapeICalText(name))
+
+	for _, e := range events {
+		line("BEGIN:VEVENT")
+		line("UID:" + e.UID)
+		line(fmt.Sprintf("SEQUENCE:%d", e.Sequence))
+		line("DTSTAMP:" + e.UpdatedAt.UTC().Format(icalDateTimeLayout))
+		line("LAST-MODIFIED:" + e.UpdatedAt.UTC().Format(icalDateTimeLayout))
+		if e.AllDay {
+			line("DTSTART;VALUE=DATE:" + e.Start.Format(icalDateLayout))
+			line("DTEND;VALUE=DATE:" + e.Start.AddDate(0, 0, 1).Format(icalDateLayout))
+		} else {
+			line("DTSTART:" + e.Start.UTC().Format(icalDateTimeLayout))
+			line("DTEND:" + e.Start.Add(e.Duration).UTC().Format(icalDateTimeLayout))
+		}
+		line("SUMMARY:" + escapeICalText(e.Summary))
+		if e.Location != "" {
+			line("LOCATION:" + escapeICalText(e.Location))
+		}
+		if e.Description != "" {
+			line("DESCRIPTION:" + escapeICalText(e.Description))
+		}
+		switch {
+		case e.Cancelled:
+			line("STATUS:CANCELLED")
+		case e.Tentative:
+			line("STATUS:TENTATIVE")
+		default:
+			line("STATUS:CONFIRMED")
+		}
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+	return sb.String()
+}
+
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// escapeICalText escapes the characters that are special inside an iCalendar TEXT value
+func escapeICalText(s string) string {
+	return icalTextEscaper.Replace(s)
+}
+
+// foldICalLine splits a content line longer than 75 octets into continuation lines,
+// never cutting a multi-byte character in half
+func foldICalLine(s string) string {
+	if len(s) <= icalLineLimit {
+		return s
+	}
+
+	var sb strings.Builder
+	limit := icalLineLimit
+	width := 0
+	for _, r := range s {
+		size := utf8.RuneLen(r)
+		if width+size > limit {
+			sb.WriteString("\r\n ")
+			width = 0
+			limit = icalLineLimit - 1 // The leading space counts towards the line
+		}
+		sb.WriteRune(r)
+		width += size
+	}
+	return sb.String()
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestBuildICalendar(t *testing.T) {
+	startsAt := time.Date(2026, 3, 7, 21, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+	sprintDate := "2026-03-06"
+	match := &model.Match{
+		ID:         uuid.MustParse("7f1c2a4e-0000-4000-8000-000000000001"),
+		Round:      3,
+		Track:      "Monza",
+		MatchDate:  "2026-03-07",
+		StartsAt:   &startsAt,
+		HasSprint:  true,
+		SprintDate: &sprintDate,
+		Status:     model.MatchStatusUpcoming,
+		UpdatedAt:  startsAt,
+	}
+
+	events := MatchCalendarEvents(match, "Rivals; Season 2, 리그", 2*time.Hour)
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want race and sprint", len(events))
+	}
+	if events[0].UID != "race-7f1c2a4e-0000-4000-8000-000000000001@f1-rivals-cup" {
+		t.Errorf("race UID = %q, want one derived from the match ID", events[0].UID)
+	}
+	if !events[1].AllDay {
+		t.Error("sprint without a start time should be an all-day event")
+	}
+
+	ics := BuildICalendar("Rivals; Season 2, 리그", events)
+	for _, want := range []string{
+		"DTSTART:20260307T120000Z\r\n",
+		"DTEND:20260307T140000Z\r\n",
+		"DTSTART;VALUE=DATE:20260306\r\n",
+		`X-WR-CALNAME:Rivals\; Season 2\, 리그` + "\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("calendar missing %q", want)
+		}
+	}
+
+	folded := foldICalLine("SUMMARY:" + strings.Repeat("스프린트", 10))
+	for _, l := range strings.Split(folded, "\r\n") {
+		if len(l) > icalLineLimit {
+			t.Errorf("folded line is %d octets, want at most %d", len(l), icalLineLimit)
+		}
+	}
+	if strings.ReplaceAll(folded, "\r\n ", "") != "SUMMARY:"+strings.Repeat("스프린트", 10) {
+		t.Error("unfolding does not restore the original line")
+	}
+}
+
+func TestPostponedMatchCalendar(t *testing.T) {
+	match := &model.Match{
+		ID:        uuid.New(),
+		Round:     4,
+		Track:     "Spa",
+		MatchDate: "2026-03-14",
+		Status:    model.MatchStatusPostponed,
+		Revision:  2,
+	}
+
+	ics := BuildICalendar("Rivals", MatchCalendarEvents(match, "Rivals", 2*time.Hour))
+	for _, want := range []string{"SEQUENCE:2\r\n", "STATUS:TENTATIVE\r\n"} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("calendar missing %q", want)
+		}
+	}
+
+	if !CanTransitionMatchStatus(model.MatchStatusPostponed, model.MatchStatusUpcoming) || CanTransitionMatchStatus(model.MatchStatusInProgress, model.MatchStatusPostponed) {
+		t.Error("Unexpected postponement transition rules")
+	}
+}
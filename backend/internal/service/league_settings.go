@@ -0,0 +1,227 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+// LeagueSettingsVersion is the current version of the league settings document
+const LeagueSettingsVersion = 1
+
+// Bounds of the league settings document
+const (
+	maxLeagueTeams       = 50
+	maxSeatsPerTeam      = 10
+	defaultSeatsPerTeam  = 2
+	maxTransferWindows   = 20
+	leagueSettingsLayout = "2006-01-02"
+)
+
+var (
+	leagueRoles     = []model.ParticipantRole{model.RoleDirector, model.RolePlayer, model.RoleReserve, model.RoleEngineer}
+	pointsTables    = []model.PointsTableSource{model.PointsTableStandard, model.PointsTableCustom}
+	assistsPolicies = []model.AssistsPolicy{model.AssistsAny, model.AssistsLimited, model.AssistsNone}
+	damageLevels    = []model.DamageLevel{model.DamageOff, model.DamageReduced, model.DamageStandard, model.DamageSimulation}
+	weatherModes    = []model.WeatherMode{model.WeatherDynamic, model.WeatherFixed, model.WeatherClear}
+)
+
+// DefaultLeagueSettings returns the settings used when a league has not configured its own
+func DefaultLeagueSettings() *model.LeagueSettings {
+	return &model.LeagueSettings{
+		Version:         LeagueSettingsVersion,
+		SeatsPerTeam:    defaultSeatsPerTeam,
+		AllowedRoles:    slices.Clone(leagueRoles),
+		PointsTable:     model.PointsTableStandard,
+		TransferWindows: []model.TransferWindow{},
+		Game: model.GameSettings{
+			Assists: model.AssistsAny,
+			Damage:  model.DamageStandard,
+			Weather: model.WeatherDynamic,
+		},
+	}
+}
+
+// LeagueSettingsFor returns the settings of a league, falling back to the defaults
+func LeagueSettingsFor(league *model.League) *model.LeagueSettings {
+	if league.Settings == nil {
+		return DefaultLeagueSettings()
+	}
+	return ApplyLeagueSettingsDefaults(league.Settings)
+}
+
+// ApplyLeagueSettingsDefaults fills the fields a settings document leaves out with their defaults
+// and upgrades it to the current version
+func ApplyLeagueSettingsDefaults(settings *model.LeagueSettings) *model.LeagueSettings {
+	defaults := DefaultLeagueSettings()
+	s := *settings
+	if s.Version == 0 {
+		s.Version = LeagueSettingsVersion
+	}
+	if s.SeatsPerTeam == 0 {
+		s.SeatsPerTeam = defaults.SeatsPerTeam
+	}
+	if len(s.AllowedRoles) == 0 {
+		s.AllowedRoles = defaults.AllowedRoles
+	}
+	if s.PointsTable == "" {
+		s.PointsTable = defaults.PointsTable
+	}
+	if s.TransferWindows == nil {
+		s.TransferWindows = defaults.TransferWindows
+	}
+	if s.Game.Assists == "" {
+		s.Game.Assists = defaults.Game.Assists
+	}
+	if s.Game.Damage == "" {
+		s.Game.Damage = defaults.Game.Damage
+	}
+	if s.Game.Weather == "" {
+		s.Game.Weather = defaults.Game.Weather
+	}
+	return &s
+}
+
+// ValidateLeagueSettings checks a settings document, with defaults applied, against the schema
+func ValidateLeagueSettings(s *model.LeagueSettings) error {
+	if s.Version < 1 || s.Version > LeagueSettingsVersion {
+		return fmt.Errorf("지원하지 않는 설정 버전입니다 (최신 버전: %d)", LeagueSettingsVersion)
+	}
+	if s.MaxTeams < 0 || s.MaxTeams > maxLeagueTeams {
+		return fmt.Errorf("최대 팀 수는 0에서 %d 사이여야 합니다", maxLeagueTeams)
+	}
+	if s.SeatsPerTeam < 1 || s.SeatsPerTeam > maxSeatsPerTeam {
+		return fmt.Errorf("팀당 시트 수는 1에서 %d 사이여야 합니다", maxSeatsPerTeam)
+	}
+	if s.MaxReserves < 0 {
+		return errors.New("리저브 선수 정원은 0 이상이어야 합니다")
+	}
+	for i, role := range s.AllowedRoles {
+		if !slices.Contains(leagueRoles, role) {
+			return fmt.Errorf("유효하지 않은 역할입니다: %s", role)
+		}
+		if slices.Contains(s.AllowedRoles[:i], role) {
+			return fmt.Errorf("중복된 역할입니다: %s", role)
+		}
+	}
+	if !slices.Contains(pointsTables, s.PointsTable) {
+		return fmt.Errorf("유효하지 않은 포인트 테이블입니다: %s", s.PointsTable)
+	}
+	if len(s.TransferWindows) > maxTransferWindows {
+		return fmt.Errorf("이적 시장은 최대 %d개까지 설정할 수 있습니다", maxTransferWindows)
+	}
+	for _, w := range s.TransferWindows {
+		opens, err := time.Parse(leagueSettingsLayout, w.Opens)
+		if err != nil {
+			return errors.New("이적 시장 날짜 형식이 올바르지 않습니다 (YYYY-MM-DD)")
+		}
+		closes, err := time.Parse(leagueSettingsLayout, w.Closes)
+		if err != nil {
+			return errors.New("이적 시장 날짜 형식이 올바르지 않습니다 (YYYY-MM-DD)")
+		}
+		if closes.Before(opens) {
+			return errors.New("이적 시장 종료일은 시작일보다 빠를 수 없습니다")
+		}
+	}
+	if s.StartingBudget < 0 {
+		return errors.New("초기 예산은 0 이상이어야 합니다")
+	}
+	if !slices.Contains(assistsPolicies, s.Game.Assists) {
+		return fmt.Errorf("유효하지 않은 어시스트 설정입니다: %s", s.Game.Assists)
+	}
+	if !slices.Contains(damageLevels, s.Game.Damage) {
+		return fmt.Errorf("유효하지 않은 데미지 설정입니다: %s", s.Game.Damage)
+	}
+	if !slices.Contains(weatherModes, s.Game.Weather) {
+		return fmt.Errorf("유효하지 않은 날씨 설정입니다: %s", s.Game.Weather)
+	}
+	return nil
+}
+
+// RoleAllowed reports whether drivers may take a role in a league
+func RoleAllowed(s *model.LeagueSettings, role string) bool {
+	return slices.Contains(s.AllowedRoles, model.ParticipantRole(role))
+}
+
+// Errors returned when a league's roster limits are reached
+var (
+	ErrReservesFull = errors.New("리저브 선수 정원이 이미 찼습니다")
+	ErrTeamFull     = errors.New("해당 팀의 선수 정원이 이미 찼습니다")
+)
+
+// CheckRosterLimits checks a participant with roles against the approved reserves of the league
+// and the approved players of their team
+func CheckRosterLimits(s *model.LeagueSettings, roles []string, reserves, teamPlayers int) error {
+	if s.MaxReserves > 0 && slices.Contains(roles, string(model.RoleReserve)) && reserves >= s.MaxReserves {
+		return ErrReservesFull
+	}
+	if slices.Contains(roles, string(model.RolePlayer)) && teamPlayers >= s.SeatsPerTeam {
+		return ErrTeamFull
+	}
+	return nil
+}
+
+// TransferWindowOpen reports whether team changes may be requested on a date in the league's timezone.
+// A league without transfer windows allows them at any time.
+func TransferWindowOpen(s *model.LeagueSettings, today string) bool {
+	if len(s.TransferWindows) == 0 {
+		return true
+	}
+	// Dates in YYYY-MM-DD order the same way as strings
+	return slices.ContainsFunc(s.TransferWindows, func(w model.TransferWindow) bool {
+		return w.Opens <= today && today <= w.Closes
+	})
+}
+
+// LeagueSettingsSchema returns the JSON Schema of the league settings document
+func LeagueSettingsSchema() map[string]any {
+	date := map[string]any{"type": "string", "format": "date"}
+	return map[string]any{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"title":                "LeagueSettings",
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]any{
+			"version":        map[string]any{"type": "integer", "minimum": 1, "maximum": LeagueSettingsVersion, "default": LeagueSettingsVersion},
+			"max_teams":      map[string]any{"type": "integer", "minimum": 0, "maximum": maxLeagueTeams, "default": 0, "description": "0 = no limit"},
+			"seats_per_team": map[string]any{"type": "integer", "minimum": 1, "maximum": maxSeatsPerTeam, "default": defaultSeatsPerTeam},
+			"max_reserves":   map[string]any{"type": "integer", "minimum": 0, "default": 0, "description": "0 = no limit"},
+			"allowed_roles": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"enum": leagueRoles},
+				"uniqueItems": true,
+				"default":     leagueRoles,
+			},
+			"points_table": map[string]any{
+				"enum":        pointsTables,
+				"default":     model.PointsTableStandard,
+				"description": "standard = the default F1 table; custom = the league's saved points system",
+			},
+			"transfer_windows": map[string]any{
+				"type":     "array",
+				"maxItems": maxTransferWindows,
+				"items": map[string]any{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"opens", "closes"},
+					"properties":           map[string]any{"opens": date, "closes": date},
+				},
+				"default":     []any{},
+				"description": "Inclusive dates in the league's timezone; empty = team changes allowed at any time",
+			},
+			"starting_budget": map[string]any{"type": "integer", "minimum": 0, "default": 0},
+			"game": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"assists": map[string]any{"enum": assistsPolicies, "default": model.AssistsAny},
+					"damage":  map[string]any{"enum": damageLevels, "default": model.DamageStandard},
+					"weather": map[string]any{"enum": weatherModes, "default": model.WeatherDynamic},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+func TestLeagueSettings(t *testing.T) {
+	settings := ApplyLeagueSettingsDefaults(&model.LeagueSettings{MaxReserves: 3})
+	if settings.Version != LeagueSettingsVersion || settings.SeatsPerTeam != 2 || len(settings.AllowedRoles) != 4 {
+		t.Errorf("defaults not applied: %+v", settings)
+	}
+	if err := ValidateLeagueSettings(settings); err != nil {
+		t.Errorf("expected defaults to validate, got %v", err)
+	}
+
+	invalid := []*model.LeagueSettings{
+		{Version: LeagueSettingsVersion + 1},
+		{SeatsPerTeam: maxSeatsPerTeam + 1},
+		{AllowedRoles: []model.ParticipantRole{model.RolePlayer, model.RolePlayer}},
+		{AllowedRoles: []model.ParticipantRole{"marshal"}},
+		{TransferWindows: []model.TransferWindow{{Opens: "2026-03-10", Closes: "2026-03-01"}}},
+		{StartingBudget: -1},
+		{Game: model.GameSettings{Weather: "monsoon"}},
+	}
+	for _, s := range invalid {
+		if err := ValidateLeagueSettings(ApplyLeagueSettingsDefaults(s)); err == nil {
+			t.Errorf("expected %+v to be rejected", s)
+		}
+	}
+
+	windows := &model.LeagueSettings{TransferWindows: []model.TransferWindow{{Opens: "2026-03-01", Closes: "2026-03-10"}}}
+	if !TransferWindowOpen(windows, "2026-03-10") || TransferWindowOpen(windows, "2026-03-11") {
+		t.Error("transfer window should include both of its dates and nothing after")
+	}
+	if !TransferWindowOpen(DefaultLeagueSettings(), "2026-07-01") {
+		t.Error("a league without transfer windows should always allow team changes")
+	}
+}
+
+func TestCheckRosterLimits(t *testing.T) {
+	settings := ApplyLeagueSettingsDefaults(&model.LeagueSettings{MaxReserves: 2})
+	reserve := []string{string(model.RoleReserve)}
+	player := []string{string(model.RolePlayer)}
+
+	// Approving a pending participant is checked against the approved entries only
+	if err := CheckRosterLimits(settings, reserve, 1, 0); err != nil {
+		t.Errorf("expected a reserve to fit with 1 of 2 seats taken, got %v", err)
+	}
+	if err := CheckRosterLimits(settings, reserve, 2, 0); !errors.Is(err, ErrReservesFull) {
+		t.Errorf("expected ErrReservesFull, got %v", err)
+	}
+	if err := CheckRosterLimits(settings, player, 5, 2); !errors.Is(err, ErrTeamFull) {
+		t.Errorf("expected ErrTeamFull, got %v", err)
+	}
+	if err := CheckRosterLimits(DefaultLeagueSettings(), reserve, 30, 0); err != nil {
+		t.Errorf("expected no reserve limit by default, got %v", err)
+	}
+}
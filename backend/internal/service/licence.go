@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+// DefaultLicenceSettings returns the F1 super licence rules used when a league has not configured its own:
+// a one race ban at 12 points, with points expiring after 12 months
+func DefaultLicenceSettings(leagueID uuid.UUID) *model.LicenceSettings {
+	expiryDays := 365
+	return &model.LicenceSettings{
+		LeagueID:     leagueID,
+		BanThreshold: 12,
+		ExpiryDays:   &expiryDays,
+		IsDefault:    true,
+	}
+}
+
+// LicenceService tracks licence penalty points and issues race bans when the threshold is reached
+type LicenceService struct {
+	licenceRepo *repository.LicenceRepository
+	matchRepo   *repository.MatchRepository
+}
+
+// NewLicenceService creates a new LicenceService instance
+func NewLicenceService(licenceRepo *repository.LicenceRepository, matchRepo *repository.MatchRepository) *LicenceService {
+	return &LicenceService{
+		licenceRepo: licenceRepo,
+		matchRepo:   matchRepo,
+	}
+}
+
+// SettingsFor returns the league's licence settings, falling back to the default rules
+func (s *LicenceService) SettingsFor(ctx context.Context, leagueID uuid.UUID) (*model.LicenceSettings, error) {
+	settings, err := s.licenceRepo.GetSettings(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrLicenceSettingsNotFound) {
+			return DefaultLicenceSettings(leagueID), nil
+		}
+		return nil, err
+	}
+	return settings, nil
+}
+
+// Award records licence points and bans the participant from their next match once the
+// active points reach the league threshold. Points that trigger a ban are consumed by it.
+func (s *LicenceService) Award(ctx context.Context, leagueID uuid.UUID, point *model.LicencePoint) (*model.AwardLicencePointsResponse, error) {
+	settings, err := s.SettingsFor(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.licenceRepo.CreatePoints(ctx, point); err != nil {
+		return nil, err
+	}
+
+	points, err := s.licenceRepo.ListPointsByParticipant(ctx, point.ParticipantID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range points {
+		if p.ID == point.ID {
+			point.Round = p.Round
+			point.ParticipantName = p.ParticipantName
+			point.IssuerName = p.IssuerName
+		}
+	}
+
+	total, active := activeLicencePoints(points, settings, completedMatchRounds(matches), time.Now())
+	resp := &model.AwardLicencePointsResponse{
+		Points:  point,
+		Licence: &model.ParticipantLicence{ActivePoints: total, BanThreshold: settings.BanThreshold},
+	}
+
+	if total >= settings.BanThreshold {
+		if next := nextMatchAfter(matches, point.Round); next != nil {
+			ban := &model.RaceBan{
+				ParticipantID: point.ParticipantID,
+				MatchID:       next.ID,
+				PointsAtBan:   total,
+				Reason:        fmt.Sprintf("라이선스 벌점 %d점 누적", total),
+				Round:         next.Round,
+				Track:         next.Track,
+			}
+			err := s.licenceRepo.CreateBan(ctx, ban, active)
+			switch {
+			case err == nil:
+				resp.Ban = ban
+				resp.Licence.ActivePoints = 0
+			case !errors.Is(err, repository.ErrRaceBanExists):
+				return nil, err
+			}
+		}
+	}
+
+	if ban, err := s.licenceRepo.GetUpcomingBan(ctx, point.ParticipantID); err == nil {
+		resp.Licence.UpcomingBan = ban
+	} else if !errors.Is(err, repository.ErrRaceBanNotFound) {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// licenceRecords holds everything needed to evaluate licences in a league
+type licenceRecords struct {
+	settings *model.LicenceSettings
+	matches  []*model.Match
+	points   []*model.LicencePoint
+	bans     []*model.RaceBan
+}
+
+func (s *LicenceService) load(ctx context.Context, leagueID uuid.UUID) (*licenceRecords, error) {
+	settings, err := s.SettingsFor(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.licenceRepo.ListPointsByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	bans, err := s.licenceRepo.ListBansByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	activeLicencePoints(points, settings, completedMatchRounds(matches), time.Now())
+
+	return &licenceRecords{
+		settings: settings,
+		matches:  matches,
+		points:   points,
+		bans:     bans,
+	}, nil
+}
+
+// History returns every licence point and ban in a league with expiry flags filled in
+func (s *LicenceService) History(ctx context.Context, leagueID uuid.UUID) (*model.LicenceHistoryResponse, error) {
+	records, err := s.load(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &model.LicenceHistoryResponse{
+		Settings: records.settings,
+		Points:   records.points,
+		Bans:     records.bans,
+	}
+	if resp.Points == nil {
+		resp.Points = []*model.LicencePoint{}
+	}
+	if resp.Bans == nil {
+		resp.Bans = []*model.RaceBan{}
+	}
+
+	return resp, nil
+}
+
+// StatusByLeague returns the current licence state of the given participants
+func (s *LicenceService) StatusByLeague(ctx context.Context, leagueID uuid.UUID, participantIDs []uuid.UUID) (map[uuid.UUID]*model.ParticipantLicence, error) {
+	records, err := s.load(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(map[uuid.UUID]bool)
+	for _, m := range records.matches {
+		if m.Status != model.MatchStatusCompleted && m.Status != model.MatchStatusCancelled {
+			pending[m.ID] = true
+		}
+	}
+
+	status := make(map[uuid.UUID]*model.ParticipantLicence, len(participantIDs))
+	for _, id := range participantIDs {
+		status[id] = &model.ParticipantLicence{BanThreshold: records.settings.BanThreshold}
+	}
+
+	for _, p := range records.points {
+		if l, ok := status[p.ParticipantID]; ok && !p.Expired && p.BanID == nil {
+			l.ActivePoints += p.Points
+		}
+	}
+	for _, b := range records.bans {
+		l, ok := status[b.ParticipantID]
+		if !ok || !pending[b.MatchID] {
+			continue
+		}
+		if l.UpcomingBan == nil || b.Round < l.UpcomingBan.Round {
+			l.UpcomingBan = b
+		}
+	}
+
+	return status, nil
+}
+
+// BannedParticipants returns the participants banned from a match
+func (s *LicenceService) BannedParticipants(ctx context.Context, matchID uuid.UUID) (map[uuid.UUID]*model.RaceBan, error) {
+	bans, err := s.licenceRepo.ListBansByMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	banned := make(map[uuid.UUID]*model.RaceBan, len(bans))
+	for _, b := range bans {
+		banned[b.ParticipantID] = b
+	}
+	return banned, nil
+}
+
+// activeLicencePoints flags expired points and returns the total of points that still count
+// towards a ban along with their IDs. Points already consumed by a ban never count.
+func activeLicencePoints(points []*model.LicencePoint, settings *model.LicenceSettings, completedRounds []int, now time.Time) (int, []uuid.UUID) {
+	total := 0
+	var ids []uuid.UUID
+	for _, p := range points {
+		p.Expired = licencePointExpired(p, settings, completedRounds, now)
+		if p.Expired || p.BanID != nil {
+			continue
+		}
+		total += p.Points
+		ids = append(ids, p.ID)
+	}
+	return total, ids
+}
+
+func licencePointExpired(p *model.LicencePoint, settings *model.LicenceSettings, completedRounds []int, now time.Time) bool {
+	if settings.ExpiryDays != nil && !now.Before(p.CreatedAt.AddDate(0, 0, *settings.ExpiryDays)) {
+		return true
+	}
+	if settings.ExpiryRounds != nil {
+		since := 0
+		for _, round := range completedRounds {
+			if round > p.Round {
+				since++
+			}
+		}
+		if since >= *settings.ExpiryRounds {
+			return true
+		}
+	}
+	return false
+}
+
+func completedMatchRounds(matches []*model.Match) []int {
+	var rounds []int
+	for _, m := range matches {
+		if m.Status == model.MatchStatusCompleted {
+			rounds = append(rounds, m.Round)
+		}
+	}
+	return rounds
+}
+
+// nextMatchAfter returns the earliest match after the given round that is still to be run
+func nextMatchAfter(matches []*model.Match, round int) *model.Match {
+	var next *model.Match
+	for _, m := range matches {
+		if m.Round <= round || m.Status == model.MatchStatusCompleted || m.Status == model.MatchStatusCancelled {
+			continue
+		}
+		if next == nil || m.Round < next.Round {
+			next = m
+		}
+	}
+	return next
+}
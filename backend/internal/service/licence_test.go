@@ -0,0 +1,67 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestActiveLicencePoints(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	banID := uuid.New()
+
+	points := []*model.LicencePoint{
+		{ID: uuid.New(), Round: 1, Points: 3, CreatedAt: now.AddDate(0, 0, -40)},
+		{ID: uuid.New(), Round: 2, Points: 2, CreatedAt: now.AddDate(0, 0, -20)},
+		{ID: uuid.New(), Round: 3, Points: 4, CreatedAt: now.AddDate(0, 0, -10), BanID: &banID},
+		{ID: uuid.New(), Round: 4, Points: 1, CreatedAt: now.AddDate(0, 0, -1)},
+	}
+
+	tests := []struct {
+		name     string
+		settings *model.LicenceSettings
+		want     int
+		expired  []bool
+	}{
+		{
+			name:     "no expiry",
+			settings: &model.LicenceSettings{BanThreshold: 12},
+			want:     6,
+			expired:  []bool{false, false, false, false},
+		},
+		{
+			name:     "expire after 30 days",
+			settings: &model.LicenceSettings{BanThreshold: 12, ExpiryDays: intPtr(30)},
+			want:     3,
+			expired:  []bool{true, false, false, false},
+		},
+		{
+			name:     "expire after 2 completed rounds",
+			settings: &model.LicenceSettings{BanThreshold: 12, ExpiryRounds: intPtr(2)},
+			want:     1,
+			expired:  []bool{true, true, false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, ids := activeLicencePoints(points, tt.settings, []int{1, 2, 3, 4}, now)
+			if total != tt.want {
+				t.Errorf("total = %d, want %d", total, tt.want)
+			}
+			for i, p := range points {
+				if p.Expired != tt.expired[i] {
+					t.Errorf("points[%d].Expired = %v, want %v", i, p.Expired, tt.expired[i])
+				}
+			}
+			for _, id := range ids {
+				if id == points[2].ID {
+					t.Errorf("points consumed by a ban should not be active")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidStatusTransition = errors.New("invalid match status transition")
+
+// matchStatusTransitions lists the statuses a match or session may move to from each status.
+// Completed weekends can be reopened and cancelled ones reinstated, but not skipped past.
+// Only weekends that have not started can be postponed.
+var matchStatusTransitions = map[model.MatchStatus][]model.MatchStatus{
+	model.MatchStatusUpcoming:   {model.MatchStatusInProgress, model.MatchStatusCompleted, model.MatchStatusCancelled, model.MatchStatusPostponed},
+	model.MatchStatusInProgress: {model.MatchStatusUpcoming, model.MatchStatusCompleted, model.MatchStatusCancelled},
+	model.MatchStatusCompleted:  {model.MatchStatusInProgress},
+	model.MatchStatusCancelled:  {model.MatchStatusUpcoming},
+	model.MatchStatusPostponed:  {model.MatchStatusUpcoming, model.MatchStatusCancelled},
+}
+
+// CanTransitionMatchStatus reports whether a match or session may move from one status to another.
+// Keeping the current status is always allowed.
+func CanTransitionMatchStatus(from, to model.MatchStatus) bool {
+	return from == to || slices.Contains(matchStatusTransitions[from], to)
+}
+
+// LifecycleResult summarises one automatic lifecycle pass
+type LifecycleResult struct {
+	SessionsStarted   int
+	SessionsCompleted int
+	MatchesStarted    int
+	MatchesCompleted  int
+}
+
+// MatchLifecycleService moves matches and their sessions through the status state machine
+// and keeps an audit trail of every change
+type MatchLifecycleService struct {
+	matchRepo       *repository.MatchRepository
+	sessionRepo     *repository.SessionRepository
+	eventRepo       *repository.MatchStatusEventRepository
+	defaultDuration time.Duration
+}
+
+// NewMatchLifecycleService creates a new MatchLifecycleService. defaultDuration is how long a session
+// without its own duration runs before it is completed automatically; zero disables that.
+func NewMatchLifecycleService(matchRepo *repository.MatchRepository, sessionRepo *repository.SessionRepository, eventRepo *repository.MatchStatusEventRepository, defaultDuration time.Duration) *MatchLifecycleService {
+	return &MatchLifecycleService{
+		matchRepo:       matchRepo,
+		sessionRepo:     sessionRepo,
+		eventRepo:       eventRepo,
+		defaultDuration: defaultDuration,
+	}
+}
+
+// Record logs a status change that has already been saved. Failures are logged rather than returned
+// so a missing audit entry never undoes the change itself.
+func (s *MatchLifecycleService) Record(ctx context.Context, matchID uuid.UUID, sessionID *uuid.UUID, subject string, from, to model.MatchStatus, reason model.MatchStatusReason, actorID *uuid.UUID) {
+	if from == to {
+		return
+	}
+
+	event := &model.MatchStatusEvent{
+		MatchID:    matchID,
+		SessionID:  sessionID,
+		Subject:    subject,
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     reason,
+		ActorID:    actorID,
+	}
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		slog.Error("MatchLifecycle: failed to record status event", "error", err, "match_id", matchID, "subject", subject)
+	}
+}
+
+// SetSessionStatus moves a session to a new status when the transition is legal and records it
+func (s *MatchLifecycleService) SetSessionStatus(ctx context.Context, session *model.MatchSession, to model.MatchStatus, reason model.MatchStatusReason, actorID *uuid.UUID) error {
+	if session.Status == to {
+		return nil
+	}
+	if !CanTransitionMatchStatus(session.Status, to) {
+		return ErrInvalidStatusTransition
+	}
+
+	if err := s.sessionRepo.UpdateStatus(ctx, session.ID, to); err != nil {
+		return err
+	}
+
+	s.Record(ctx, session.MatchID, &session.ID, string(session.Type), session.Status, to, reason, actorID)
+	session.Status = to
+	return nil
+}
+
+// SetMatchStatus moves a match, without touching its sessions, when the transition is legal and records it
+func (s *MatchLifecycleService) SetMatchStatus(ctx context.Context, match *model.Match, to model.MatchStatus, reason model.MatchStatusReason, actorID *uuid.UUID) error {
+	if match.Status == to {
+		return nil
+	}
+	if !CanTransitionMatchStatus(match.Status, to) {
+		return ErrInvalidStatusTransition
+	}
+
+	if err := s.matchRepo.UpdateStatus(ctx, match.ID, to); err != nil {
+		return err
+	}
+
+	s.Record(ctx, match.ID, nil, model.MatchStatusSubjectMatch, match.Status, to, reason, actorID)
+	match.Status = to
+	return nil
+}
+
+// Advance runs one automatic lifecycle pass: it starts sessions whose start time has passed, completes
+// running sessions once results are in or their running time has elapsed, and then moves each affected
+// match along with its sessions
+func (s *MatchLifecycleService) Advance(ctx context.Context, now time.Time) (*LifecycleResult, error) {
+	upcoming, err := s.sessionRepo.ListUpcoming(ctx)
+	if err != nil {
+		return nil, err
+	}
+	running, err := s.sessionRepo.ListInProgress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LifecycleResult{}
+	seen := make(map[uuid.UUID]bool)
+	var matchIDs []uuid.UUID
+	touch := func(id uuid.UUID) {
+		if !seen[id] {
+			seen[id] = true
+			matchIDs = append(matchIDs, id)
+		}
+	}
+
+	for _, session := range append(upcoming, running...) {
+		to, reason, ok := nextSessionStatus(session, now, s.defaultDuration)
+		if !ok {
+			continue
+		}
+		if err := s.SetSessionStatus(ctx, session, to, reason, nil); err != nil {
+			slog.Error("MatchLifecycle: failed to update session status", "error", err, "session_id", session.ID, "status", to)
+			continue
+		}
+		if to == model.MatchStatusInProgress {
+			result.SessionsStarted++
+		} else {
+			result.SessionsCompleted++
+		}
+		touch(session.MatchID)
+	}
+
+	// Running matches are checked too, in case their sessions were finished by hand
+	inProgress, err := s.matchRepo.ListIDsByStatus(ctx, model.MatchStatusInProgress)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range inProgress {
+		touch(id)
+	}
+
+	for _, id := range matchIDs {
+		match, err := s.matchRepo.GetByID(ctx, id)
+		if err != nil {
+			slog.Error("MatchLifecycle: failed to get match", "error", err, "match_id", id)
+			continue
+		}
+		sessions, err := s.sessionRepo.ListByMatch(ctx, id)
+		if err != nil {
+			slog.Error("MatchLifecycle: failed to list sessions", "error", err, "match_id", id)
+			continue
+		}
+
+		to, reason, ok := nextMatchStatus(match.Status, sessions)
+		if !ok {
+			continue
+		}
+		if err := s.SetMatchStatus(ctx, match, to, reason, nil); err != nil {
+			slog.Error("MatchLifecycle: failed to update match status", "error", err, "match_id", id, "status", to)
+			continue
+		}
+		if to == model.MatchStatusInProgress {
+			result.MatchesStarted++
+		} else {
+			result.MatchesCompleted++
+		}
+	}
+
+	return result, nil
+}
+
+// nextSessionStatus decides the automatic transition of a session at the given time, if any
+func nextSessionStatus(session *model.MatchSession, now time.Time, defaultDuration time.Duration) (model.MatchStatus, model.MatchStatusReason, bool) {
+	switch session.Status {
+	case model.MatchStatusUpcoming:
+		if session.StartsAt != nil && !now.Before(*session.StartsAt) {
+			return model.MatchStatusInProgress, model.MatchStatusReasonSchedule, true
+		}
+	case model.MatchStatusInProgress:
+		if session.ResultsStatus != nil {
+			return model.MatchStatusCompleted, model.MatchStatusReasonResults, true
+		}
+		duration := defaultDuration
+		if session.DurationMinutes != nil {
+			duration = time.Duration(*session.DurationMinutes) * time.Minute
+		}
+		if session.StartsAt != nil && duration > 0 && !now.Before(session.StartsAt.Add(duration)) {
+			return model.MatchStatusCompleted, model.MatchStatusReasonDuration, true
+		}
+	}
+	return "", "", false
+}
+
+// nextMatchStatus decides the automatic transition of a match from the state of its sessions, if any.
+// A match starts with its first session and completes once every session that was not cancelled has finished.
+func nextMatchStatus(status model.MatchStatus, sessions []*model.MatchSession) (model.MatchStatus, model.MatchStatusReason, bool) {
+	started, finished, active := false, 0, 0
+	for _, session := range sessions {
+		switch session.Status {
+		case model.MatchStatusCancelled:
+			continue
+		case model.MatchStatusInProgress:
+			started = true
+		case model.MatchStatusCompleted:
+			started = true
+			finished++
+		}
+		active++
+	}
+
+	switch status {
+	case model.MatchStatusUpcoming:
+		if started {
+			return model.MatchStatusInProgress, model.MatchStatusReasonSchedule, true
+		}
+	case model.MatchStatusInProgress:
+		if active > 0 && finished == active {
+			return model.MatchStatusCompleted, model.MatchStatusReasonSessions, true
+		}
+	}
+	return "", "", false
+}
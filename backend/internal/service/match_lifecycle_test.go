@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+func TestMatchLifecycleTransitions(t *testing.T) {
+	start := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	provisional := model.ResultsStatusProvisional
+	stint := 45
+
+	sessionCases := []struct {
+		name    string
+		session model.MatchSession
+		now     time.Time
+		want    model.MatchStatus
+		reason  model.MatchStatusReason
+	}{
+		{"before start", model.MatchSession{Status: model.MatchStatusUpcoming, StartsAt: &start}, start.Add(-time.Minute), "", ""},
+		{"start reached", model.MatchSession{Status: model.MatchStatusUpcoming, StartsAt: &start}, start, model.MatchStatusInProgress, model.MatchStatusReasonSchedule},
+		{"results submitted", model.MatchSession{Status: model.MatchStatusInProgress, StartsAt: &start, ResultsStatus: &provisional}, start.Add(time.Minute), model.MatchStatusCompleted, model.MatchStatusReasonResults},
+		{"default duration running", model.MatchSession{Status: model.MatchStatusInProgress, StartsAt: &start}, start.Add(time.Hour), "", ""},
+		{"default duration elapsed", model.MatchSession{Status: model.MatchStatusInProgress, StartsAt: &start}, start.Add(2 * time.Hour), model.MatchStatusCompleted, model.MatchStatusReasonDuration},
+		{"own duration elapsed", model.MatchSession{Status: model.MatchStatusInProgress, StartsAt: &start, DurationMinutes: &stint}, start.Add(45 * time.Minute), model.MatchStatusCompleted, model.MatchStatusReasonDuration},
+		{"cancelled", model.MatchSession{Status: model.MatchStatusCancelled, StartsAt: &start}, start.Add(time.Hour), "", ""},
+	}
+	for _, tc := range sessionCases {
+		got, reason, _ := nextSessionStatus(&tc.session, tc.now, 2*time.Hour)
+		if got != tc.want || reason != tc.reason {
+			t.Errorf("%s: expected %q (%q), got %q (%q)", tc.name, tc.want, tc.reason, got, reason)
+		}
+	}
+
+	sprint := &model.MatchSession{Status: model.MatchStatusCompleted}
+	race := &model.MatchSession{Status: model.MatchStatusInProgress}
+	practice := &model.MatchSession{Status: model.MatchStatusCancelled}
+
+	if got, reason, _ := nextMatchStatus(model.MatchStatusUpcoming, []*model.MatchSession{sprint, race}); got != model.MatchStatusInProgress || reason != model.MatchStatusReasonSchedule {
+		t.Errorf("Expected match to start with its first session, got %q (%q)", got, reason)
+	}
+	if _, _, ok := nextMatchStatus(model.MatchStatusInProgress, []*model.MatchSession{sprint, race}); ok {
+		t.Error("Expected match to keep running while the race is in progress")
+	}
+	race.Status = model.MatchStatusCompleted
+	if got, reason, _ := nextMatchStatus(model.MatchStatusInProgress, []*model.MatchSession{practice, sprint, race}); got != model.MatchStatusCompleted || reason != model.MatchStatusReasonSessions {
+		t.Errorf("Expected match to complete once every non-cancelled session finished, got %q (%q)", got, reason)
+	}
+
+	if !CanTransitionMatchStatus(model.MatchStatusCompleted, model.MatchStatusInProgress) || CanTransitionMatchStatus(model.MatchStatusCancelled, model.MatchStatusCompleted) {
+		t.Error("Unexpected transition rules")
+	}
+}
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+// ErrPenaltyRequiresTime is returned when a time penalty is applied to a session without recorded finish times
+var ErrPenaltyRequiresTime = errors.New("time penalty requires finish times for every classified driver")
+
+// sessionEntry is a classified result used during reclassification
+type sessionEntry struct {
+	result   *model.SessionResult
+	original int
+	timeMs   int64
+	hasTime  bool
+}
+
+// ApplyPenalties rebuilds the classification of every points-scoring session of a match from the original
+// positions of its session results and the given active penalties, then recalculates points. Penalties apply
+// to the first session of their type; the results of the reclassified sessions are returned.
+func ApplyPenalties(ps *model.PointsSystem, results []*model.SessionResult, penalties []*model.Penalty) ([]*model.SessionResult, error) {
+	var reclassified []*model.SessionResult
+	for _, session := range model.PenaltySessions {
+		sessionType := model.SessionType(session)
+		sessionResults := firstSessionResults(results, sessionType)
+		if len(sessionResults) == 0 {
+			continue
+		}
+
+		var sessionPenalties []*model.Penalty
+		for _, p := range penalties {
+			if p.Session == session && p.RevokedAt == nil {
+				sessionPenalties = append(sessionPenalties, p)
+			}
+		}
+		if err := ReclassifySession(ps, sessionType, sessionResults, sessionPenalties); err != nil {
+			return nil, err
+		}
+		reclassified = append(reclassified, sessionResults...)
+	}
+
+	return reclassified, nil
+}
+
+// firstSessionResults picks the results of the first session of a type from a match's results in session order
+func firstSessionResults(results []*model.SessionResult, sessionType model.SessionType) []*model.SessionResult {
+	var sessionID uuid.UUID
+	var sessionResults []*model.SessionResult
+	for _, r := range results {
+		if r.SessionType != sessionType {
+			continue
+		}
+		if sessionID == uuid.Nil {
+			sessionID = r.SessionID
+		}
+		if r.SessionID == sessionID {
+			sessionResults = append(sessionResults, r)
+		}
+	}
+	return sessionResults
+}
+
+// ReclassifySession rebuilds a session's classification from its original positions and the penalties
+// issued against it, then recalculates points for every result that is not a manual override
+func ReclassifySession(ps *model.PointsSystem, sessionType model.SessionType, results []*model.SessionResult, penalties []*model.Penalty) error {
+	timePenalty := make(map[uuid.UUID]int64)
+	drops := make(map[uuid.UUID]int)
+	disqualified := make(map[uuid.UUID]bool)
+	for _, p := range penalties {
+		switch p.Type {
+		case model.PenaltyTypeTime:
+			timePenalty[p.ParticipantID] += int64(p.Value) * 1000
+		case model.PenaltyTypePositionDrop:
+			drops[p.ParticipantID] += p.Value
+		case model.PenaltyTypeDisqualification:
+			disqualified[p.ParticipantID] = true
+		}
+	}
+
+	// Classified finishers are reordered; retired drivers stay behind them in their original order
+	var finishers, retired []sessionEntry
+	for _, r := range results {
+		r.Disqualified = disqualified[r.ParticipantID]
+		r.Position = nil
+
+		if r.OriginalPosition == nil || r.Disqualified {
+			continue
+		}
+
+		entry := sessionEntry{result: r, original: *r.OriginalPosition}
+		if r.TimeMs != nil {
+			entry.timeMs = *r.TimeMs + timePenalty[r.ParticipantID]
+			entry.hasTime = true
+		}
+		if r.DNF {
+			retired = append(retired, entry)
+		} else {
+			finishers = append(finishers, entry)
+		}
+	}
+
+	byOriginal := func(entries []sessionEntry) {
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].original < entries[j].original })
+	}
+	byOriginal(finishers)
+	byOriginal(retired)
+
+	if hasTimePenalty(finishers, timePenalty) {
+		for _, e := range finishers {
+			if !e.hasTime {
+				return ErrPenaltyRequiresTime
+			}
+		}
+		sort.SliceStable(finishers, func(i, j int) bool { return finishers[i].timeMs < finishers[j].timeMs })
+	}
+
+	finishers = applyPositionDrops(finishers, drops)
+
+	for i, e := range append(finishers, retired...) {
+		position := i + 1
+		e.result.Position = &position
+	}
+
+	for _, r := range results {
+		if r.Disqualified {
+			r.Points = 0
+		} else if !r.PointsManual {
+			r.Points = CalculateSessionPoints(ps, sessionType, r.Position, r.FastestLap, r.DNF)
+		}
+	}
+
+	return nil
+}
+
+func hasTimePenalty(entries []sessionEntry, timePenalty map[uuid.UUID]int64) bool {
+	for _, e := range entries {
+		if timePenalty[e.result.ParticipantID] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// applyPositionDrops moves each penalised driver down by their drop from the position they held
+// after time penalties. Unpenalised drivers fill the remaining places in order.
+func applyPositionDrops(entries []sessionEntry, drops map[uuid.UUID]int) []sessionEntry {
+	type target struct {
+		entry sessionEntry
+		slot  int
+	}
+
+	var penalised []target
+	var others []sessionEntry
+	for i, e := range entries {
+		if drop := drops[e.result.ParticipantID]; drop > 0 {
+			penalised = append(penalised, target{entry: e, slot: i + drop})
+		} else {
+			others = append(others, e)
+		}
+	}
+	if len(penalised) == 0 {
+		return entries
+	}
+
+	sort.SliceStable(penalised, func(i, j int) bool { return penalised[i].slot < penalised[j].slot })
+
+	ordered := make([]sessionEntry, len(entries))
+	taken := make([]bool, len(entries))
+	for _, p := range penalised {
+		slot := nearestFreeSlot(taken, p.slot)
+		ordered[slot] = p.entry
+		taken[slot] = true
+	}
+
+	next := 0
+	for i := range ordered {
+		if !taken[i] {
+			ordered[i] = others[next]
+			next++
+		}
+	}
+
+	return ordered
+}
+
+// nearestFreeSlot returns the first free place at or behind want, or the closest one ahead of it
+func nearestFreeSlot(taken []bool, want int) int {
+	if want > len(taken)-1 {
+		want = len(taken) - 1
+	}
+	for i := want; i < len(taken); i++ {
+		if !taken[i] {
+			return i
+		}
+	}
+	for i := want - 1; i >= 0; i-- {
+		if !taken[i] {
+			return i
+		}
+	}
+	return -1
+}
+
+// ReclassifyMatch rebuilds a match's classification from its original results and active penalties
+func (s *ResultService) ReclassifyMatch(ctx context.Context, match *model.Match) ([]*model.MatchResult, error) {
+	return s.reclassify(ctx, match, nil, nil)
+}
+
+// IssuePenalty validates and stores a new penalty, then reclassifies the match
+func (s *ResultService) IssuePenalty(ctx context.Context, match *model.Match, penalty *model.Penalty) ([]*model.MatchResult, error) {
+	return s.reclassify(ctx, match, penalty, nil)
+}
+
+// RevokePenalty reclassifies the match without a penalty, revoking it along with the new classification
+func (s *ResultService) RevokePenalty(ctx context.Context, match *model.Match, penaltyID, revokedBy uuid.UUID) ([]*model.MatchResult, error) {
+	return s.reclassify(ctx, match, nil, &model.Penalty{ID: penaltyID, RevokedBy: &revokedBy})
+}
+
+// CheckPenalties reports whether the active penalties of a match can still be applied once the given results
+// are saved over the stored results of one of its sessions. Running it before saving keeps a classification
+// the penalties cannot be applied to from being stored.
+func (s *ResultService) CheckPenalties(ctx context.Context, match *model.Match, session *model.MatchSession, incoming []model.CreateSessionResultRequest) error {
+	penalties, err := s.penaltyRepo.ListActiveByMatch(ctx, match.ID)
+	if err != nil {
+		return err
+	}
+	var sessionPenalties []*model.Penalty
+	for _, p := range penalties {
+		if model.SessionType(p.Session) == session.Type {
+			sessionPenalties = append(sessionPenalties, p)
+		}
+	}
+	if len(sessionPenalties) == 0 {
+		return nil
+	}
+
+	// Penalties only apply to the first session of their type
+	first, err := s.sessionRepo.GetByMatchAndType(ctx, match.ID, session.Type)
+	if err != nil {
+		return err
+	}
+	if first.ID != session.ID {
+		return nil
+	}
+
+	ps, err := s.PointsSystemFor(ctx, match.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	stored, err := s.sessionRepo.ListResults(ctx, session.ID)
+	if err != nil {
+		return err
+	}
+
+	return ReclassifySession(ps, session.Type, enteredResults(session.ID, stored, incoming), sessionPenalties)
+}
+
+// enteredResults returns a session's results as they will be once the entered results are saved,
+// each replacing the stored result of the same driver
+func enteredResults(sessionID uuid.UUID, stored []*model.SessionResult, incoming []model.CreateSessionResultRequest) []*model.SessionResult {
+	entered := make(map[uuid.UUID]bool, len(incoming))
+	results := make([]*model.SessionResult, 0, len(incoming)+len(stored))
+	for _, r := range incoming {
+		entered[r.ParticipantID] = true
+		results = append(results, &model.SessionResult{
+			SessionID:        sessionID,
+			ParticipantID:    r.ParticipantID,
+			OriginalPosition: r.Position,
+			Points:           r.Points,
+			PointsManual:     r.PointsManual,
+			FastestLap:       r.FastestLap,
+			DNF:              r.DNF,
+			TimeMs:           r.TimeMs,
+		})
+	}
+	for _, r := range stored {
+		if !entered[r.ParticipantID] {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+func (s *ResultService) reclassify(ctx context.Context, match *model.Match, newPenalty, revoked *model.Penalty) ([]*model.MatchResult, error) {
+	ps, err := s.PointsSystemFor(ctx, match.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.sessionRepo.ListResultsByMatch(ctx, match.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	penalties, err := s.penaltyRepo.ListActiveByMatch(ctx, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	if newPenalty != nil {
+		penalties = append(penalties, newPenalty)
+	}
+	if revoked != nil {
+		penalties = slices.DeleteFunc(penalties, func(p *model.Penalty) bool { return p.ID == revoked.ID })
+	}
+
+	// Validate the new classification before storing anything
+	reclassified, err := ApplyPenalties(ps, results, penalties)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionRepo.UpdateClassification(ctx, reclassified, newPenalty, revoked); err != nil {
+		return nil, err
+	}
+
+	return s.resultRepo.ListByMatch(ctx, match.ID)
+}
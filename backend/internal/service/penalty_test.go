@@ -0,0 +1,117 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestApplyPenalties(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	msPtr := func(v int64) *int64 { return &v }
+
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	raceID := uuid.New()
+	newResults := func() []*model.SessionResult {
+		results := make([]*model.SessionResult, len(ids))
+		for i, id := range ids {
+			results[i] = &model.SessionResult{
+				SessionID:        raceID,
+				SessionType:      model.SessionTypeRace,
+				ParticipantID:    id,
+				OriginalPosition: intPtr(i + 1),
+				TimeMs:           msPtr(int64(3600000 + i*2000)),
+			}
+		}
+		return results
+	}
+	ps := DefaultPointsSystem(uuid.New())
+
+	t.Run("time penalty, position drop and disqualification", func(t *testing.T) {
+		results := newResults()
+		penalties := []*model.Penalty{
+			{ParticipantID: ids[0], Session: model.PenaltySessionRace, Type: model.PenaltyTypeTime, Value: 5},
+			{ParticipantID: ids[1], Session: model.PenaltySessionRace, Type: model.PenaltyTypeDisqualification},
+			{ParticipantID: ids[2], Session: model.PenaltySessionRace, Type: model.PenaltyTypePositionDrop, Value: 2},
+		}
+
+		reclassified, err := ApplyPenalties(ps, results, penalties)
+		if err != nil {
+			t.Fatalf("ApplyPenalties() error = %v", err)
+		}
+		if len(reclassified) != len(results) {
+			t.Fatalf("Expected every race result to be reclassified, got %d", len(reclassified))
+		}
+
+		// P2 is removed, P1 (+5s) falls behind P3, then P3 drops from 1st to 3rd
+		want := map[uuid.UUID]int{ids[0]: 1, ids[3]: 2, ids[2]: 3, ids[4]: 4}
+		for _, r := range results {
+			if r.ParticipantID == ids[1] {
+				if r.Position != nil || !r.Disqualified || r.Points != 0 {
+					t.Errorf("Expected disqualified driver unclassified with no points, got %+v", r)
+				}
+				continue
+			}
+			if r.Position == nil || *r.Position != want[r.ParticipantID] {
+				t.Errorf("Expected position %d, got %v", want[r.ParticipantID], derefInt(r.Position))
+			}
+		}
+		if results[3].Points != 18 {
+			t.Errorf("Expected P4 promoted to 2nd to score 18, got %v", results[3].Points)
+		}
+	})
+
+	t.Run("time penalty without times", func(t *testing.T) {
+		results := newResults()
+		results[4].TimeMs = nil
+		penalties := []*model.Penalty{
+			{ParticipantID: ids[0], Session: model.PenaltySessionRace, Type: model.PenaltyTypeTime, Value: 5},
+		}
+
+		if _, err := ApplyPenalties(ps, results, penalties); !errors.Is(err, ErrPenaltyRequiresTime) {
+			t.Errorf("Expected ErrPenaltyRequiresTime, got %v", err)
+		}
+	})
+
+	t.Run("feature race penalty", func(t *testing.T) {
+		results := newResults()
+		for _, r := range results {
+			r.SessionType = model.SessionTypeFeatureRace
+		}
+		penalties := []*model.Penalty{
+			{ParticipantID: ids[0], Session: model.PenaltySessionFeatureRace, Type: model.PenaltyTypeDisqualification},
+		}
+
+		if _, err := ApplyPenalties(ps, results, penalties); err != nil {
+			t.Fatalf("ApplyPenalties() error = %v", err)
+		}
+		if !results[0].Disqualified || results[1].Position == nil || *results[1].Position != 1 || results[1].Points != 25 {
+			t.Errorf("Expected the feature race winner disqualified and P2 promoted, got %+v %+v", results[0], results[1])
+		}
+	})
+
+	t.Run("entered results without times", func(t *testing.T) {
+		// Re-entering the winner without a time must fail before anything is saved
+		incoming := []model.CreateSessionResultRequest{{ParticipantID: ids[1], Position: intPtr(1)}}
+		results := enteredResults(raceID, newResults(), incoming)
+		if len(results) != len(ids) || results[0].ParticipantID != ids[1] || results[0].TimeMs != nil {
+			t.Fatalf("Expected the entered result to replace the stored one, got %+v", results)
+		}
+		penalties := []*model.Penalty{
+			{ParticipantID: ids[0], Session: model.PenaltySessionRace, Type: model.PenaltyTypeTime, Value: 5},
+		}
+
+		if err := ReclassifySession(ps, model.SessionTypeRace, results, penalties); !errors.Is(err, ErrPenaltyRequiresTime) {
+			t.Errorf("Expected ErrPenaltyRequiresTime, got %v", err)
+		}
+	})
+}
+
+func derefInt(v *int) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
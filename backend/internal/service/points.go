@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// DefaultPointsSystem returns the standard F1 points table used when a league has not configured its own
+func DefaultPointsSystem(leagueID uuid.UUID) *model.PointsSystem {
+	fastestLapMaxPosition := 10
+	return &model.PointsSystem{
+		LeagueID:              leagueID,
+		RacePoints:            pq.Float64Array{25, 18, 15, 12, 10, 8, 6, 4, 2, 1},
+		SprintPoints:          pq.Float64Array{8, 7, 6, 5, 4, 3, 2, 1},
+		FastestLapPoints:      1,
+		FastestLapMaxPosition: &fastestLapMaxPosition,
+		DNFNoPoints:           true,
+		IsDefault:             true,
+	}
+}
+
+// CalculateRacePoints returns race points for a classified position, including the fastest lap bonus
+func CalculateRacePoints(ps *model.PointsSystem, position *int, fastestLap, dnf bool) float64 {
+	if dnf && ps.DNFNoPoints {
+		return 0
+	}
+
+	points := pointsForPosition(ps.RacePoints, position)
+
+	if fastestLap && ps.FastestLapPoints > 0 {
+		eligible := ps.FastestLapMaxPosition == nil ||
+			(position != nil && *position <= *ps.FastestLapMaxPosition)
+		if eligible {
+			points += ps.FastestLapPoints
+		}
+	}
+
+	return points
+}
+
+// CalculateSprintPoints returns sprint points for a classified sprint position
+func CalculateSprintPoints(ps *model.PointsSystem, sprintPosition *int) float64 {
+	return pointsForPosition(ps.SprintPoints, sprintPosition)
+}
+
+// CalculateSessionPoints returns points for a result in the given session type.
+// Sprint sessions use the sprint table, every other points-scoring session uses the race table.
+func CalculateSessionPoints(ps *model.PointsSystem, sessionType model.SessionType, position *int, fastestLap, dnf bool) float64 {
+	if sessionType == model.SessionTypeSprint {
+		return CalculateSprintPoints(ps, position)
+	}
+	return CalculateRacePoints(ps, position, fastestLap, dnf)
+}
+
+// ApplySessionPoints fills in points for session results that are not flagged as manual overrides
+func ApplySessionPoints(ps *model.PointsSystem, sessionType model.SessionType, results []model.CreateSessionResultRequest) {
+	for i := range results {
+		if !results[i].PointsManual {
+			results[i].Points = CalculateSessionPoints(ps, sessionType, results[i].Position, results[i].FastestLap, results[i].DNF)
+		}
+	}
+}
+
+func pointsForPosition(table []float64, position *int) float64 {
+	if position == nil || *position < 1 || *position > len(table) {
+		return 0
+	}
+	return table[*position-1]
+}
+
+// ApplyPoints fills in race and/or sprint points for results that are not flagged as manual overrides
+func ApplyPoints(ps *model.PointsSystem, results []model.CreateMatchResultRequest, race, sprint bool) {
+	for i := range results {
+		if race && !results[i].PointsManual {
+			results[i].Points = CalculateRacePoints(ps, results[i].Position, results[i].FastestLap, results[i].DNF)
+		}
+		if sprint && !results[i].SprintPointsManual {
+			results[i].SprintPoints = CalculateSprintPoints(ps, results[i].SprintPosition)
+		}
+	}
+}
+
+// ResultService coordinates point calculation for stored match results
+type ResultService struct {
+	resultRepo  *repository.MatchResultRepository
+	sessionRepo *repository.SessionRepository
+	pointsRepo  *repository.PointsSystemRepository
+	penaltyRepo *repository.PenaltyRepository
+	leagueRepo  *repository.LeagueRepository
+}
+
+// NewResultService creates a new ResultService instance
+func NewResultService(resultRepo *repository.MatchResultRepository, sessionRepo *repository.SessionRepository, pointsRepo *repository.PointsSystemRepository, penaltyRepo *repository.PenaltyRepository, leagueRepo *repository.LeagueRepository) *ResultService {
+	return &ResultService{
+		resultRepo:  resultRepo,
+		sessionRepo: sessionRepo,
+		pointsRepo:  pointsRepo,
+		penaltyRepo: penaltyRepo,
+		leagueRepo:  leagueRepo,
+	}
+}
+
+// PointsSystemFor returns the points system a league scores with under its points_table setting
+func (s *ResultService) PointsSystemFor(ctx context.Context, leagueID uuid.UUID) (*model.PointsSystem, error) {
+	league, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	settings := LeagueSettingsFor(league)
+
+	var stored *model.PointsSystem
+	if settings.PointsTable == model.PointsTableCustom {
+		stored, err = s.pointsRepo.GetByLeague(ctx, leagueID)
+		if err != nil && !errors.Is(err, repository.ErrPointsSystemNotFound) {
+			return nil, err
+		}
+	}
+	return pointsSystemFor(leagueID, settings, stored), nil
+}
+
+// pointsSystemFor picks the default table for leagues on the standard table, and the league's own system
+// for leagues on a custom table, falling back to the default until one is saved
+func pointsSystemFor(leagueID uuid.UUID, settings *model.LeagueSettings, stored *model.PointsSystem) *model.PointsSystem {
+	if settings.PointsTable != model.PointsTableCustom || stored == nil {
+		return DefaultPointsSystem(leagueID)
+	}
+	return stored
+}
+
+// RecalculateLeague recomputes stored points for every non-manual session result in the league
+// and returns the number of results whose points changed
+func (s *ResultService) RecalculateLeague(ctx context.Context, leagueID uuid.UUID) (int, error) {
+	ps, err := s.PointsSystemFor(ctx, leagueID)
+	if err != nil {
+		return 0, err
+	}
+
+	results, err := s.sessionRepo.ListResultsByLeague(ctx, leagueID, model.PointsSessionTypes)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := recalculatePoints(ps, results)
+	if len(changed) == 0 {
+		return 0, nil
+	}
+
+	if err := s.sessionRepo.UpdateResultPoints(ctx, changed); err != nil {
+		return 0, err
+	}
+
+	return len(changed), nil
+}
+
+// SavePointsSystem stores a league's points system, moving a league on the standard table over to it,
+// and recalculates every stored result with it in the same transaction. It returns the number of
+// results whose points changed.
+func (s *ResultService) SavePointsSystem(ctx context.Context, league *model.League, ps *model.PointsSystem) (int, error) {
+	var switched *model.LeagueSettings
+	if settings := LeagueSettingsFor(league); settings.PointsTable != model.PointsTableCustom {
+		settings.PointsTable = model.PointsTableCustom
+		switched = settings
+	}
+
+	results, err := s.sessionRepo.ListResultsByLeague(ctx, league.ID, model.PointsSessionTypes)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := recalculatePoints(ps, results)
+	if err := s.pointsRepo.Save(ctx, ps, switched, changed); err != nil {
+		return 0, err
+	}
+
+	if switched != nil {
+		league.Settings = switched
+	}
+	return len(changed), nil
+}
+
+// recalculatePoints recomputes the points of session results that are not manual overrides
+// and returns the results whose points changed
+func recalculatePoints(ps *model.PointsSystem, results []*model.SessionResult) []*model.SessionResult {
+	var changed []*model.SessionResult
+	for _, r := range results {
+		points := r.Points
+		if r.Disqualified {
+			points = 0
+		} else if !r.PointsManual {
+			points = CalculateSessionPoints(ps, r.SessionType, r.Position, r.FastestLap, r.DNF)
+		}
+
+		if points != r.Points {
+			r.Points = points
+			changed = append(changed, r)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestCalculateSessionPoints(t *testing.T) {
+	ps := DefaultPointsSystem(uuid.New())
+	pos := func(v int) *int { return &v }
+
+	tests := []struct {
+		name       string
+		session    model.SessionType
+		position   *int
+		fastestLap bool
+		dnf        bool
+		want       float64
+	}{
+		{"sprint win uses sprint table", model.SessionTypeSprint, pos(1), false, false, 8},
+		{"sprint ignores fastest lap", model.SessionTypeSprint, pos(1), true, false, 8},
+		{"race win with fastest lap", model.SessionTypeRace, pos(1), true, false, 26},
+		{"feature race uses race table", model.SessionTypeFeatureRace, pos(2), false, false, 18},
+		{"reverse grid race dnf", model.SessionTypeReverseGridRace, pos(3), false, true, 0},
+		{"endurance stint outside points", model.SessionTypeEnduranceStint, pos(11), false, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalculateSessionPoints(ps, tt.session, tt.position, tt.fastestLap, tt.dnf)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointsSystemFor(t *testing.T) {
+	leagueID := uuid.New()
+	stored := &model.PointsSystem{LeagueID: leagueID, RacePoints: []float64{10, 5}}
+
+	standard := DefaultLeagueSettings()
+	if ps := pointsSystemFor(leagueID, standard, stored); !ps.IsDefault {
+		t.Errorf("Expected the standard table to ignore the stored system, got %+v", ps)
+	}
+
+	custom := DefaultLeagueSettings()
+	custom.PointsTable = model.PointsTableCustom
+	if ps := pointsSystemFor(leagueID, custom, stored); ps != stored {
+		t.Errorf("Expected the custom table to use the stored system, got %+v", ps)
+	}
+	if ps := pointsSystemFor(leagueID, custom, nil); !ps.IsDefault {
+		t.Errorf("Expected a custom table without a stored system to fall back to the default, got %+v", ps)
+	}
+}
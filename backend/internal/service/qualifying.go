@@ -0,0 +1,77 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+// BuildGrid fills in the gap to the fastest qualifier and the starting grid slot of each result.
+// Grid penalties drop a driver the given number of places from their qualifying position, with
+// drivers whose penalty would take them past the back of the grid placed as close to it as possible.
+// Pit lane starters line up behind everyone else in qualifying order.
+func BuildGrid(results []*model.QualifyingResult) {
+	ordered := make([]*model.QualifyingResult, len(results))
+	copy(ordered, results)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Position < ordered[j].Position })
+
+	var fastest *int64
+	for _, r := range ordered {
+		if r.BestLapMs != nil && (fastest == nil || *r.BestLapMs < *fastest) {
+			fastest = r.BestLapMs
+		}
+	}
+	for _, r := range ordered {
+		r.GapMs = nil
+		if fastest != nil && r.BestLapMs != nil {
+			gap := *r.BestLapMs - *fastest
+			r.GapMs = &gap
+		}
+	}
+
+	var grid, pitLane []*model.QualifyingResult
+	for _, r := range ordered {
+		if r.PitLaneStart {
+			pitLane = append(pitLane, r)
+		} else {
+			grid = append(grid, r)
+		}
+	}
+
+	type target struct {
+		result *model.QualifyingResult
+		slot   int
+	}
+
+	var penalised []target
+	var others []*model.QualifyingResult
+	for i, r := range grid {
+		if r.GridPenalty > 0 {
+			penalised = append(penalised, target{result: r, slot: i + r.GridPenalty})
+		} else {
+			others = append(others, r)
+		}
+	}
+	// Place the heaviest drops first so a driver sent past the back of the grid stays behind
+	// drivers with smaller penalties; equal targets keep qualifying order
+	sort.SliceStable(penalised, func(i, j int) bool { return penalised[i].slot > penalised[j].slot })
+
+	slots := make([]*model.QualifyingResult, len(grid))
+	taken := make([]bool, len(grid))
+	for _, p := range penalised {
+		slot := nearestFreeSlot(taken, p.slot)
+		slots[slot] = p.result
+		taken[slot] = true
+	}
+	next := 0
+	for i := range slots {
+		if !taken[i] {
+			slots[i] = others[next]
+			next++
+		}
+	}
+
+	for i, r := range append(slots, pitLane...) {
+		r.GridPosition = i + 1
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestBuildGrid(t *testing.T) {
+	lap := func(v int64) *int64 { return &v }
+
+	results := []*model.QualifyingResult{
+		{ParticipantID: uuid.New(), Position: 1, BestLapMs: lap(80000), GridPenalty: 3},
+		{ParticipantID: uuid.New(), Position: 2, BestLapMs: lap(80150)},
+		{ParticipantID: uuid.New(), Position: 3, BestLapMs: lap(80200), PitLaneStart: true},
+		{ParticipantID: uuid.New(), Position: 4, BestLapMs: lap(80300)},
+		{ParticipantID: uuid.New(), Position: 5, GridPenalty: 10},
+	}
+
+	BuildGrid(results)
+
+	wantGrid := []int{3, 1, 5, 2, 4}
+	for i, r := range results {
+		if r.GridPosition != wantGrid[i] {
+			t.Errorf("P%d grid = %d, want %d", r.Position, r.GridPosition, wantGrid[i])
+		}
+	}
+
+	if results[1].GapMs == nil || *results[1].GapMs != 150 {
+		t.Errorf("P2 gap = %v, want 150", results[1].GapMs)
+	}
+	if results[4].GapMs != nil {
+		t.Errorf("P5 without a lap time should have no gap, got %d", *results[4].GapMs)
+	}
+}
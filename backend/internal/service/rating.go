@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+// RatingParams tunes the rating engine
+type RatingParams struct {
+	Initial float64             // Rating given to a driver's first rated match
+	KFactor float64             // Most a driver can gain or lose in one match
+	DNFMode model.RatingDNFMode // How non-finishers are compared
+}
+
+// DefaultRatingParams returns the standard Elo settings
+func DefaultRatingParams() RatingParams {
+	return RatingParams{Initial: 1500, KFactor: 32, DNFMode: model.RatingDNFLast}
+}
+
+// RatingEngine applies multi-player Elo updates match by match.
+// Every pair of drivers in a match is scored as a head-to-head game, and the K factor
+// is shared across the opponents so field size does not inflate rating swings.
+type RatingEngine struct {
+	params  RatingParams
+	ratings map[uuid.UUID]*model.DriverRating
+	history []model.RatingHistoryEntry
+}
+
+// NewRatingEngine creates an engine with no ratings
+func NewRatingEngine(params RatingParams) *RatingEngine {
+	return &RatingEngine{
+		params:  params,
+		ratings: make(map[uuid.UUID]*model.DriverRating),
+	}
+}
+
+type ratingEntry struct {
+	userID uuid.UUID
+	place  int
+	before float64
+}
+
+// Apply updates ratings from a match's classification. Matches must be applied in chronological order.
+func (e *RatingEngine) Apply(matchID uuid.UUID, results []model.RatingResult) {
+	last := 0
+	for _, r := range results {
+		if !r.DNF && r.Position != nil && *r.Position > last {
+			last = *r.Position
+		}
+	}
+
+	var entries []ratingEntry
+	for _, r := range results {
+		place := 0
+		switch {
+		case !r.DNF && r.Position != nil:
+			place = *r.Position
+		case e.params.DNFMode == model.RatingDNFLast:
+			place = last + 1
+		default:
+			continue
+		}
+		entries = append(entries, ratingEntry{userID: r.UserID, place: place, before: e.params.Initial})
+	}
+	if len(entries) < 2 {
+		return
+	}
+
+	// Sort so updates are applied in the same order on every replay
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].place != entries[j].place {
+			return entries[i].place < entries[j].place
+		}
+		return entries[i].userID.String() < entries[j].userID.String()
+	})
+	for i := range entries {
+		if r, ok := e.ratings[entries[i].userID]; ok {
+			entries[i].before = r.Rating
+		}
+	}
+
+	k := e.params.KFactor / float64(len(entries)-1)
+	for i, a := range entries {
+		var delta float64
+		for j, b := range entries {
+			if i == j {
+				continue
+			}
+			score := 0.5
+			if a.place < b.place {
+				score = 1
+			} else if a.place > b.place {
+				score = 0
+			}
+			expected := 1 / (1 + math.Pow(10, (b.before-a.before)/400))
+			delta += k * (score - expected)
+		}
+
+		after := math.Round((a.before+delta)*100) / 100
+
+		r, ok := e.ratings[a.userID]
+		if !ok {
+			r = &model.DriverRating{UserID: a.userID, PeakRating: e.params.Initial}
+			e.ratings[a.userID] = r
+		}
+		id := matchID
+		r.Rating = after
+		r.PeakRating = math.Max(r.PeakRating, after)
+		r.MatchesRated++
+		r.LastMatchID = &id
+
+		e.history = append(e.history, model.RatingHistoryEntry{
+			UserID:       a.userID,
+			MatchID:      matchID,
+			RatingBefore: a.before,
+			RatingAfter:  after,
+			Delta:        math.Round((after-a.before)*100) / 100,
+			Position:     a.place,
+			Opponents:    len(entries) - 1,
+		})
+	}
+}
+
+// Ratings returns every rating, highest first
+func (e *RatingEngine) Ratings() []model.DriverRating {
+	ratings := make([]model.DriverRating, 0, len(e.ratings))
+	for _, r := range e.ratings {
+		ratings = append(ratings, *r)
+	}
+	sort.Slice(ratings, func(i, j int) bool {
+		if ratings[i].Rating != ratings[j].Rating {
+			return ratings[i].Rating > ratings[j].Rating
+		}
+		return ratings[i].UserID.String() < ratings[j].UserID.String()
+	})
+	return ratings
+}
+
+// History returns the rating changes in the order they were applied
+func (e *RatingEngine) History() []model.RatingHistoryEntry {
+	return e.history
+}
+
+// RatingService rebuilds driver ratings from completed match results
+type RatingService struct {
+	ratingRepo *repository.RatingRepository
+	params     RatingParams
+}
+
+// NewRatingService creates a new RatingService instance
+func NewRatingService(ratingRepo *repository.RatingRepository, params RatingParams) *RatingService {
+	return &RatingService{
+		ratingRepo: ratingRepo,
+		params:     params,
+	}
+}
+
+// Replay runs every completed match through a fresh engine in chronological order.
+// It returns the engine and the completed matches that were processed, without saving anything.
+func (s *RatingService) Replay(ctx context.Context) (*RatingEngine, []uuid.UUID, error) {
+	matchIDs, err := s.ratingRepo.ListCompletedMatchIDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results, err := s.ratingRepo.ListResults(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	engine := NewRatingEngine(s.params)
+	for start := 0; start < len(results); {
+		end := start
+		for end < len(results) && results[end].MatchID == results[start].MatchID {
+			end++
+		}
+		engine.Apply(results[start].MatchID, results[start:end])
+		start = end
+	}
+
+	return engine, matchIDs, nil
+}
+
+// Recompute replays every completed match and replaces the stored ratings and history
+func (s *RatingService) Recompute(ctx context.Context) (*model.RecomputeRatingsResponse, error) {
+	engine, matchIDs, err := s.Replay(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := engine.Ratings()
+	if err := s.ratingRepo.ReplaceAll(ctx, ratings, engine.History(), matchIDs); err != nil {
+		return nil, err
+	}
+
+	return &model.RecomputeRatingsResponse{Matches: len(matchIDs), Drivers: len(ratings)}, nil
+}
+
+// ProcessPending recomputes ratings when matches have completed since the last run.
+// Results edited after a match was rated are only picked up by a manual recompute.
+func (s *RatingService) ProcessPending(ctx context.Context) (*model.RecomputeRatingsResponse, error) {
+	pending, err := s.ratingRepo.CountPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if pending == 0 {
+		return nil, nil
+	}
+	return s.Recompute(ctx)
+}
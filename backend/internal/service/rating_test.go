@@ -0,0 +1,54 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestRatingEngine(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	pos := func(p int) *int { return &p }
+	match := []model.RatingResult{
+		{UserID: c, DNF: true},
+		{UserID: b, Position: pos(2)},
+		{UserID: a, Position: pos(1)},
+	}
+
+	ratingOf := func(e *RatingEngine, id uuid.UUID) float64 {
+		for _, r := range e.Ratings() {
+			if r.UserID == id {
+				return r.Rating
+			}
+		}
+		return 0
+	}
+
+	// DNFs lose to every finisher, so the winner collects the whole K factor
+	last := NewRatingEngine(DefaultRatingParams())
+	last.Apply(uuid.New(), match)
+	if ratingOf(last, a) != 1516 || ratingOf(last, b) != 1500 || ratingOf(last, c) != 1484 {
+		t.Errorf("Unexpected ratings with DNFs last: %+v", last.Ratings())
+	}
+	if h := last.History(); len(h) != 3 || h[2].UserID != c || h[2].Position != 3 || h[2].Opponents != 2 {
+		t.Errorf("Expected the DNF classified third against two opponents, got %+v", h)
+	}
+
+	params := DefaultRatingParams()
+	params.DNFMode = model.RatingDNFExclude
+	exclude := NewRatingEngine(params)
+	exclude.Apply(uuid.New(), match)
+	if len(exclude.Ratings()) != 2 || ratingOf(exclude, a) != 1516 || ratingOf(exclude, b) != 1484 {
+		t.Errorf("Unexpected ratings with DNFs excluded: %+v", exclude.Ratings())
+	}
+
+	// A rematch between unequal ratings moves the favourite less than the underdog
+	last.Apply(uuid.New(), []model.RatingResult{{UserID: c, Position: pos(1)}, {UserID: a, Position: pos(2)}})
+	if gain := ratingOf(last, c) - 1484; gain <= 16 {
+		t.Errorf("Expected the underdog to gain more than 16, got %.2f", gain)
+	}
+	if got := ratingOf(last, a); got != 1516-(ratingOf(last, c)-1484) {
+		t.Errorf("Expected a zero-sum update, got %.2f", got)
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+)
+
+// utf8BOM lets spreadsheet applications detect UTF-8, so Korean names open correctly
+const utf8BOM = "\uFEFF"
+
+// WriteMatchResultsCSV writes a match classification in the same column layout the import accepts
+func WriteMatchResultsCSV(w io.Writer, results []*model.MatchResult) error {
+	rows := [][]string{{"position", "driver", "team", "points", "fastest_lap", "dnf", "race_time", "sprint_position", "sprint_points", "sprint_time"}}
+	for _, r := range results {
+		position := optionalInt(r.Position)
+		if r.Disqualified {
+			position = "DSQ"
+		} else if r.DNF {
+			position = "DNF"
+		}
+		rows = append(rows, []string{
+			position,
+			optionalString(r.ParticipantName),
+			optionalString(r.StoredTeamName),
+			formatPoints(r.Points),
+			strconv.FormatBool(r.FastestLap),
+			strconv.FormatBool(r.DNF),
+			formatRaceTime(r.RaceTimeMs),
+			optionalInt(r.SprintPosition),
+			formatPoints(r.SprintPoints),
+			formatRaceTime(r.SprintTimeMs),
+		})
+	}
+	return writeCSV(w, rows)
+}
+
+// WriteDriverStandingsCSV writes the driver championship table
+func WriteDriverStandingsCSV(w io.Writer, standings []model.StandingsEntry) error {
+	rows := [][]string{{"rank", "driver", "team", "points", "race_points", "sprint_points", "wins", "podiums", "poles", "fastest_laps", "dnfs", "races"}}
+	for _, s := range standings {
+		rows = append(rows, []string{
+			strconv.Itoa(s.Rank),
+			s.DriverName,
+			optionalString(s.TeamName),
+			formatPoints(s.TotalPoints),
+			formatPoints(s.RacePoints),
+			formatPoints(s.SprintPoints),
+			strconv.Itoa(s.Wins),
+			strconv.Itoa(s.Podiums),
+			strconv.Itoa(s.Poles),
+			strconv.Itoa(s.FastestLaps),
+			strconv.Itoa(s.DNFs),
+			strconv.Itoa(s.RacesCompleted),
+		})
+	}
+	return writeCSV(w, rows)
+}
+
+// WriteTeamStandingsCSV writes the constructors championship table
+func WriteTeamStandingsCSV(w io.Writer, standings []model.TeamStandingsEntry) error {
+	rows := [][]string{{"rank", "team", "points", "race_points", "sprint_points", "wins", "podiums", "fastest_laps", "dnfs", "drivers"}}
+	for _, s := range standings {
+		rows = append(rows, []string{
+			strconv.Itoa(s.Rank),
+			s.TeamName,
+			formatPoints(s.TotalPoints),
+			formatPoints(s.RacePoints),
+			formatPoints(s.SprintPoints),
+			strconv.Itoa(s.Wins),
+			strconv.Itoa(s.Podiums),
+			strconv.Itoa(s.FastestLaps),
+			strconv.Itoa(s.DNFs),
+			strconv.Itoa(s.DriverCount),
+		})
+	}
+	return writeCSV(w, rows)
+}
+
+func writeCSV(w io.Writer, rows [][]string) error {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+func optionalInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
+
+func optionalString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func formatPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
+
+// formatRaceTime renders milliseconds as "h:mm:ss.sss", which parseRaceTime reads back
+func formatRaceTime(ms *int64) string {
+	if ms == nil {
+		return ""
+	}
+	total := *ms
+	return fmt.Sprintf("%d:%02d:%02d.%03d", total/3600000, total/60000%60, total/1000%60, total%1000)
+}
@@ -0,0 +1,426 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+// Import formats accepted by ParseResultImport
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatJSON = "json"
+)
+
+// Name similarity thresholds used when matching imported drivers to participants
+const (
+	importCandidateScore = 0.6
+	importMatchScore     = 0.85
+	importMatchMargin    = 0.1
+	importMaxCandidates  = 3
+)
+
+var ErrImportFormat = errors.New("지원하지 않는 파일 형식입니다 (csv, json)")
+
+// importColumns maps normalized header names to the field they fill
+var importColumns = map[string]string{
+	"driver":         "driver",
+	"drivername":     "driver",
+	"name":           "driver",
+	"gamename":       "driver",
+	"gamertag":       "driver",
+	"player":         "driver",
+	"position":       "position",
+	"pos":            "position",
+	"finish":         "position",
+	"rank":           "position",
+	"fastestlap":     "fastest_lap",
+	"fl":             "fastest_lap",
+	"dnf":            "dnf",
+	"retired":        "dnf",
+	"penalty":        "penalty",
+	"penalties":      "penalty",
+	"penaltytime":    "penalty",
+	"penaltysec":     "penalty",
+	"timepenalty":    "penalty",
+	"time":           "time",
+	"racetime":       "time",
+	"totaltime":      "time",
+	"timems":         "time_ms",
+	"racetimems":     "time_ms",
+	"sprint":         "sprint_position",
+	"sprintpos":      "sprint_position",
+	"sprintposition": "sprint_position",
+	"sprinttime":     "sprint_time",
+}
+
+// ParseResultImport reads a classification export in CSV or JSON format.
+// CSV files need a header row; JSON files hold an array of objects, optionally under a "results" key.
+func ParseResultImport(format string, r io.Reader) ([]model.ResultImportRow, error) {
+	var records []map[string]string
+	var err error
+
+	switch format {
+	case ImportFormatCSV:
+		records, err = readImportCSV(r)
+	case ImportFormatJSON:
+		records, err = readImportJSON(r)
+	default:
+		return nil, ErrImportFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("가져올 결과가 없습니다")
+	}
+
+	rows := make([]model.ResultImportRow, 0, len(records))
+	for i, record := range records {
+		line := i + 1
+		if format == ImportFormatCSV {
+			line = i + 2 // Header is the first line
+		}
+
+		row, err := parseImportRecord(line, record)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func readImportCSV(r io.Reader) ([]map[string]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.New("CSV 파일을 읽을 수 없습니다")
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	header := make([]string, len(lines[0]))
+	for i, name := range lines[0] {
+		header[i] = importColumns[normalizeColumn(name)]
+	}
+
+	records := make([]map[string]string, 0, len(lines)-1)
+	for _, line := range lines[1:] {
+		record := make(map[string]string)
+		for i, value := range line {
+			if i < len(header) && header[i] != "" {
+				record[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func readImportJSON(r io.Reader) ([]map[string]string, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, errors.New("JSON 파일을 읽을 수 없습니다")
+	}
+
+	var items []map[string]any
+	if err := json.Unmarshal(raw, &items); err != nil {
+		var wrapped struct {
+			Results []map[string]any `json:"results"`
+		}
+		if err := json.Unmarshal(raw, &wrapped); err != nil {
+			return nil, errors.New("JSON 결과 목록을 찾을 수 없습니다")
+		}
+		items = wrapped.Results
+	}
+
+	records := make([]map[string]string, 0, len(items))
+	for _, item := range items {
+		record := make(map[string]string)
+		for key, value := range item {
+			field := importColumns[normalizeColumn(key)]
+			if field == "" || value == nil {
+				continue
+			}
+			switch v := value.(type) {
+			case string:
+				record[field] = strings.TrimSpace(v)
+			case float64:
+				record[field] = strconv.FormatFloat(v, 'f', -1, 64)
+			case bool:
+				record[field] = strconv.FormatBool(v)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func parseImportRecord(line int, record map[string]string) (model.ResultImportRow, error) {
+	row := model.ResultImportRow{Line: line, DriverName: record["driver"]}
+	if row.DriverName == "" {
+		return row, fmt.Errorf("%d번째 줄: 드라이버 이름이 없습니다", line)
+	}
+
+	if value := strings.ToUpper(record["position"]); value != "" {
+		switch value {
+		case "DNF", "RET", "DNS", "NC", "DSQ":
+			row.DNF = true
+		default:
+			position, err := strconv.Atoi(strings.TrimPrefix(value, "P"))
+			if err != nil || position < 1 {
+				return row, fmt.Errorf("%d번째 줄: 순위가 올바르지 않습니다", line)
+			}
+			row.Position = &position
+		}
+	}
+
+	var err error
+	if row.FastestLap, err = parseImportBool(record["fastest_lap"]); err != nil {
+		return row, fmt.Errorf("%d번째 줄: 패스티스트 랩 값이 올바르지 않습니다", line)
+	}
+	dnf, err := parseImportBool(record["dnf"])
+	if err != nil {
+		return row, fmt.Errorf("%d번째 줄: DNF 값이 올바르지 않습니다", line)
+	}
+	row.DNF = row.DNF || dnf
+
+	if value := strings.TrimSuffix(strings.TrimPrefix(record["penalty"], "+"), "s"); value != "" {
+		penalty, err := strconv.Atoi(value)
+		if err != nil || penalty < 0 {
+			return row, fmt.Errorf("%d번째 줄: 페널티 시간이 올바르지 않습니다", line)
+		}
+		row.PenaltySec = penalty
+	}
+
+	if value := record["time_ms"]; value != "" {
+		ms, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || ms < 0 {
+			return row, fmt.Errorf("%d번째 줄: 기록 시간이 올바르지 않습니다", line)
+		}
+		row.RaceTimeMs = &ms
+	} else if value := record["time"]; value != "" {
+		ms, err := parseRaceTime(value)
+		if err != nil {
+			return row, fmt.Errorf("%d번째 줄: 기록 시간이 올바르지 않습니다", line)
+		}
+		row.RaceTimeMs = &ms
+	}
+
+	if value := strings.ToUpper(record["sprint_position"]); value != "" {
+		switch value {
+		case "DNF", "RET", "DNS", "NC", "DSQ":
+		default:
+			position, err := strconv.Atoi(strings.TrimPrefix(value, "P"))
+			if err != nil || position < 1 {
+				return row, fmt.Errorf("%d번째 줄: 스프린트 순위가 올바르지 않습니다", line)
+			}
+			row.SprintPosition = &position
+		}
+	}
+
+	if value := record["sprint_time"]; value != "" {
+		ms, err := parseRaceTime(value)
+		if err != nil {
+			return row, fmt.Errorf("%d번째 줄: 스프린트 기록 시간이 올바르지 않습니다", line)
+		}
+		row.SprintTimeMs = &ms
+	}
+
+	return row, nil
+}
+
+func parseImportBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "", "0", "false", "no", "n":
+		return false, nil
+	case "1", "true", "yes", "y", "x", "o":
+		return true, nil
+	default:
+		return false, errors.New("invalid boolean")
+	}
+}
+
+// parseRaceTime parses "h:mm:ss.sss", "mm:ss.sss" or plain seconds into milliseconds
+func parseRaceTime(value string) (int64, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 {
+		return 0, errors.New("invalid time")
+	}
+
+	var seconds float64
+	for _, part := range parts {
+		n, err := strconv.ParseFloat(part, 64)
+		if err != nil || n < 0 {
+			return 0, errors.New("invalid time")
+		}
+		seconds = seconds*60 + n
+	}
+	return int64(seconds*1000 + 0.5), nil
+}
+
+func normalizeColumn(name string) string {
+	name = strings.TrimPrefix(name, utf8BOM)
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '_' || r == '-' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, strings.TrimSpace(name))
+}
+
+// MatchImportRows resolves each imported driver name against the league's participants.
+// Exact and clearly closest matches are resolved; close calls are left ambiguous for the admin,
+// as are rows that would claim a participant already taken by another row.
+func MatchImportRows(rows []model.ResultImportRow, participants []*model.LeagueParticipant) {
+	claimed := make(map[uuid.UUID][]int)
+
+	for i := range rows {
+		row := &rows[i]
+		row.Candidates = importCandidates(row.DriverName, participants)
+		row.Status = model.ImportMatchUnmatched
+		row.ParticipantID = nil
+		row.ParticipantName = nil
+
+		if len(row.Candidates) == 0 {
+			continue
+		}
+		row.Status = model.ImportMatchAmbiguous
+
+		top := row.Candidates[0]
+		clear := top.Score == 1 ||
+			(top.Score >= importMatchScore && (len(row.Candidates) == 1 || row.Candidates[1].Score < top.Score-importMatchMargin))
+		if !clear {
+			continue
+		}
+
+		id, name := top.ParticipantID, top.Name
+		row.Status = model.ImportMatchMatched
+		row.ParticipantID = &id
+		row.ParticipantName = &name
+		claimed[id] = append(claimed[id], i)
+	}
+
+	for _, indexes := range claimed {
+		if len(indexes) < 2 {
+			continue
+		}
+		for _, i := range indexes {
+			rows[i].Status = model.ImportMatchAmbiguous
+			rows[i].ParticipantID = nil
+			rows[i].ParticipantName = nil
+		}
+	}
+}
+
+func importCandidates(driverName string, participants []*model.LeagueParticipant) []model.ImportCandidate {
+	name := normalizeDriverName(driverName)
+
+	var candidates []model.ImportCandidate
+	for _, p := range participants {
+		if p.UserNickname == nil {
+			continue
+		}
+		score := nameSimilarity(name, normalizeDriverName(*p.UserNickname))
+		if score >= importCandidateScore {
+			candidates = append(candidates, model.ImportCandidate{
+				ParticipantID: p.ID,
+				Name:          *p.UserNickname,
+				Score:         score,
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Score > candidates[j].Score })
+	if len(candidates) > importMaxCandidates {
+		candidates = candidates[:importMaxCandidates]
+	}
+	return candidates
+}
+
+// normalizeDriverName drops case, spacing and punctuation so that "[FRC] Max_V" and "frc max v" compare equal
+func normalizeDriverName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// nameSimilarity scores two normalized names from 0 to 1 by edit distance.
+// A name contained in the other, such as a nickname inside a tagged game name, scores at least the match threshold.
+func nameSimilarity(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	score := 1 - float64(levenshtein(ra, rb))/float64(longest)
+
+	if min(len(ra), len(rb)) >= 3 && (strings.Contains(a, b) || strings.Contains(b, a)) {
+		score = max(score, importMatchScore)
+	}
+	return score
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// ImportResultRequests converts confirmed import rows into race and sprint results.
+// Penalty time is added to the recorded race time; positions are taken as already including it.
+func ImportResultRequests(entries []model.ResultImportEntry) []model.CreateMatchResultRequest {
+	results := make([]model.CreateMatchResultRequest, len(entries))
+	for i, entry := range entries {
+		results[i] = model.CreateMatchResultRequest{
+			ParticipantID:  entry.ParticipantID,
+			Position:       entry.Position,
+			FastestLap:     entry.FastestLap,
+			DNF:            entry.DNF,
+			SprintPosition: entry.SprintPosition,
+			SprintTimeMs:   entry.SprintTimeMs,
+		}
+		if entry.RaceTimeMs != nil {
+			total := *entry.RaceTimeMs + int64(entry.PenaltySec)*1000
+			results[i].RaceTimeMs = &total
+		}
+	}
+	return results
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestParseAndMatchResultImport(t *testing.T) {
+	csv := utf8BOM + "Pos,Driver Name,Fastest Lap,Penalty,Race Time\n" +
+		"1,[FRC] MaxVerstappen,,,1:25:10.500\n" +
+		"2,Land0,x,5s,1:25:20.000\n" +
+		"DNF,Charles,,,\n" +
+		"4,Unknown Racer,,,\n"
+
+	rows, err := ParseResultImport(ImportFormatCSV, strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("ParseResultImport() error = %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("parsed %d rows, want 4", len(rows))
+	}
+	if rows[0].RaceTimeMs == nil || *rows[0].RaceTimeMs != 5110500 {
+		t.Errorf("row 1 time = %v, want 5110500", rows[0].RaceTimeMs)
+	}
+	if !rows[1].FastestLap || rows[1].PenaltySec != 5 {
+		t.Errorf("row 2 = %+v, want fastest lap with 5s penalty", rows[1])
+	}
+	if !rows[2].DNF || rows[2].Position != nil {
+		t.Errorf("row 3 = %+v, want DNF without position", rows[2])
+	}
+
+	nickname := func(name string) *model.LeagueParticipant {
+		return &model.LeagueParticipant{ID: uuid.New(), UserNickname: &name}
+	}
+	participants := []*model.LeagueParticipant{
+		nickname("MaxVerstappen"),
+		nickname("Lando"),
+		nickname("Landon"),
+		nickname("Charles"),
+	}
+
+	MatchImportRows(rows, participants)
+
+	want := []model.ImportMatchStatus{
+		model.ImportMatchMatched,
+		model.ImportMatchAmbiguous,
+		model.ImportMatchMatched,
+		model.ImportMatchUnmatched,
+	}
+	for i, row := range rows {
+		if row.Status != want[i] {
+			t.Errorf("row %d (%s) status = %s, want %s", row.Line, row.DriverName, row.Status, want[i])
+		}
+	}
+	if rows[0].ParticipantID == nil || *rows[0].ParticipantID != participants[0].ID {
+		t.Errorf("tagged game name should match MaxVerstappen, got %v", rows[0].ParticipantID)
+	}
+
+	results := ImportResultRequests([]model.ResultImportEntry{
+		{ParticipantID: participants[1].ID, PenaltySec: 5, RaceTimeMs: rows[1].RaceTimeMs},
+	})
+	if *results[0].RaceTimeMs != 5125000 {
+		t.Errorf("race time with penalty = %d, want 5125000", *results[0].RaceTimeMs)
+	}
+}
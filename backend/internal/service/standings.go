@@ -0,0 +1,557 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+// DefaultAppealWindowHours is how long results stay provisional when a league has not configured its own window
+const DefaultAppealWindowHours = 24
+
+// LeagueStandings holds the computed driver and team standings for a league
+type LeagueStandings struct {
+	Drivers           []model.StandingsEntry
+	Teams             []model.TeamStandingsEntry
+	Rules             *model.StandingsRules
+	ProvisionalRounds []int // Rounds with counted results still in their appeal window
+}
+
+// StandingsService calculates championship standings with league-level rules applied
+type StandingsService struct {
+	resultRepo     *repository.MatchResultRepository
+	rulesRepo      *repository.StandingsRulesRepository
+	qualifyingRepo *repository.QualifyingRepository
+}
+
+// NewStandingsService creates a new StandingsService instance
+func NewStandingsService(resultRepo *repository.MatchResultRepository, rulesRepo *repository.StandingsRulesRepository, qualifyingRepo *repository.QualifyingRepository) *StandingsService {
+	return &StandingsService{
+		resultRepo:     resultRepo,
+		rulesRepo:      rulesRepo,
+		qualifyingRepo: qualifyingRepo,
+	}
+}
+
+// RulesFor returns the league's standings rules, falling back to counting every round
+func (s *StandingsService) RulesFor(ctx context.Context, leagueID uuid.UUID) (*model.StandingsRules, error) {
+	rules, err := s.rulesRepo.GetByLeague(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrStandingsRulesNotFound) {
+			return &model.StandingsRules{LeagueID: leagueID, ApplyToTeams: true, AppealWindowHours: DefaultAppealWindowHours, ReservePointsMode: model.ReservePointsCount}, nil
+		}
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Compute calculates driver and team standings for a league.
+// With officialOnly set, results still within their appeal window are left out.
+func (s *StandingsService) Compute(ctx context.Context, leagueID uuid.UUID, officialOnly bool) (*LeagueStandings, error) {
+	drivers, err := s.resultRepo.GetLeagueStandings(ctx, leagueID, officialOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.resultRepo.GetTeamStandings(ctx, leagueID, officialOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.RulesFor(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds, err := s.resultRepo.ListRoundResults(ctx, leagueID, officialOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	markReserveEligibility(rounds, rules)
+	removeReservePoints(drivers, rounds)
+	applyStandingsRules(drivers, teams, rounds, rules)
+
+	driverProfiles, teamProfiles := buildFinishProfiles(rounds)
+	rankDrivers(drivers, driverProfiles)
+	rankTeams(teams, teamProfiles)
+
+	qualifying, err := s.qualifyingRepo.ListStatsByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range drivers {
+		stats := qualifying[drivers[i].ParticipantID]
+		drivers[i].Poles = stats.Poles
+		drivers[i].PositionsGained = stats.PositionsGained
+	}
+
+	return &LeagueStandings{
+		Drivers:           drivers,
+		Teams:             teams,
+		Rules:             rules,
+		ProvisionalRounds: provisionalRounds(rounds),
+	}, nil
+}
+
+// provisionalRounds lists, in order, the rounds that include provisional results
+func provisionalRounds(results []model.RoundResult) []int {
+	var rounds []int
+	for _, r := range results {
+		if r.Provisional && !slices.Contains(rounds, r.Round) {
+			rounds = append(rounds, r.Round)
+		}
+	}
+	slices.Sort(rounds)
+	return rounds
+}
+
+// LeagueProgression holds cumulative driver and team standings after each completed round
+type LeagueProgression struct {
+	Rounds  []model.ProgressionRound
+	Drivers []model.DriverProgression
+	Teams   []model.TeamProgression
+}
+
+// Progression calculates cumulative points and championship positions after each completed match round.
+// Sprint points count towards the round the sprint was held in.
+func (s *StandingsService) Progression(ctx context.Context, leagueID uuid.UUID, matches []*model.Match) (*LeagueProgression, error) {
+	roster, err := s.resultRepo.GetLeagueStandings(ctx, leagueID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	teamRoster, err := s.resultRepo.GetTeamStandings(ctx, leagueID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.RulesFor(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.resultRepo.ListRoundResults(ctx, leagueID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	markReserveEligibility(results, rules)
+
+	progression := &LeagueProgression{
+		Rounds:  []model.ProgressionRound{},
+		Drivers: make([]model.DriverProgression, len(roster)),
+		Teams:   make([]model.TeamProgression, len(teamRoster)),
+	}
+
+	driverIndex := make(map[uuid.UUID]int, len(roster))
+	for i, d := range roster {
+		driverIndex[d.ParticipantID] = i
+		progression.Drivers[i] = model.DriverProgression{
+			ParticipantID: d.ParticipantID,
+			DriverName:    d.DriverName,
+			TeamName:      d.TeamName,
+			Progression:   []model.ProgressionPoint{},
+		}
+	}
+	teamIndex := make(map[string]int, len(teamRoster))
+	for i, t := range teamRoster {
+		teamIndex[t.TeamName] = i
+		progression.Teams[i] = model.TeamProgression{
+			TeamName:    t.TeamName,
+			Progression: []model.ProgressionPoint{},
+		}
+	}
+
+	completed := make(map[int]bool)
+	for _, m := range matches {
+		if m.Status == model.MatchStatusCompleted {
+			completed[m.Round] = true
+			progression.Rounds = append(progression.Rounds, model.ProgressionRound{
+				Round:   m.Round,
+				MatchID: m.ID,
+				Track:   m.Track,
+			})
+		}
+	}
+	sort.SliceStable(progression.Rounds, func(i, j int) bool {
+		return progression.Rounds[i].Round < progression.Rounds[j].Round
+	})
+
+	for _, round := range progression.Rounds {
+		var subset []model.RoundResult
+		driverRoundPoints := make(map[uuid.UUID]float64)
+		teamRoundPoints := make(map[string]float64)
+		for _, r := range results {
+			if !completed[r.Round] || r.Round > round.Round {
+				continue
+			}
+			subset = append(subset, r)
+			if r.Round == round.Round {
+				if !r.DriverExcluded {
+					driverRoundPoints[r.ParticipantID] += r.Points + r.SprintPoints
+				}
+				if r.TeamName != nil {
+					teamRoundPoints[*r.TeamName] += r.Points + r.SprintPoints
+				}
+			}
+		}
+
+		drivers, teams := standingsFromResults(roster, teamRoster, subset)
+		applyStandingsRules(drivers, teams, subset, rules)
+		driverProfiles, teamProfiles := buildFinishProfiles(subset)
+		rankDrivers(drivers, driverProfiles)
+		rankTeams(teams, teamProfiles)
+
+		for _, d := range drivers {
+			i := driverIndex[d.ParticipantID]
+			progression.Drivers[i].Progression = append(progression.Drivers[i].Progression, model.ProgressionPoint{
+				Round:       round.Round,
+				RoundPoints: roundPoints(driverRoundPoints[d.ParticipantID]),
+				TotalPoints: d.TotalPoints,
+				Position:    d.Rank,
+			})
+		}
+		for _, t := range teams {
+			i := teamIndex[t.TeamName]
+			progression.Teams[i].Progression = append(progression.Teams[i].Progression, model.ProgressionPoint{
+				Round:       round.Round,
+				RoundPoints: roundPoints(teamRoundPoints[t.TeamName]),
+				TotalPoints: t.TotalPoints,
+				Position:    t.Rank,
+			})
+		}
+	}
+
+	return progression, nil
+}
+
+// standingsFromResults builds point totals for the given drivers and teams from a set of round results
+func standingsFromResults(roster []model.StandingsEntry, teamRoster []model.TeamStandingsEntry, results []model.RoundResult) ([]model.StandingsEntry, []model.TeamStandingsEntry) {
+	drivers := make([]model.StandingsEntry, len(roster))
+	driverIndex := make(map[uuid.UUID]int, len(roster))
+	for i, d := range roster {
+		drivers[i] = model.StandingsEntry{
+			ParticipantID: d.ParticipantID,
+			UserID:        d.UserID,
+			DriverName:    d.DriverName,
+			TeamName:      d.TeamName,
+		}
+		driverIndex[d.ParticipantID] = i
+	}
+
+	teams := make([]model.TeamStandingsEntry, len(teamRoster))
+	teamIndex := make(map[string]int, len(teamRoster))
+	for i, t := range teamRoster {
+		teams[i] = model.TeamStandingsEntry{TeamName: t.TeamName}
+		teamIndex[t.TeamName] = i
+	}
+
+	for _, r := range results {
+		if i, ok := driverIndex[r.ParticipantID]; ok && !r.DriverExcluded {
+			drivers[i].RacePoints += r.Points
+			drivers[i].SprintPoints += r.SprintPoints
+		}
+		if r.TeamName == nil {
+			continue
+		}
+		if i, ok := teamIndex[*r.TeamName]; ok {
+			teams[i].RacePoints += r.Points
+			teams[i].SprintPoints += r.SprintPoints
+		}
+	}
+
+	for i := range drivers {
+		drivers[i].TotalPoints = roundPoints(drivers[i].RacePoints + drivers[i].SprintPoints)
+	}
+	for i := range teams {
+		teams[i].TotalPoints = roundPoints(teams[i].RacePoints + teams[i].SprintPoints)
+	}
+
+	return drivers, teams
+}
+
+// markReserveEligibility flags substitute results that do not count for the driver under the league's reserve rule.
+// In capped mode a reserve's earliest substitute rounds count, up to the cap. Results must be ordered by round.
+func markReserveEligibility(results []model.RoundResult, rules *model.StandingsRules) {
+	if rules.ReservePointsMode != model.ReservePointsExclude && rules.ReservePointsMode != model.ReservePointsCapped {
+		return
+	}
+
+	limit := 0
+	if rules.ReservePointsMode == model.ReservePointsCapped && rules.ReserveRoundCap != nil {
+		limit = *rules.ReserveRoundCap
+	}
+
+	counted := make(map[uuid.UUID][]int)
+	for i := range results {
+		r := &results[i]
+		if !r.Substitute {
+			continue
+		}
+		rounds := counted[r.ParticipantID]
+		if slices.Contains(rounds, r.Round) {
+			continue
+		}
+		if len(rounds) < limit {
+			counted[r.ParticipantID] = append(rounds, r.Round)
+			continue
+		}
+		r.DriverExcluded = true
+	}
+}
+
+// removeReservePoints takes substitute points that do not count for the driver out of their totals
+func removeReservePoints(drivers []model.StandingsEntry, results []model.RoundResult) {
+	index := make(map[uuid.UUID]int, len(drivers))
+	for i, d := range drivers {
+		index[d.ParticipantID] = i
+	}
+
+	for _, r := range results {
+		i, ok := index[r.ParticipantID]
+		if !r.DriverExcluded || !ok {
+			continue
+		}
+		drivers[i].RacePoints = roundPoints(drivers[i].RacePoints - r.Points)
+		drivers[i].SprintPoints = roundPoints(drivers[i].SprintPoints - r.SprintPoints)
+		drivers[i].ReservePoints = roundPoints(drivers[i].ReservePoints + r.Points + r.SprintPoints)
+		drivers[i].TotalPoints = roundPoints(drivers[i].TotalPoints - r.Points - r.SprintPoints)
+	}
+}
+
+// applyStandingsRules removes dropped round scores from each driver's and team's total
+func applyStandingsRules(drivers []model.StandingsEntry, teams []model.TeamStandingsEntry, results []model.RoundResult, rules *model.StandingsRules) {
+	if rules.DropWorstRounds == 0 && rules.CountedRounds == nil {
+		return
+	}
+
+	rounds := completedRounds(results)
+
+	driverScores := make(map[uuid.UUID]map[int]float64)
+	teamScores := make(map[string]map[int]float64)
+	for _, r := range results {
+		if driverScores[r.ParticipantID] == nil {
+			driverScores[r.ParticipantID] = make(map[int]float64)
+		}
+		if !r.DriverExcluded {
+			driverScores[r.ParticipantID][r.Round] += r.Points + r.SprintPoints
+		}
+
+		if r.TeamName != nil && *r.TeamName != "" {
+			if teamScores[*r.TeamName] == nil {
+				teamScores[*r.TeamName] = make(map[int]float64)
+			}
+			teamScores[*r.TeamName][r.Round] += r.Points + r.SprintPoints
+		}
+	}
+
+	for i := range drivers {
+		dropped, droppedPoints := selectDroppedRounds(rounds, driverScores[drivers[i].ParticipantID], rules)
+		drivers[i].DroppedRounds = dropped
+		drivers[i].DroppedPoints = roundPoints(droppedPoints)
+		drivers[i].TotalPoints = roundPoints(drivers[i].TotalPoints - droppedPoints)
+	}
+
+	if !rules.ApplyToTeams {
+		return
+	}
+
+	for i := range teams {
+		dropped, droppedPoints := selectDroppedRounds(rounds, teamScores[teams[i].TeamName], rules)
+		teams[i].DroppedRounds = dropped
+		teams[i].DroppedPoints = roundPoints(droppedPoints)
+		teams[i].TotalPoints = roundPoints(teams[i].TotalPoints - droppedPoints)
+	}
+}
+
+// completedRounds returns the distinct rounds that have results, in ascending order
+func completedRounds(results []model.RoundResult) []int {
+	seen := make(map[int]bool)
+	var rounds []int
+	for _, r := range results {
+		if !seen[r.Round] {
+			seen[r.Round] = true
+			rounds = append(rounds, r.Round)
+		}
+	}
+	sort.Ints(rounds)
+	return rounds
+}
+
+// selectDroppedRounds picks the lowest-scoring rounds to drop under the given rules.
+// Rounds without a result count as zero, and at least one round always counts.
+func selectDroppedRounds(rounds []int, scores map[int]float64, rules *model.StandingsRules) ([]int, float64) {
+	dropCount := rules.DropWorstRounds
+	if rules.CountedRounds != nil && len(rounds)-dropCount > *rules.CountedRounds {
+		dropCount = len(rounds) - *rules.CountedRounds
+	}
+	if dropCount > len(rounds)-1 {
+		dropCount = len(rounds) - 1
+	}
+	if dropCount <= 0 {
+		return nil, 0
+	}
+
+	ordered := append([]int(nil), rounds...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if scores[ordered[i]] != scores[ordered[j]] {
+			return scores[ordered[i]] < scores[ordered[j]]
+		}
+		// Drop the later of two equally scored rounds
+		return ordered[i] > ordered[j]
+	})
+
+	dropped := ordered[:dropCount]
+	sort.Ints(dropped)
+
+	var droppedPoints float64
+	for _, round := range dropped {
+		droppedPoints += scores[round]
+	}
+
+	return dropped, droppedPoints
+}
+
+// finishProfile counts classified race finishes per position for countback
+type finishProfile struct {
+	counts map[int]int // position -> number of finishes
+	first  map[int]int // position -> earliest round achieved
+	maxPos int
+}
+
+func (p *finishProfile) add(position, round int) {
+	p.counts[position]++
+	if first, ok := p.first[position]; !ok || round < first {
+		p.first[position] = round
+	}
+	if position > p.maxPos {
+		p.maxPos = position
+	}
+}
+
+func newFinishProfile() *finishProfile {
+	return &finishProfile{counts: make(map[int]int), first: make(map[int]int)}
+}
+
+// buildFinishProfiles collects classified race finishes per driver and per team
+func buildFinishProfiles(results []model.RoundResult) (map[uuid.UUID]*finishProfile, map[string]*finishProfile) {
+	drivers := make(map[uuid.UUID]*finishProfile)
+	teams := make(map[string]*finishProfile)
+	for _, r := range results {
+		if r.Position == nil || *r.Position < 1 || r.DNF {
+			continue
+		}
+		if !r.DriverExcluded {
+			if drivers[r.ParticipantID] == nil {
+				drivers[r.ParticipantID] = newFinishProfile()
+			}
+			drivers[r.ParticipantID].add(*r.Position, r.Round)
+		}
+
+		if r.TeamName != nil && *r.TeamName != "" {
+			if teams[*r.TeamName] == nil {
+				teams[*r.TeamName] = newFinishProfile()
+			}
+			teams[*r.TeamName].add(*r.Position, r.Round)
+		}
+	}
+	return drivers, teams
+}
+
+// compareCountback compares two entries on equal points. It returns a negative value
+// when a ranks ahead of b, a positive value when b ranks ahead, and zero when the tie
+// cannot be broken, along with the rule that decided it.
+func compareCountback(a, b *finishProfile) (int, *model.TieBreak) {
+	if a == nil {
+		a = newFinishProfile()
+	}
+	if b == nil {
+		b = newFinishProfile()
+	}
+
+	maxPos := a.maxPos
+	if b.maxPos > maxPos {
+		maxPos = b.maxPos
+	}
+
+	// Most wins, then most 2nd places, then 3rd, and so on
+	for pos := 1; pos <= maxPos; pos++ {
+		if a.counts[pos] != b.counts[pos] {
+			return b.counts[pos] - a.counts[pos], &model.TieBreak{Rule: model.TieBreakCountback, Position: pos}
+		}
+	}
+
+	// Identical finishes: whoever achieved the best result first
+	for pos := 1; pos <= maxPos; pos++ {
+		if a.counts[pos] == 0 {
+			continue
+		}
+		if a.first[pos] != b.first[pos] {
+			return a.first[pos] - b.first[pos], &model.TieBreak{Rule: model.TieBreakEarliestResult, Position: pos}
+		}
+	}
+
+	return 0, &model.TieBreak{Rule: model.TieBreakShared}
+}
+
+// rankDrivers orders drivers by points and countback. Drivers that cannot be separated share a rank.
+func rankDrivers(drivers []model.StandingsEntry, profiles map[uuid.UUID]*finishProfile) {
+	sort.SliceStable(drivers, func(i, j int) bool {
+		a, b := drivers[i], drivers[j]
+		if a.TotalPoints != b.TotalPoints {
+			return a.TotalPoints > b.TotalPoints
+		}
+		cmp, _ := compareCountback(profiles[a.ParticipantID], profiles[b.ParticipantID])
+		return cmp < 0
+	})
+
+	for i := range drivers {
+		drivers[i].Rank = i + 1
+		drivers[i].TieBreak = nil
+		if i == 0 || drivers[i].TotalPoints != drivers[i-1].TotalPoints {
+			continue
+		}
+		cmp, tieBreak := compareCountback(profiles[drivers[i-1].ParticipantID], profiles[drivers[i].ParticipantID])
+		drivers[i].TieBreak = tieBreak
+		if cmp == 0 {
+			drivers[i].Rank = drivers[i-1].Rank
+		}
+	}
+}
+
+// rankTeams orders teams by points and countback. Teams that cannot be separated share a rank.
+func rankTeams(teams []model.TeamStandingsEntry, profiles map[string]*finishProfile) {
+	sort.SliceStable(teams, func(i, j int) bool {
+		a, b := teams[i], teams[j]
+		if a.TotalPoints != b.TotalPoints {
+			return a.TotalPoints > b.TotalPoints
+		}
+		cmp, _ := compareCountback(profiles[a.TeamName], profiles[b.TeamName])
+		return cmp < 0
+	})
+
+	for i := range teams {
+		teams[i].Rank = i + 1
+		teams[i].TieBreak = nil
+		if i == 0 || teams[i].TotalPoints != teams[i-1].TotalPoints {
+			continue
+		}
+		cmp, tieBreak := compareCountback(profiles[teams[i-1].TeamName], profiles[teams[i].TeamName])
+		teams[i].TieBreak = tieBreak
+		if cmp == 0 {
+			teams[i].Rank = teams[i-1].Rank
+		}
+	}
+}
+
+// roundPoints rounds to one decimal place to match the DECIMAL(5,1) point columns
+func roundPoints(p float64) float64 {
+	return math.Round(p*10) / 10
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func TestSelectDroppedRounds(t *testing.T) {
+	rounds := []int{1, 2, 3, 4, 5}
+	scores := map[int]float64{1: 25, 2: 18, 3: 0, 4: 18, 5: 10}
+	counted := 3
+
+	tests := []struct {
+		name          string
+		rules         *model.StandingsRules
+		scores        map[int]float64
+		wantDropped   []int
+		wantDroppedPt float64
+	}{
+		{"no rules", &model.StandingsRules{}, scores, nil, 0},
+		{"drop worst two", &model.StandingsRules{DropWorstRounds: 2}, scores, []int{3, 5}, 10},
+		{"best three", &model.StandingsRules{CountedRounds: &counted}, scores, []int{3, 5}, 10},
+		{"tie drops later round", &model.StandingsRules{DropWorstRounds: 3}, scores, []int{3, 4, 5}, 28},
+		{"missing rounds count as zero", &model.StandingsRules{DropWorstRounds: 1}, map[int]float64{1: 25}, []int{5}, 0},
+		{"at least one round counts", &model.StandingsRules{DropWorstRounds: 10}, scores, []int{2, 3, 4, 5}, 46},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dropped, droppedPoints := selectDroppedRounds(rounds, tt.scores, tt.rules)
+			if !reflect.DeepEqual(dropped, tt.wantDropped) {
+				t.Errorf("Expected dropped rounds %v, got %v", tt.wantDropped, dropped)
+			}
+			if droppedPoints != tt.wantDroppedPt {
+				t.Errorf("Expected dropped points %v, got %v", tt.wantDroppedPt, droppedPoints)
+			}
+		})
+	}
+}
+
+func TestRankDrivers_Countback(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	pos := func(p int) *int { return &p }
+
+	results := []model.RoundResult{
+		{Round: 1, ParticipantID: a, Position: pos(2)},
+		{Round: 1, ParticipantID: b, Position: pos(1)},
+		{Round: 1, ParticipantID: c, Position: pos(3)},
+		{Round: 1, ParticipantID: d, Position: pos(4)},
+		{Round: 2, ParticipantID: a, Position: pos(1)},
+		{Round: 2, ParticipantID: b, Position: pos(2)},
+		{Round: 2, ParticipantID: c, Position: pos(4)},
+		{Round: 2, ParticipantID: d, Position: pos(3)},
+	}
+	drivers := []model.StandingsEntry{
+		{ParticipantID: c, TotalPoints: 27},
+		{ParticipantID: d, TotalPoints: 27},
+		{ParticipantID: a, TotalPoints: 43},
+		{ParticipantID: b, TotalPoints: 43},
+	}
+
+	profiles, _ := buildFinishProfiles(results)
+	rankDrivers(drivers, profiles)
+
+	// b won earlier than a, c finished 3rd earlier than d
+	if drivers[0].ParticipantID != b || drivers[1].ParticipantID != a {
+		t.Fatalf("Expected b ahead of a on earliest result, got %v", drivers)
+	}
+	if drivers[1].TieBreak == nil || drivers[1].TieBreak.Rule != model.TieBreakEarliestResult || drivers[1].TieBreak.Position != 1 {
+		t.Errorf("Expected earliest_result tie-break at P1, got %+v", drivers[1].TieBreak)
+	}
+	if drivers[3].TieBreak == nil || drivers[3].TieBreak.Rule != model.TieBreakEarliestResult || drivers[3].TieBreak.Position != 3 {
+		t.Errorf("Expected earliest_result tie-break at P3, got %+v", drivers[3].TieBreak)
+	}
+	if drivers[2].ParticipantID != c || drivers[2].Rank != 3 || drivers[3].Rank != 4 {
+		t.Errorf("Expected c third and d fourth, got %+v", drivers)
+	}
+
+	// Identical records share a rank
+	shared := []model.StandingsEntry{{ParticipantID: uuid.New(), TotalPoints: 10}, {ParticipantID: uuid.New(), TotalPoints: 10}}
+	rankDrivers(shared, map[uuid.UUID]*finishProfile{})
+	if shared[0].Rank != 1 || shared[1].Rank != 1 || shared[1].TieBreak.Rule != model.TieBreakShared {
+		t.Errorf("Expected shared rank 1, got %+v", shared)
+	}
+}
+
+func TestProvisionalRounds(t *testing.T) {
+	results := []model.RoundResult{
+		{Round: 3, Provisional: true},
+		{Round: 1},
+		{Round: 2, Provisional: true},
+		{Round: 3, Provisional: true},
+	}
+
+	if got := provisionalRounds(results); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("Expected provisional rounds [2 3], got %v", got)
+	}
+	if got := provisionalRounds(results[1:2]); got != nil {
+		t.Errorf("Expected no provisional rounds, got %v", got)
+	}
+}
+
+func TestMarkReserveEligibility(t *testing.T) {
+	reserve := uuid.New()
+	newResults := func() []model.RoundResult {
+		return []model.RoundResult{
+			{Round: 1, Session: model.SessionTypeSprint, ParticipantID: reserve, SprintPoints: 8, Substitute: true},
+			{Round: 1, Session: model.SessionTypeRace, ParticipantID: reserve, Points: 25, Substitute: true},
+			{Round: 2, Session: model.SessionTypeRace, ParticipantID: reserve, Points: 10},
+			{Round: 3, Session: model.SessionTypeRace, ParticipantID: reserve, Points: 18, Substitute: true},
+		}
+	}
+	one := 1
+
+	tests := []struct {
+		name     string
+		rules    model.StandingsRules
+		excluded []bool
+		total    float64
+	}{
+		{"count", model.StandingsRules{ReservePointsMode: model.ReservePointsCount}, []bool{false, false, false, false}, 61},
+		{"exclude", model.StandingsRules{ReservePointsMode: model.ReservePointsExclude}, []bool{true, true, false, true}, 10},
+		{"capped", model.StandingsRules{ReservePointsMode: model.ReservePointsCapped, ReserveRoundCap: &one}, []bool{false, false, false, true}, 43},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := newResults()
+			markReserveEligibility(results, &tt.rules)
+			for i, r := range results {
+				if r.DriverExcluded != tt.excluded[i] {
+					t.Errorf("Result %d: expected excluded %v, got %v", i, tt.excluded[i], r.DriverExcluded)
+				}
+			}
+
+			drivers := []model.StandingsEntry{{ParticipantID: reserve, TotalPoints: 61}}
+			removeReservePoints(drivers, results)
+			if drivers[0].TotalPoints != tt.total || drivers[0].ReservePoints != 61-tt.total {
+				t.Errorf("Expected %.1f points with %.1f left out, got %+v", tt.total, 61-tt.total, drivers[0])
+			}
+		})
+	}
+}
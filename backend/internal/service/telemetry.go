@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/f1-rivals-cup/backend/internal/repository"
+	"github.com/f1-rivals-cup/backend/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// liveTimingInterval throttles lap data snapshots, which the game sends many times per second
+const liveTimingInterval = 500 * time.Millisecond
+
+var (
+	ErrTelemetryMatchNotLive = errors.New("match is not in progress")
+)
+
+// TelemetryService feeds game telemetry into the live timing of the match it is attached to
+// and stores the game's final classification as provisional results
+type TelemetryService struct {
+	matchRepo       *repository.MatchRepository
+	participantRepo *repository.ParticipantRepository
+	telemetryRepo   *repository.TelemetryRepository
+	hub             *telemetry.Hub
+	enabled         bool
+
+	mu            sync.Mutex
+	match         *model.Match
+	timing        *telemetry.Timing
+	lastPacketAt  time.Time
+	lastPublishAt time.Time
+}
+
+// NewTelemetryService creates a new TelemetryService instance.
+// enabled reports whether a UDP listener is feeding packets to the service.
+func NewTelemetryService(matchRepo *repository.MatchRepository, participantRepo *repository.ParticipantRepository, telemetryRepo *repository.TelemetryRepository, hub *telemetry.Hub, enabled bool) *TelemetryService {
+	return &TelemetryService{
+		matchRepo:       matchRepo,
+		participantRepo: participantRepo,
+		telemetryRepo:   telemetryRepo,
+		hub:             hub,
+		enabled:         enabled,
+	}
+}
+
+// Attach routes incoming telemetry to a match in progress, replacing any previously attached match
+func (s *TelemetryService) Attach(ctx context.Context, matchID uuid.UUID) (*model.Match, error) {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status != model.MatchStatusInProgress {
+		return nil, ErrTelemetryMatchNotLive
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.match = match
+	s.timing = telemetry.NewTiming()
+	s.lastPacketAt = time.Time{}
+	s.lastPublishAt = time.Time{}
+
+	return match, nil
+}
+
+// Detach stops routing telemetry to any match
+func (s *TelemetryService) Detach() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.match = nil
+	s.timing = nil
+}
+
+// Status returns the listener state and the attached match
+func (s *TelemetryService) Status() *model.TelemetryStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := &model.TelemetryStatus{Enabled: s.enabled}
+	if s.match != nil {
+		matchID := s.match.ID
+		status.MatchID = &matchID
+	}
+	if !s.lastPacketAt.IsZero() {
+		lastPacketAt := s.lastPacketAt
+		status.LastPacketAt = &lastPacketAt
+	}
+	return status
+}
+
+// Snapshot returns the current live timing of a match, or nil when no telemetry is attached to it
+func (s *TelemetryService) Snapshot(matchID uuid.UUID) *model.LiveTiming {
+	s.mu.Lock()
+	match, timing := s.match, s.timing
+	s.mu.Unlock()
+
+	if match == nil || match.ID != matchID {
+		return nil
+	}
+	return timing.Snapshot(matchID)
+}
+
+// Subscribe registers a live timing subscriber for a match
+func (s *TelemetryService) Subscribe(matchID uuid.UUID) (<-chan *model.LiveTiming, func()) {
+	return s.hub.Subscribe(matchID)
+}
+
+// HandlePacket decodes a raw UDP packet and applies it to the attached match.
+// Packets are dropped while no match is attached.
+func (s *TelemetryService) HandlePacket(ctx context.Context, data []byte) error {
+	packet, err := telemetry.Decode(data)
+	if err != nil || packet == nil {
+		return err
+	}
+
+	now := time.Now()
+
+	s.mu.Lock()
+	match, timing := s.match, s.timing
+	if match == nil {
+		s.mu.Unlock()
+		return nil
+	}
+	s.lastPacketAt = now
+	_, isLapData := packet.(*telemetry.LapDataPacket)
+	publish := !isLapData || now.Sub(s.lastPublishAt) >= liveTimingInterval
+	if publish {
+		s.lastPublishAt = now
+	}
+	s.mu.Unlock()
+
+	timing.Apply(packet)
+	if publish {
+		s.hub.Publish(timing.Snapshot(match.ID))
+	}
+
+	final, ok := packet.(*telemetry.FinalClassificationPacket)
+	if !ok {
+		return nil
+	}
+
+	results := timing.Classification(match.ID, final)
+	if len(results) == 0 {
+		return nil
+	}
+	if err := s.matchDrivers(ctx, match.LeagueID, results); err != nil {
+		return err
+	}
+	return s.telemetryRepo.ReplaceSession(ctx, match.ID, results[0].SessionType, results)
+}
+
+// matchDrivers links classification rows to approved participants whose nickname equals the in-game name
+func (s *TelemetryService) matchDrivers(ctx context.Context, leagueID uuid.UUID, results []*model.TelemetryClassification) error {
+	participants, err := s.participantRepo.ListByLeague(ctx, leagueID, string(model.ParticipantStatusApproved))
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]uuid.UUID, len(participants))
+	for _, p := range participants {
+		if p.UserNickname != nil {
+			byName[strings.ToLower(strings.TrimSpace(*p.UserNickname))] = p.ID
+		}
+	}
+
+	for _, result := range results {
+		if id, ok := byName[strings.ToLower(strings.TrimSpace(result.DriverName))]; ok {
+			result.ParticipantID = &id
+		}
+	}
+	return nil
+}
+
+// TelemetryResultRequests converts provisional classification rows into session result entries.
+// Rows without a participant are skipped; retirements become DNFs and disqualified drivers lose their position.
+func TelemetryResultRequests(rows []*model.TelemetryClassification) []model.CreateSessionResultRequest {
+	var fastest *model.TelemetryClassification
+	for _, row := range rows {
+		if row.ParticipantID == nil || row.BestLapMs == nil {
+			continue
+		}
+		if fastest == nil || *row.BestLapMs < *fastest.BestLapMs {
+			fastest = row
+		}
+	}
+
+	var requests []model.CreateSessionResultRequest
+	for _, row := range rows {
+		if row.ParticipantID == nil {
+			continue
+		}
+
+		laps := row.Laps
+		req := model.CreateSessionResultRequest{
+			ParticipantID: *row.ParticipantID,
+			FastestLap:    row == fastest,
+			TimeMs:        row.TotalTimeMs,
+			Laps:          &laps,
+		}
+
+		position := row.Position
+		switch row.ResultStatus {
+		case "dsq":
+			reason := "disqualified"
+			req.DNF = true
+			req.DNFReason = &reason
+		case "dnf", "retired", "not_classified":
+			req.Position = &position
+			req.DNF = true
+			req.TimeMs = nil
+		default:
+			req.Position = &position
+		}
+
+		requests = append(requests, req)
+	}
+
+	return requests
+}
@@ -0,0 +1,102 @@
+package telemetry
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Capture files store raw packets as frames of
+// [int64 receive time in unix nanoseconds][uint32 payload length][payload], all little endian.
+const frameHeaderSize = 12
+
+// maxFrameSize guards against reading a corrupt length as a huge allocation
+const maxFrameSize = 64 * 1024
+
+var ErrCorruptCapture = errors.New("telemetry capture is corrupt")
+
+// Recorder appends received packets to a capture file. It is safe for concurrent use.
+type Recorder struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewRecorder creates a Recorder writing frames to w
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w}
+}
+
+// Write records one packet received at the given time
+func (r *Recorder) Write(receivedAt time.Time, packet []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var header [frameHeaderSize]byte
+	binary.LittleEndian.PutUint64(header[0:], uint64(receivedAt.UnixNano()))
+	binary.LittleEndian.PutUint32(header[8:], uint32(len(packet)))
+
+	if _, err := r.w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := r.w.Write(packet)
+	return err
+}
+
+// Replay feeds every packet of a capture to handle in recorded order.
+// With realtime set, it waits between packets as long as the original gap between them.
+func Replay(ctx context.Context, r io.Reader, realtime bool, handle func([]byte) error) error {
+	br := bufio.NewReader(r)
+	var last time.Time
+
+	for {
+		var header [frameHeaderSize]byte
+		if _, err := io.ReadFull(br, header[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return ErrCorruptCapture
+		}
+
+		size := binary.LittleEndian.Uint32(header[8:])
+		if size > maxFrameSize {
+			return ErrCorruptCapture
+		}
+		packet := make([]byte, size)
+		if _, err := io.ReadFull(br, packet); err != nil {
+			return ErrCorruptCapture
+		}
+
+		receivedAt := time.Unix(0, int64(binary.LittleEndian.Uint64(header[0:])))
+		if realtime && !last.IsZero() {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(receivedAt.Sub(last)):
+			}
+		}
+		last = receivedAt
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := handle(packet); err != nil {
+			return err
+		}
+	}
+}
+
+// ReplayFile replays a capture file from disk
+func ReplayFile(ctx context.Context, path string, realtime bool, handle func([]byte) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return Replay(ctx, f, realtime, handle)
+}
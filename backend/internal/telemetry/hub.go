@@ -0,0 +1,62 @@
+package telemetry
+
+import (
+	"sync"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+// Hub fans live timing snapshots out to the subscribers of each match
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan *model.LiveTiming]struct{}
+}
+
+// NewHub creates an empty Hub
+func NewHub() *Hub {
+	return &Hub{subscribers: make(map[uuid.UUID]map[chan *model.LiveTiming]struct{})}
+}
+
+// Subscribe registers a subscriber for a match. The returned function unsubscribes and closes the channel.
+func (h *Hub) Subscribe(matchID uuid.UUID) (<-chan *model.LiveTiming, func()) {
+	ch := make(chan *model.LiveTiming, 1)
+
+	h.mu.Lock()
+	if h.subscribers[matchID] == nil {
+		h.subscribers[matchID] = make(map[chan *model.LiveTiming]struct{})
+	}
+	h.subscribers[matchID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subscribers[matchID], ch)
+			if len(h.subscribers[matchID]) == 0 {
+				delete(h.subscribers, matchID)
+			}
+			h.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Publish sends a snapshot to every subscriber of its match.
+// Slow subscribers only ever receive the latest snapshot.
+func (h *Hub) Publish(live *model.LiveTiming) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers[live.MatchID] {
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- live:
+		default:
+		}
+	}
+}
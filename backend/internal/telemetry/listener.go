@@ -0,0 +1,43 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+)
+
+// maxPacketSize comfortably exceeds the largest packet the game sends
+const maxPacketSize = 2048
+
+// Listen receives telemetry packets on a UDP address and passes a copy of each to handle
+// until ctx is cancelled
+func Listen(ctx context.Context, addr string, handle func([]byte)) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	slog.Info("Telemetry listener started", "addr", conn.LocalAddr().String())
+
+	buf := make([]byte, maxPacketSize)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				slog.Info("Telemetry listener stopped")
+				return nil
+			}
+			return err
+		}
+
+		packet := make([]byte, n)
+		copy(packet, buf[:n])
+		handle(packet)
+	}
+}
@@ -0,0 +1,240 @@
+// Package telemetry decodes the UDP telemetry broadcast by the official F1 24 game
+// and turns it into a live timing feed and final classifications.
+package telemetry
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// PacketFormat is the only telemetry format version the decoder understands
+const PacketFormat = 2024
+
+// MaxCars is the number of car slots carried by every per-car packet
+const MaxCars = 22
+
+// Packet IDs used by the decoder; every other packet type is ignored
+const (
+	PacketIDSession             uint8 = 1
+	PacketIDLapData             uint8 = 2
+	PacketIDParticipants        uint8 = 4
+	PacketIDFinalClassification uint8 = 8
+)
+
+const (
+	headerSize              = 29
+	sessionMinSize          = 13
+	lapDataSize             = 57
+	participantSize         = 60
+	participantNameSize     = 48
+	finalClassificationSize = 45
+)
+
+var (
+	ErrShortPacket       = errors.New("telemetry packet is too short")
+	ErrUnsupportedFormat = errors.New("unsupported telemetry packet format")
+)
+
+// Header is the common header at the start of every packet
+type Header struct {
+	PacketFormat    uint16
+	GameYear        uint8
+	PacketID        uint8
+	SessionUID      uint64
+	SessionTime     float32
+	FrameIdentifier uint32
+	PlayerCarIndex  uint8
+}
+
+// SessionPacket carries the session details sent a couple of times per second
+type SessionPacket struct {
+	Header          Header
+	TotalLaps       uint8
+	SessionType     uint8
+	TrackID         int8
+	SessionTimeLeft uint16 // Seconds
+}
+
+// LapData is the per-car timing state of a lap data packet
+type LapData struct {
+	LastLapTimeMs       uint32
+	CurrentLapTimeMs    uint32
+	DeltaToCarInFrontMs uint32
+	DeltaToLeaderMs     uint32
+	CarPosition         uint8
+	CurrentLapNum       uint8
+	PitStatus           uint8 // 0 = none, 1 = pitting, 2 = in pit area
+	NumPitStops         uint8
+	Penalties           uint8 // Accumulated time penalties in seconds
+	GridPosition        uint8
+	ResultStatus        uint8
+}
+
+// LapDataPacket carries timing for every car slot
+type LapDataPacket struct {
+	Header Header
+	Cars   [MaxCars]LapData
+}
+
+// Participant identifies the driver of a car slot
+type Participant struct {
+	AIControlled bool
+	TeamID       uint8
+	RaceNumber   uint8
+	Name         string
+}
+
+// ParticipantsPacket lists the drivers taking part in the session
+type ParticipantsPacket struct {
+	Header        Header
+	NumActiveCars uint8
+	Cars          [MaxCars]Participant
+}
+
+// FinalClassification is a car's result at the end of a session
+type FinalClassification struct {
+	Position      uint8
+	NumLaps       uint8
+	GridPosition  uint8
+	Points        uint8
+	NumPitStops   uint8
+	ResultStatus  uint8
+	BestLapTimeMs uint32
+	TotalRaceTime float64 // Seconds, without penalties
+	PenaltiesTime uint8   // Seconds
+	NumPenalties  uint8
+	NumTyreStints uint8
+}
+
+// FinalClassificationPacket is sent once when a session ends
+type FinalClassificationPacket struct {
+	Header  Header
+	NumCars uint8
+	Cars    [MaxCars]FinalClassification
+}
+
+// Decode parses a raw UDP payload. It returns nil without an error for packet types the decoder ignores.
+func Decode(data []byte) (any, error) {
+	if len(data) < headerSize {
+		return nil, ErrShortPacket
+	}
+
+	header := Header{
+		PacketFormat:    binary.LittleEndian.Uint16(data[0:]),
+		GameYear:        data[2],
+		PacketID:        data[6],
+		SessionUID:      binary.LittleEndian.Uint64(data[7:]),
+		SessionTime:     math.Float32frombits(binary.LittleEndian.Uint32(data[15:])),
+		FrameIdentifier: binary.LittleEndian.Uint32(data[19:]),
+		PlayerCarIndex:  data[27],
+	}
+	if header.PacketFormat != PacketFormat {
+		return nil, ErrUnsupportedFormat
+	}
+
+	body := data[headerSize:]
+	switch header.PacketID {
+	case PacketIDSession:
+		return decodeSession(header, body)
+	case PacketIDLapData:
+		return decodeLapData(header, body)
+	case PacketIDParticipants:
+		return decodeParticipants(header, body)
+	case PacketIDFinalClassification:
+		return decodeFinalClassification(header, body)
+	default:
+		return nil, nil
+	}
+}
+
+func decodeSession(header Header, body []byte) (*SessionPacket, error) {
+	if len(body) < sessionMinSize {
+		return nil, ErrShortPacket
+	}
+	return &SessionPacket{
+		Header:          header,
+		TotalLaps:       body[3],
+		SessionType:     body[6],
+		TrackID:         int8(body[7]),
+		SessionTimeLeft: binary.LittleEndian.Uint16(body[9:]),
+	}, nil
+}
+
+func decodeLapData(header Header, body []byte) (*LapDataPacket, error) {
+	if len(body) < MaxCars*lapDataSize {
+		return nil, ErrShortPacket
+	}
+
+	p := &LapDataPacket{Header: header}
+	for i := range p.Cars {
+		b := body[i*lapDataSize:]
+		p.Cars[i] = LapData{
+			LastLapTimeMs:       binary.LittleEndian.Uint32(b[0:]),
+			CurrentLapTimeMs:    binary.LittleEndian.Uint32(b[4:]),
+			DeltaToCarInFrontMs: splitTime(b[14:]),
+			DeltaToLeaderMs:     splitTime(b[17:]),
+			CarPosition:         b[32],
+			CurrentLapNum:       b[33],
+			PitStatus:           b[34],
+			NumPitStops:         b[35],
+			Penalties:           b[38],
+			GridPosition:        b[43],
+			ResultStatus:        b[45],
+		}
+	}
+	return p, nil
+}
+
+func decodeParticipants(header Header, body []byte) (*ParticipantsPacket, error) {
+	if len(body) < 1+MaxCars*participantSize {
+		return nil, ErrShortPacket
+	}
+
+	p := &ParticipantsPacket{Header: header, NumActiveCars: body[0]}
+	for i := range p.Cars {
+		b := body[1+i*participantSize:]
+		name := b[7 : 7+participantNameSize]
+		if end := bytes.IndexByte(name, 0); end != -1 {
+			name = name[:end]
+		}
+		p.Cars[i] = Participant{
+			AIControlled: b[0] == 1,
+			TeamID:       b[3],
+			RaceNumber:   b[5],
+			Name:         string(name),
+		}
+	}
+	return p, nil
+}
+
+func decodeFinalClassification(header Header, body []byte) (*FinalClassificationPacket, error) {
+	if len(body) < 1+MaxCars*finalClassificationSize {
+		return nil, ErrShortPacket
+	}
+
+	p := &FinalClassificationPacket{Header: header, NumCars: body[0]}
+	for i := range p.Cars {
+		b := body[1+i*finalClassificationSize:]
+		p.Cars[i] = FinalClassification{
+			Position:      b[0],
+			NumLaps:       b[1],
+			GridPosition:  b[2],
+			Points:        b[3],
+			NumPitStops:   b[4],
+			ResultStatus:  b[5],
+			BestLapTimeMs: binary.LittleEndian.Uint32(b[6:]),
+			TotalRaceTime: math.Float64frombits(binary.LittleEndian.Uint64(b[10:])),
+			PenaltiesTime: b[18],
+			NumPenalties:  b[19],
+			NumTyreStints: b[20],
+		}
+	}
+	return p, nil
+}
+
+// splitTime combines the milliseconds and minutes parts the game uses for gaps
+func splitTime(b []byte) uint32 {
+	return uint32(binary.LittleEndian.Uint16(b[0:])) + uint32(b[2])*60000
+}
@@ -0,0 +1,125 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+func testPacket(id uint8, bodySize int) ([]byte, []byte) {
+	data := make([]byte, headerSize+bodySize)
+	binary.LittleEndian.PutUint16(data[0:], PacketFormat)
+	data[2] = 24
+	data[6] = id
+	binary.LittleEndian.PutUint64(data[7:], 42)
+	return data, data[headerSize:]
+}
+
+func testCapture(t *testing.T) string {
+	t.Helper()
+
+	session, body := testPacket(PacketIDSession, sessionMinSize)
+	body[3] = 10 // total laps
+	body[6] = 15 // race
+
+	participants, body := testPacket(PacketIDParticipants, 1+MaxCars*participantSize)
+	body[0] = 2
+	for i, name := range []string{"Alice", "Bob"} {
+		b := body[1+i*participantSize:]
+		b[3] = uint8(i)
+		b[5] = uint8(10 + i)
+		copy(b[7:], name)
+	}
+
+	laps, body := testPacket(PacketIDLapData, MaxCars*lapDataSize)
+	alice, bob := body[0:], body[lapDataSize:]
+	binary.LittleEndian.PutUint32(alice[0:], 89500)
+	binary.LittleEndian.PutUint16(alice[14:], 1200)
+	binary.LittleEndian.PutUint16(alice[17:], 1200)
+	alice[32], alice[33], alice[45] = 2, 5, ResultStatusActive
+	binary.LittleEndian.PutUint32(bob[0:], 90000)
+	bob[32], bob[33], bob[45] = 1, 5, ResultStatusActive
+
+	final, body := testPacket(PacketIDFinalClassification, 1+MaxCars*finalClassificationSize)
+	body[0] = 2
+	alice, bob = body[1:], body[1+finalClassificationSize:]
+	alice[0], alice[1], alice[2], alice[5] = 2, 10, 1, ResultStatusFinished
+	binary.LittleEndian.PutUint32(alice[6:], 87500)
+	binary.LittleEndian.PutUint64(alice[10:], math.Float64bits(902.0))
+	alice[18] = 5
+	bob[0], bob[1], bob[2], bob[5] = 1, 10, 2, ResultStatusFinished
+	binary.LittleEndian.PutUint32(bob[6:], 88000)
+	binary.LittleEndian.PutUint64(bob[10:], math.Float64bits(900.5))
+
+	var buf bytes.Buffer
+	recorder := NewRecorder(&buf)
+	start := time.Now()
+	for i, packet := range [][]byte{session, participants, laps, final} {
+		if err := recorder.Write(start.Add(time.Duration(i)*time.Millisecond), packet); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "capture.bin")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestReplayCapture(t *testing.T) {
+	matchID := uuid.New()
+	timing := NewTiming()
+	var live *model.LiveTiming
+	var results []*model.TelemetryClassification
+
+	err := ReplayFile(context.Background(), testCapture(t), false, func(data []byte) error {
+		packet, err := Decode(data)
+		if err != nil {
+			return err
+		}
+		timing.Apply(packet)
+		if _, ok := packet.(*LapDataPacket); ok {
+			live = timing.Snapshot(matchID)
+		}
+		if final, ok := packet.(*FinalClassificationPacket); ok {
+			results = timing.Classification(matchID, final)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ReplayFile() error = %v", err)
+	}
+
+	if live == nil || len(live.Cars) != 2 {
+		t.Fatalf("live timing = %+v, want 2 cars", live)
+	}
+	if live.SessionType != model.SessionTypeRace || live.TotalLaps != 10 || live.CurrentLap != 5 {
+		t.Errorf("live session = %s lap %d/%d, want race lap 5/10", live.SessionType, live.CurrentLap, live.TotalLaps)
+	}
+	leader, second := live.Cars[0], live.Cars[1]
+	if leader.DriverName != "Bob" || leader.GapToLeaderMs != 0 {
+		t.Errorf("leader = %s gap %d, want Bob gap 0", leader.DriverName, leader.GapToLeaderMs)
+	}
+	if second.DriverName != "Alice" || second.GapToLeaderMs != 1200 || second.LastLapMs == nil || *second.LastLapMs != 89500 {
+		t.Errorf("second = %+v, want Alice +1200ms with last lap 89500", second)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("classification has %d rows, want 2", len(results))
+	}
+	if results[0].DriverName != "Bob" || results[0].Position != 1 || *results[0].TotalTimeMs != 900500 {
+		t.Errorf("winner = %+v, want Bob P1 in 900500ms", results[0])
+	}
+	if results[1].DriverName != "Alice" || *results[1].TotalTimeMs != 907000 || *results[1].BestLapMs != 87500 {
+		t.Errorf("second = %+v, want Alice in 907000ms with best lap 87500", results[1])
+	}
+}
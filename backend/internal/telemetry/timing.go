@@ -0,0 +1,245 @@
+package telemetry
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/f1-rivals-cup/backend/internal/model"
+	"github.com/google/uuid"
+)
+
+// Result statuses reported in lap data and final classification packets
+const (
+	ResultStatusInvalid       uint8 = 0
+	ResultStatusInactive      uint8 = 1
+	ResultStatusActive        uint8 = 2
+	ResultStatusFinished      uint8 = 3
+	ResultStatusDidNotFinish  uint8 = 4
+	ResultStatusDisqualified  uint8 = 5
+	ResultStatusNotClassified uint8 = 6
+	ResultStatusRetired       uint8 = 7
+)
+
+// ResultStatusName returns the API name of a game result status
+func ResultStatusName(status uint8) string {
+	switch status {
+	case ResultStatusInactive:
+		return "inactive"
+	case ResultStatusActive:
+		return "active"
+	case ResultStatusFinished:
+		return "finished"
+	case ResultStatusDidNotFinish:
+		return "dnf"
+	case ResultStatusDisqualified:
+		return "dsq"
+	case ResultStatusNotClassified:
+		return "not_classified"
+	case ResultStatusRetired:
+		return "retired"
+	default:
+		return "invalid"
+	}
+}
+
+// SessionTypeFor maps the game's session type to a race weekend session type.
+// Time trial and unknown sessions return an empty type.
+func SessionTypeFor(gameType uint8) model.SessionType {
+	switch {
+	case gameType >= 1 && gameType <= 4:
+		return model.SessionTypePractice
+	case gameType >= 5 && gameType <= 9:
+		return model.SessionTypeQualifying
+	case gameType >= 10 && gameType <= 14:
+		return model.SessionTypeSprintShootout
+	case gameType >= 15 && gameType <= 17:
+		return model.SessionTypeRace
+	default:
+		return ""
+	}
+}
+
+// Timing accumulates decoded packets of one game session into a live timing view.
+// It is safe for concurrent use.
+type Timing struct {
+	mu           sync.Mutex
+	sessionUID   uint64
+	session      *SessionPacket
+	participants *ParticipantsPacket
+	laps         *LapDataPacket
+	bestLaps     [MaxCars]uint32
+	updatedAt    time.Time
+}
+
+// NewTiming creates an empty Timing
+func NewTiming() *Timing {
+	return &Timing{}
+}
+
+// Apply folds a decoded packet into the timing state. A packet from a new game session resets the state.
+func (t *Timing) Apply(packet any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var header Header
+	switch p := packet.(type) {
+	case *SessionPacket:
+		header = p.Header
+	case *LapDataPacket:
+		header = p.Header
+	case *ParticipantsPacket:
+		header = p.Header
+	case *FinalClassificationPacket:
+		header = p.Header
+	default:
+		return
+	}
+
+	if header.SessionUID != t.sessionUID {
+		t.sessionUID = header.SessionUID
+		t.session = nil
+		t.participants = nil
+		t.laps = nil
+		t.bestLaps = [MaxCars]uint32{}
+	}
+	t.updatedAt = time.Now()
+
+	switch p := packet.(type) {
+	case *SessionPacket:
+		t.session = p
+	case *ParticipantsPacket:
+		t.participants = p
+	case *LapDataPacket:
+		t.laps = p
+		for i, car := range p.Cars {
+			if car.LastLapTimeMs > 0 && (t.bestLaps[i] == 0 || car.LastLapTimeMs < t.bestLaps[i]) {
+				t.bestLaps[i] = car.LastLapTimeMs
+			}
+		}
+	}
+}
+
+// SessionType returns the weekend session type of the current game session
+func (t *Timing) SessionType() model.SessionType {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.session == nil {
+		return ""
+	}
+	return SessionTypeFor(t.session.SessionType)
+}
+
+// Snapshot returns the live timing of every running car ordered by position
+func (t *Timing) Snapshot(matchID uuid.UUID) *model.LiveTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	live := &model.LiveTiming{
+		MatchID:   matchID,
+		Cars:      []model.LiveTimingCar{},
+		UpdatedAt: t.updatedAt,
+	}
+	if t.session != nil {
+		live.SessionType = SessionTypeFor(t.session.SessionType)
+		live.TotalLaps = int(t.session.TotalLaps)
+		live.SessionTimeLeft = int(t.session.SessionTimeLeft)
+	}
+	if t.laps == nil {
+		return live
+	}
+
+	for i, lap := range t.laps.Cars {
+		if lap.CarPosition == 0 || lap.ResultStatus < ResultStatusActive {
+			continue
+		}
+
+		car := model.LiveTimingCar{
+			CarIndex:      i,
+			Position:      int(lap.CarPosition),
+			CurrentLap:    int(lap.CurrentLapNum),
+			GapToLeaderMs: int64(lap.DeltaToLeaderMs),
+			IntervalMs:    int64(lap.DeltaToCarInFrontMs),
+			PitStops:      int(lap.NumPitStops),
+			InPit:         lap.PitStatus != 0,
+			PenaltiesSec:  int(lap.Penalties),
+			Status:        ResultStatusName(lap.ResultStatus),
+		}
+		if lap.LastLapTimeMs > 0 {
+			last := int64(lap.LastLapTimeMs)
+			car.LastLapMs = &last
+		}
+		if t.bestLaps[i] > 0 {
+			best := int64(t.bestLaps[i])
+			car.BestLapMs = &best
+		}
+		if t.participants != nil {
+			p := t.participants.Cars[i]
+			car.DriverName = p.Name
+			car.TeamID = int(p.TeamID)
+			car.RaceNumber = int(p.RaceNumber)
+		}
+		if car.Position == 1 {
+			live.CurrentLap = car.CurrentLap
+			car.GapToLeaderMs = 0
+			car.IntervalMs = 0
+		}
+		live.Cars = append(live.Cars, car)
+	}
+
+	sort.Slice(live.Cars, func(i, j int) bool { return live.Cars[i].Position < live.Cars[j].Position })
+
+	return live
+}
+
+// Classification converts a final classification packet into provisional results,
+// using the driver names from the latest participants packet
+func (t *Timing) Classification(matchID uuid.UUID, packet *FinalClassificationPacket) []*model.TelemetryClassification {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sessionType := model.SessionTypeRace
+	if t.session != nil {
+		if st := SessionTypeFor(t.session.SessionType); st != "" {
+			sessionType = st
+		}
+	}
+
+	var results []*model.TelemetryClassification
+	for i := 0; i < int(packet.NumCars) && i < MaxCars; i++ {
+		car := packet.Cars[i]
+		if car.Position == 0 {
+			continue
+		}
+
+		result := &model.TelemetryClassification{
+			MatchID:      matchID,
+			SessionType:  sessionType,
+			CarIndex:     i,
+			Position:     int(car.Position),
+			GridPosition: int(car.GridPosition),
+			Laps:         int(car.NumLaps),
+			PenaltiesSec: int(car.PenaltiesTime),
+			PitStops:     int(car.NumPitStops),
+			ResultStatus: ResultStatusName(car.ResultStatus),
+		}
+		if car.BestLapTimeMs > 0 {
+			best := int64(car.BestLapTimeMs)
+			result.BestLapMs = &best
+		}
+		if car.TotalRaceTime > 0 {
+			total := int64(car.TotalRaceTime*1000) + int64(car.PenaltiesTime)*1000
+			result.TotalTimeMs = &total
+		}
+		if t.participants != nil {
+			result.DriverName = t.participants.Cars[i].Name
+			result.RaceNumber = int(t.participants.Cars[i].RaceNumber)
+		}
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Position < results[j].Position })
+
+	return results
+}
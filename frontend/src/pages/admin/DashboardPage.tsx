@@ -49,7 +49,7 @@ const initialFormData: FormData = {
   end_date: '',
   match_time: '',
   rules: '',
-  settings: '',
+  settings_note: '',
   contact_info: '',
 }
 
@@ -180,7 +180,7 @@ export default function DashboardPage() {
       end_date: league.end_date ? league.end_date.split('T')[0] : '',
       match_time: league.match_time || '',
       rules: league.rules || '',
-      settings: league.settings || '',
+      settings_note: league.settings_note || '',
       contact_info: league.contact_info || '',
       status: league.status,
     })
@@ -236,7 +236,7 @@ export default function DashboardPage() {
           end_date: formData.end_date || undefined,
           match_time: formData.match_time || undefined,
           rules: formData.rules || undefined,
-          settings: formData.settings || undefined,
+          settings_note: formData.settings_note || undefined,
           contact_info: formData.contact_info || undefined,
           status: formData.status,
         }
@@ -250,7 +250,7 @@ export default function DashboardPage() {
           end_date: formData.end_date || undefined,
           match_time: formData.match_time || undefined,
           rules: formData.rules || undefined,
-          settings: formData.settings || undefined,
+          settings_note: formData.settings_note || undefined,
           contact_info: formData.contact_info || undefined,
         }
         await leagueService.create(createData)
@@ -995,8 +995,8 @@ export default function DashboardPage() {
                   리그 세팅
                 </label>
                 <textarea
-                  value={formData.settings || ''}
-                  onChange={(e) => setFormData({ ...formData, settings: e.target.value })}
+                  value={formData.settings_note || ''}
+                  onChange={(e) => setFormData({ ...formData, settings_note: e.target.value })}
                   className="input w-full h-24 resize-none"
                   placeholder="게임 세팅 정보를 입력하세요"
                 />
@@ -608,7 +608,7 @@ export default function LeagueDetailPage() {
             <div className="bg-carbon-dark border border-steel rounded-lg p-5">
               <h3 className="text-sm font-medium text-text-secondary uppercase mb-3">리그 세팅</h3>
               <p className="text-white whitespace-pre-wrap">
-                {league.settings || '등록된 세팅 정보가 없습니다.'}
+                {league.settings_note || '등록된 세팅 정보가 없습니다.'}
               </p>
             </div>
 
@@ -525,7 +525,7 @@ export default function LeagueDetailPage() {
                   리그 세팅
                 </h3>
                 <p className="text-text-secondary whitespace-pre-wrap leading-relaxed">
-                  {league.settings || '등록된 세팅 정보가 없습니다.'}
+                  {league.settings_note || '등록된 세팅 정보가 없습니다.'}
                 </p>
               </div>
 
@@ -11,7 +11,7 @@ export interface League {
   end_date?: string
   match_time?: string
   rules?: string
-  settings?: string
+  settings_note?: string
   contact_info?: string
   created_at: string
   updated_at: string
@@ -25,7 +25,7 @@ export interface CreateLeagueRequest {
   end_date?: string
   match_time?: string
   rules?: string
-  settings?: string
+  settings_note?: string
   contact_info?: string
 }
 
@@ -38,7 +38,7 @@ export interface UpdateLeagueRequest {
   end_date?: string
   match_time?: string
   rules?: string
-  settings?: string
+  settings_note?: string
   contact_info?: string
 }
 